@@ -0,0 +1,143 @@
+package configs
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AI sağlayıcı türleri
+const (
+	AI_PROVIDER_OPENAI     = "openai"     // api.openai.com
+	AI_PROVIDER_AZURE      = "azure"      // Azure OpenAI (AI_BASE_URL kaynak adresi, model adları deployment adı olarak kullanılır)
+	AI_PROVIDER_COMPATIBLE = "compatible" // OpenAI uyumlu sunucular: Ollama, vLLM, LM Studio (AI_BASE_URL zorunlu)
+	AI_PROVIDER_MOCK       = "mock"       // Ağ bağlantısı olmadan deterministik yanıt üretir (geliştirme ve test)
+
+	AI_DEFAULT_MAX_RETRIES   = 2
+	AI_RETRY_BASE_DELAY      = 500 * time.Millisecond // Her denemede iki katına çıkar
+	AI_RETRY_MAX_DELAY       = 8 * time.Second
+	AI_AZURE_DEFAULT_VERSION = "2024-10-21"
+)
+
+// AIModelPrice - Modelin milyon token başına dolar fiyatı
+type AIModelPrice struct {
+	Input  float64
+	Output float64
+}
+
+// aiModelPricing - Bilinen modellerin fiyatları; AI_MODEL_PRICING ile genişletilebilir veya değiştirilebilir
+// Tarihli model adları (örn. gpt-4.1-nano-2025-04-14) en uzun ön ek eşleşmesiyle bulunur.
+var aiModelPricing = map[string]AIModelPrice{
+	"gpt-4.1-nano": {Input: 0.05, Output: 0.20},
+	"gpt-4.1-mini": {Input: 0.40, Output: 1.60},
+	"gpt-4.1":      {Input: 2.00, Output: 8.00},
+	"gpt-4o-mini":  {Input: 0.15, Output: 0.60},
+	"gpt-4o":       {Input: 2.50, Output: 10.00},
+	"mock":         {Input: 0, Output: 0},
+}
+
+// AIConfig - Yapay zeka sağlayıcısı ve özellik bazında model ayarları
+type AIConfig struct {
+	Provider         string
+	APIKey           string
+	BaseURL          string
+	APIVersion       string // Sadece Azure
+	MaxRetries       int
+	ScreeningModel   string
+	WritingModel     string
+	TranslationModel string
+	Pricing          map[string]AIModelPrice
+}
+
+// GetAIConfig ortam değişkenlerinden yapay zeka ayarlarını okur
+// AI_PROVIDER, AI_API_KEY (yoksa OPENAI_API_KEY), AI_BASE_URL, AI_AZURE_API_VERSION, AI_MAX_RETRIES,
+// AI_MODEL (tüm özellikler), AI_MODEL_SCREENING, AI_MODEL_WRITING, AI_MODEL_TRANSLATION ve
+// AI_MODEL_PRICING ("model=giriş:çıkış,..." milyon token başına dolar).
+func GetAIConfig() AIConfig {
+	config := AIConfig{
+		Provider:   strings.ToLower(strings.TrimSpace(os.Getenv("AI_PROVIDER"))),
+		APIKey:     os.Getenv("AI_API_KEY"),
+		BaseURL:    strings.TrimRight(os.Getenv("AI_BASE_URL"), "/"),
+		APIVersion: os.Getenv("AI_AZURE_API_VERSION"),
+		MaxRetries: AI_DEFAULT_MAX_RETRIES,
+		Pricing:    make(map[string]AIModelPrice, len(aiModelPricing)),
+	}
+
+	if config.Provider == "" {
+		config.Provider = AI_PROVIDER_OPENAI
+	}
+
+	if config.APIKey == "" {
+		config.APIKey = os.Getenv("OPENAI_API_KEY")
+	}
+
+	if config.APIVersion == "" {
+		config.APIVersion = AI_AZURE_DEFAULT_VERSION
+	}
+
+	if retries, err := strconv.Atoi(os.Getenv("AI_MAX_RETRIES")); err == nil && retries >= 0 {
+		config.MaxRetries = retries
+	}
+
+	// Özel model belirtilmezse AI_MODEL, o da yoksa sabitlerdeki varsayılan kullanılır
+	defaultModel := strings.TrimSpace(os.Getenv("AI_MODEL"))
+	config.ScreeningModel = firstNonEmpty(os.Getenv("AI_MODEL_SCREENING"), defaultModel, AI_SCREENING_MODEL)
+	config.WritingModel = firstNonEmpty(os.Getenv("AI_MODEL_WRITING"), defaultModel, AI_WRITING_MODEL)
+	config.TranslationModel = firstNonEmpty(os.Getenv("AI_MODEL_TRANSLATION"), defaultModel, AI_WRITING_MODEL)
+
+	if config.Provider == AI_PROVIDER_MOCK {
+		config.ScreeningModel, config.WritingModel, config.TranslationModel = "mock", "mock", "mock"
+	}
+
+	for model, price := range aiModelPricing {
+		config.Pricing[model] = price
+	}
+	for _, entry := range strings.Split(os.Getenv("AI_MODEL_PRICING"), ",") {
+		model, prices, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok {
+			continue
+		}
+		input, output, ok := strings.Cut(prices, ":")
+		if !ok {
+			continue
+		}
+		inputPrice, inputErr := strconv.ParseFloat(strings.TrimSpace(input), 64)
+		outputPrice, outputErr := strconv.ParseFloat(strings.TrimSpace(output), 64)
+		if inputErr != nil || outputErr != nil {
+			continue
+		}
+		config.Pricing[strings.TrimSpace(model)] = AIModelPrice{Input: inputPrice, Output: outputPrice}
+	}
+
+	return config
+}
+
+// Price modelin fiyatını döner; tarihli sürümler için en uzun ön ek eşleşmesi kullanılır
+// Fiyatı bilinmeyen modeller (örn. yerel modeller) ücretsiz sayılır.
+func (c AIConfig) Price(model string) (AIModelPrice, bool) {
+	if price, ok := c.Pricing[model]; ok {
+		return price, true
+	}
+
+	var match string
+	for name := range c.Pricing {
+		if strings.HasPrefix(model, name+"-") && len(name) > len(match) {
+			match = name
+		}
+	}
+	if match == "" {
+		return AIModelPrice{}, false
+	}
+
+	return c.Pricing[match], true
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,40 @@
+package configs
+
+import "testing"
+
+func TestAIConfigPrice(t *testing.T) {
+	tests := []struct {
+		name  string
+		model string
+		want  AIModelPrice
+		found bool
+	}{
+		{name: "tam eşleşme", model: "gpt-4.1", want: AIModelPrice{Input: 2.00, Output: 8.00}, found: true},
+		{name: "tarihli sürüm", model: "gpt-4.1-2025-04-14", want: AIModelPrice{Input: 2.00, Output: 8.00}, found: true},
+		{name: "en uzun ön ek seçilir", model: "gpt-4.1-mini-2025-04-14", want: AIModelPrice{Input: 0.40, Output: 1.60}, found: true},
+		{name: "tire olmadan ön ek eşleşmez", model: "gpt-4.1x", found: false},
+		{name: "ortamdan eklenen model", model: "llama3", want: AIModelPrice{Input: 0.1, Output: 0.2}, found: true},
+		{name: "ortamdan değiştirilen fiyat", model: "gpt-4o", want: AIModelPrice{Input: 1, Output: 4}, found: true},
+		{name: "bilinmeyen model ücretsiz", model: "mistral-7b", found: false},
+		{name: "boş model", model: "", found: false},
+	}
+
+	t.Setenv("AI_MODEL_PRICING", "llama3=0.1:0.2, gpt-4o = 1:4, bozuk, eksik=1, sayi=a:b")
+	config := GetAIConfig()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := config.Price(tt.model)
+			if found != tt.found || got != tt.want {
+				t.Errorf("Price(%q) = %+v, %v; beklenen %+v, %v", tt.model, got, found, tt.want, tt.found)
+			}
+		})
+	}
+
+	if _, found := config.Price("eksik"); found {
+		t.Error("hatalı AI_MODEL_PRICING girdisi eklenmemeli")
+	}
+	if aiModelPricing["gpt-4o"].Input != 2.50 {
+		t.Error("AI_MODEL_PRICING varsayılan fiyat tablosunu değiştirmemeli")
+	}
+}
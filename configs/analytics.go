@@ -0,0 +1,44 @@
+package configs
+
+import (
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	ANALYTICS_CACHE_DURATION = 5 * time.Minute
+	ANALYTICS_DEFAULT_RANGE  = 30 * 24 * time.Hour
+	ANALYTICS_MAX_RANGE      = 366 * 24 * time.Hour
+	ANALYTICS_DEFAULT_LIMIT  = 10
+)
+
+// AnalyticsConfig - Başvuru durumları serbest metin olduğu için işe alım ve huni aşamaları yapılandırılabilir
+type AnalyticsConfig struct {
+	HiredStatus  string   // İşe alındı sayılan durum
+	StatusFunnel []string // Dönüşüm hunisindeki aşamalar (sıralı)
+}
+
+// GetAnalyticsConfig ortam değişkenlerinden analitik ayarlarını okur
+func GetAnalyticsConfig() AnalyticsConfig {
+	config := AnalyticsConfig{
+		HiredStatus: strings.TrimSpace(os.Getenv("ANALYTICS_HIRED_STATUS")),
+	}
+
+	if config.HiredStatus == "" {
+		config.HiredStatus = "hired"
+	}
+
+	funnel := os.Getenv("ANALYTICS_STATUS_FUNNEL")
+	if funnel == "" {
+		funnel = "received,reviewing,interview,offer," + config.HiredStatus
+	}
+
+	for _, status := range strings.Split(funnel, ",") {
+		if status = strings.TrimSpace(status); status != "" {
+			config.StatusFunnel = append(config.StatusFunnel, status)
+		}
+	}
+
+	return config
+}
@@ -21,6 +21,26 @@ const (
 	AI_RATE_LIMIT_REQ_PER_MINUTE = 5
 	AI_RATE_LIMIT_MAX_TOKENS     = 10_000_000
 
+	// AI Screening Rules
+	AI_SCREENING_MODEL             = "gpt-4.1-nano" // Varsayılan, AI_MODEL_SCREENING ile değiştirilebilir
+	AI_SCREENING_TIMEOUT           = 60 * time.Second
+	AI_SCREENING_MAX_OUTPUT_TOKENS = 1200
+	AI_SCREENING_MAX_CV_CHARS      = 15_000           // Modele gönderilen özgeçmiş metni sınırı
+	AI_SCREENING_MAX_FILE_SIZE     = 10 * 1024 * 1024 // İndirilecek en büyük ek (FileCreateInput sınırı ile aynı)
+
+	// AI Writing Assistant Rules
+	AI_WRITING_MODEL             = "gpt-4.1-nano"   // Varsayılan, AI_MODEL_WRITING ve AI_MODEL_TRANSLATION ile değiştirilebilir
+	AI_WRITING_TIMEOUT           = 90 * time.Second // SSE isteklerinde genel istek zaman aşımı uygulanmaz
+	AI_WRITING_MAX_OUTPUT_TOKENS = 2000
+	AI_WRITING_MAX_INPUT_CHARS   = 20_000
+	AI_SEO_MAX_OUTPUT_TOKENS     = 600
+
+	// AI Translation Rules
+	AI_TRANSLATION_TIMEOUT           = 5 * time.Minute // Tüm parçaların çevirisi için toplam süre
+	AI_TRANSLATION_MAX_OUTPUT_TOKENS = 8000
+	AI_TRANSLATION_BATCH_CHARS       = 6000    // Tek çağrıda gönderilen en fazla metin
+	AI_TRANSLATION_MAX_CHARS         = 100_000 // Çevrilebilecek en uzun içerik
+
 	// Session Rules
 	REFRESH_TOKEN_LENGTH   = 32
 	REFRESH_TOKEN_DURATION = 30 * 24 * time.Hour
@@ -33,4 +53,59 @@ const (
 	JOBS_TRACKING_SUBJECT  = "tracking_jwt"
 	JOBS_TRACKING_COOKIE   = "tracking_cookie"
 	JOBS_TRACKING_DURATION = 30 * 24 * time.Hour
+
+	// JOBS Tracking Code Rules
+	JOBS_TRACKING_CODE_LENGTH   = 6
+	JOBS_TRACKING_CODE_DURATION = 15 * time.Minute
+
+	// JOB Interview Rules
+	JOB_INTERVIEW_APPLICATION_STATUS = "interview" // Mülakat planlanan başvurunun taşındığı aşama
+	CANDIDATE_MAIL_TIMEOUT           = 1 * time.Minute
+
+	// JOB Alert Rules
+	JOB_ALERT_CONFIRM_SUBJECT  = "job_alert_confirm"
+	JOB_ALERT_CONFIRM_DURATION = 48 * time.Hour
+	JOB_ALERT_MANAGE_SUBJECT   = "job_alert_manage"
+	JOB_ALERT_MANAGE_DURATION  = 365 * 24 * time.Hour
+	JOB_ALERT_DIGEST_HOUR      = 9
+	JOB_ALERT_SEND_TIMEOUT     = 10 * time.Minute
+	JOB_ALERT_CONFIRM_COOLDOWN = 15 * time.Minute // Aynı e-postaya onay mesajının tekrar gönderilebileceği en kısa süre
+
+	// Scheduled Publishing Rules
+	SCHEDULED_PUBLISHING_INTERVAL = 1 * time.Minute
+
+	// Edit Lock Rules
+	EDIT_LOCK_DURATION = 2 * time.Minute // Düzenleme ekranı bu süre dolmadan kilidi yeniler
+
+	// Draft Preview Rules
+	PREVIEW_SUBJECT          = "draft_preview"
+	PREVIEW_DEFAULT_DURATION = 7 * 24 * time.Hour // Süre belirtilmezse linkin geçerlilik süresi
+
+	// Sitemap Rules
+	SITEMAP_MAX_URLS       = 50_000 // Protokol sınırı, aşılırsa sitemap.xml bir sitemap index'e dönüşür
+	SITEMAP_CACHE_DURATION = 1 * time.Hour
+
+	// Content Feed Rules
+	CONTENT_FEED_LIMIT          = 50  // RSS ve Atom feed'lerindeki en fazla içerik
+	CONTENT_FEED_EXCERPT_CHARS  = 600 // content_html'den üretilen özetin uzunluğu
+	CONTENT_FEED_CACHE_DURATION = 15 * time.Minute
+
+	// Full-Text Search Rules
+	SEARCH_MIN_QUERY_LENGTH = 2
+	SEARCH_MAX_LIMIT        = 50
+	SEARCH_CACHE_DURATION   = 5 * time.Minute
+
+	// Email Outbox Rules
+	OUTBOX_DISPATCH_INTERVAL = 30 * time.Second
+	OUTBOX_BATCH_SIZE        = 50
+	OUTBOX_MAX_ATTEMPTS      = 5
+	OUTBOX_RETRY_DELAY       = 5 * time.Minute     // Deneme sayısı ile çarpılır
+	OUTBOX_LOCK_TIMEOUT      = 10 * time.Minute    // Bu süreden uzun 'sending' kalan kayıtlar tekrar alınır
+	OUTBOX_RETENTION         = 30 * 24 * time.Hour // Gönderilmiş ve başarısız kayıtlar bu süreden sonra silinir
+
+	// Candidate Email Template Rules
+	EMAIL_DEFAULT_LANGUAGE = "tr" // Başvuruda dil yoksa ve adayın dilinde şablon bulunamazsa kullanılan dil
+
+	// Bulk Action Rules
+	BULK_APPLICATION_MAX = 5000 // Filtre ile tek seferde işlenebilecek en fazla başvuru
 )
@@ -0,0 +1,38 @@
+package configs
+
+import "time"
+
+// JobFeedBoard - Harici ilan sitelerine verilen XML feed'in site bazlı ayarları
+type JobFeedBoard struct {
+	Publisher       string // Feed'de <publisher> olarak görünecek ad
+	UTMSource       string // Başvuru linklerine eklenecek utm_source değeri
+	HTMLDescription bool   // true ise açıklama HTML olarak, false ise düz metin olarak verilir
+	IncludeExpired  bool   // Son başvuru tarihi geçmiş ilanlar da verilsin mi?
+}
+
+const (
+	JOB_FEED_CACHE_DURATION = 30 * time.Minute
+)
+
+// JobFeedBoards - /public/feeds/jobs/:board altında sunulan feed varyantları
+var JobFeedBoards = map[string]JobFeedBoard{
+	"default": {
+		Publisher:       PROJECT_NAME,
+		HTMLDescription: false,
+	},
+	"indeed": {
+		Publisher:       PROJECT_NAME,
+		UTMSource:       "indeed",
+		HTMLDescription: true,
+	},
+	"linkedin": {
+		Publisher:       PROJECT_NAME,
+		UTMSource:       "linkedin",
+		HTMLDescription: true,
+	},
+	"kariyernet": {
+		Publisher:       PROJECT_NAME,
+		UTMSource:       "kariyer.net",
+		HTMLDescription: false,
+	},
+}
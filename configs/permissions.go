@@ -1,6 +1,11 @@
 package configs
 
-import "github.com/okanay/backend-holding/types"
+import (
+	"os"
+	"strings"
+
+	"github.com/okanay/backend-holding/types"
+)
 
 type Permission string
 type Access string
@@ -9,6 +14,8 @@ const (
 	CreatePost Permission = "create-post"
 	EditPost   Permission = "edit-post"
 	DeletePost Permission = "delete-post"
+	// PublishPost içeriği yayına alma ve incelemeleri onaylama/reddetme yetkisi (onaylayıcı rolü)
+	PublishPost Permission = "publish-post"
 )
 
 const (
@@ -19,14 +26,16 @@ const (
 
 var RolePermissionConfig = map[types.Role]map[Permission]Access{
 	types.RoleEditor: {
-		CreatePost: AccessFull,
-		EditPost:   AccessFull,
-		DeletePost: AccessNone,
+		CreatePost:  AccessFull,
+		EditPost:    AccessFull,
+		DeletePost:  AccessNone,
+		PublishPost: AccessNone,
 	},
 	types.RoleUser: {
-		CreatePost: AccessNone,
-		EditPost:   AccessNone,
-		DeletePost: AccessNone,
+		CreatePost:  AccessNone,
+		EditPost:    AccessNone,
+		DeletePost:  AccessNone,
+		PublishPost: AccessNone,
 	},
 }
 
@@ -56,3 +65,22 @@ func CheckPermission(role types.Role, permission Permission, userID string, reso
 		return false
 	}
 }
+
+// CanPublishContent rolün içerik yayınlama ve inceleme onaylama yetkisi olup olmadığını döner
+// CONTENT_APPROVER_ROLES (örn. "Admin,Editor") tanımlıysa RolePermissionConfig yerine bu liste kullanılır.
+func CanPublishContent(role types.Role) bool {
+	if role == types.RoleAdmin {
+		return true
+	}
+
+	if roles := os.Getenv("CONTENT_APPROVER_ROLES"); roles != "" {
+		for _, approver := range strings.Split(roles, ",") {
+			if strings.EqualFold(strings.TrimSpace(approver), string(role)) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return CheckPermission(role, PublishPost, "", "")
+}
@@ -0,0 +1,32 @@
+package configs
+
+import (
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	PRIVACY_RETENTION_HOUR       = 3   // Saklama süresi işleminin her gün çalıştığı saat
+	PRIVACY_RETENTION_BATCH_SIZE = 200 // Tek seferde anonimleştirilen başvuru sayısı
+	PRIVACY_DEFAULT_RETENTION    = 730 * 24 * time.Hour
+	PRIVACY_ANONYMIZED_NAME      = "Anonim Aday"
+)
+
+// PrivacyConfig - KVKK saklama politikası ayarları
+type PrivacyConfig struct {
+	ApplicationRetention time.Duration // Son işlemden bu süre sonra başvuru anonimleştirilir
+}
+
+// GetPrivacyConfig ortam değişkenlerinden saklama politikasını okur (APPLICANT_RETENTION_DAYS)
+func GetPrivacyConfig() PrivacyConfig {
+	config := PrivacyConfig{
+		ApplicationRetention: PRIVACY_DEFAULT_RETENTION,
+	}
+
+	if days, err := strconv.Atoi(os.Getenv("APPLICANT_RETENTION_DAYS")); err == nil && days > 0 {
+		config.ApplicationRetention = time.Duration(days) * 24 * time.Hour
+	}
+
+	return config
+}
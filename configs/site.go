@@ -0,0 +1,157 @@
+package configs
+
+import (
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+)
+
+// SiteConfig - Public site, SEO ve yapılandırılmış veri çıktılarında kullanılan ayarlar
+type SiteConfig struct {
+	BaseURL          string
+	OrganizationName string
+	OrganizationURL  string
+	OrganizationLogo string
+	Country          string
+	JobURLPattern    string
+	JobApplyPattern  string
+	JobAlertsPath    string
+	TrackingPath     string
+	PreviewPath      string
+	APIBaseURL       string
+	Languages        []string // Sitenin yayın dilleri, ilki varsayılan dil ve çevirilerin kaynağıdır
+
+	ContentURLPattern  string            // Tüm diller için içerik sayfası deseni ({lang} ve {slug} yer tutucuları)
+	ContentURLPatterns map[string]string // Dile özel desenler, tanımlı değilse ContentURLPattern kullanılır
+}
+
+// GetSiteConfig ortam değişkenlerinden site ayarlarını okur, eksik olanlar için varsayılan değer kullanır
+func GetSiteConfig() SiteConfig {
+	baseURL := strings.TrimRight(os.Getenv("SITE_BASE_URL"), "/")
+
+	site := SiteConfig{
+		BaseURL:          baseURL,
+		OrganizationName: os.Getenv("SITE_ORGANIZATION_NAME"),
+		OrganizationURL:  os.Getenv("SITE_ORGANIZATION_URL"),
+		OrganizationLogo: os.Getenv("SITE_ORGANIZATION_LOGO"),
+		Country:          os.Getenv("SITE_COUNTRY"),
+		JobURLPattern:    os.Getenv("SITE_JOB_URL_PATTERN"),
+		JobApplyPattern:  os.Getenv("SITE_JOB_APPLY_URL_PATTERN"),
+		JobAlertsPath:    os.Getenv("SITE_JOB_ALERTS_PATH"),
+		TrackingPath:     os.Getenv("SITE_APPLICATION_TRACKING_PATH"),
+		PreviewPath:      os.Getenv("SITE_PREVIEW_PATH"),
+		APIBaseURL:       strings.TrimRight(os.Getenv("SITE_API_URL"), "/"),
+	}
+
+	if site.OrganizationName == "" {
+		site.OrganizationName = PROJECT_NAME
+	}
+
+	if site.OrganizationURL == "" {
+		site.OrganizationURL = baseURL
+	}
+
+	if site.Country == "" {
+		site.Country = "TR"
+	}
+
+	if site.JobURLPattern == "" {
+		site.JobURLPattern = "/careers/{slug}"
+	}
+
+	if site.JobApplyPattern == "" {
+		site.JobApplyPattern = "/careers/{slug}/apply"
+	}
+
+	if site.JobAlertsPath == "" {
+		site.JobAlertsPath = "/careers/alerts"
+	}
+
+	if site.TrackingPath == "" {
+		site.TrackingPath = "/careers/applications"
+	}
+
+	if site.PreviewPath == "" {
+		site.PreviewPath = "/preview"
+	}
+
+	if site.APIBaseURL == "" {
+		site.APIBaseURL = baseURL
+	}
+
+	for _, language := range strings.Split(os.Getenv("SITE_LANGUAGES"), ",") {
+		if language = strings.ToLower(strings.TrimSpace(language)); language != "" {
+			site.Languages = append(site.Languages, language)
+		}
+	}
+
+	if len(site.Languages) == 0 {
+		site.Languages = []string{"tr", "en"}
+	}
+
+	site.ContentURLPattern = os.Getenv("SITE_CONTENT_URL_PATTERN")
+	if site.ContentURLPattern == "" {
+		site.ContentURLPattern = "/{lang}/{slug}"
+	}
+
+	// SITE_CONTENT_URL_PATTERNS="tr=/haberler/{slug},en=/en/news/{slug}"
+	site.ContentURLPatterns = make(map[string]string)
+	for _, pair := range strings.Split(os.Getenv("SITE_CONTENT_URL_PATTERNS"), ",") {
+		language, pattern, ok := strings.Cut(pair, "=")
+		if language = strings.ToLower(strings.TrimSpace(language)); ok && language != "" {
+			site.ContentURLPatterns[language] = strings.TrimSpace(pattern)
+		}
+	}
+
+	return site
+}
+
+// DefaultLanguage sitenin varsayılan dilini döner
+func (s SiteConfig) DefaultLanguage() string {
+	return s.Languages[0]
+}
+
+// HasLanguage dilin sitenin yayın dillerinden biri olup olmadığını kontrol eder
+func (s SiteConfig) HasLanguage(language string) bool {
+	return slices.Contains(s.Languages, language)
+}
+
+// ContentURL içeriğin verilen dildeki public sayfa adresini döner
+func (s SiteConfig) ContentURL(language, slug string) string {
+	pattern, ok := s.ContentURLPatterns[language]
+	if !ok || pattern == "" {
+		pattern = s.ContentURLPattern
+	}
+	return s.BaseURL + strings.NewReplacer("{lang}", language, "{slug}", slug).Replace(pattern)
+}
+
+// JobURL iş ilanının public sayfa adresini döner
+func (s SiteConfig) JobURL(slug string) string {
+	return s.BaseURL + strings.ReplaceAll(s.JobURLPattern, "{slug}", slug)
+}
+
+// JobApplyURL iş ilanının başvuru sayfası adresini döner
+func (s SiteConfig) JobApplyURL(slug string) string {
+	return s.BaseURL + strings.ReplaceAll(s.JobApplyPattern, "{slug}", slug)
+}
+
+// JobAlertURL iş alarmı sayfasındaki bir aksiyonun (confirm, manage, unsubscribe) token'lı linkini döner
+func (s SiteConfig) JobAlertURL(action, token string) string {
+	return s.BaseURL + s.JobAlertsPath + "/" + action + "?token=" + url.QueryEscape(token)
+}
+
+// JobAlertOneClickUnsubscribeURL e-posta istemcilerinin doğrudan çağırdığı abonelikten çıkma adresini döner (RFC 8058)
+func (s SiteConfig) JobAlertOneClickUnsubscribeURL(token string) string {
+	return s.APIBaseURL + "/public/job-alerts/unsubscribe?token=" + url.QueryEscape(token)
+}
+
+// TrackingURL adayın başvurularını ve mülakatlarını takip ettiği sayfanın adresini döner
+func (s SiteConfig) TrackingURL() string {
+	return s.BaseURL + s.TrackingPath
+}
+
+// PreviewURL taslak önizleme sayfasının imzalı linkini döner (örn. /preview/content?token=...)
+func (s SiteConfig) PreviewURL(resource, token string) string {
+	return s.BaseURL + s.PreviewPath + "/" + resource + "?token=" + url.QueryEscape(token)
+}
@@ -0,0 +1,23 @@
+-- İndeksleri kaldır
+DROP INDEX IF EXISTS idx_job_alert_subscriptions_status;
+
+DROP INDEX IF EXISTS idx_job_alert_subscriptions_email;
+
+DROP INDEX IF EXISTS idx_job_postings_published_at;
+
+-- Tabloları kaldır
+DROP TABLE IF EXISTS job_alert_deliveries;
+
+DROP TABLE IF EXISTS job_alert_subscriptions;
+
+-- Enum tipleri kaldır
+DROP TYPE IF EXISTS job_alert_frequency;
+
+DROP TYPE IF EXISTS job_alert_status;
+
+-- Trigger ve fonksiyonu kaldır
+DROP TRIGGER IF EXISTS trigger_job_published_at ON job_postings;
+
+DROP FUNCTION IF EXISTS update_job_published_at () CASCADE;
+
+ALTER TABLE job_postings DROP COLUMN IF EXISTS published_at;
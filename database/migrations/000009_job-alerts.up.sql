@@ -0,0 +1,59 @@
+-- İlanın ilk yayınlandığı an (bildirimler ve yapılandırılmış veri için)
+ALTER TABLE job_postings ADD COLUMN IF NOT EXISTS published_at TIMESTAMPTZ;
+
+UPDATE job_postings SET published_at = updated_at WHERE status = 'published' AND published_at IS NULL;
+
+-- Durum 'published' olduğunda published_at alanını güncelleme
+CREATE OR REPLACE FUNCTION update_job_published_at()
+RETURNS TRIGGER AS $$
+BEGIN
+  IF NEW.status = 'published' AND (TG_OP = 'INSERT' OR OLD.status != 'published') THEN
+    NEW.published_at = NOW();
+  END IF;
+
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trigger_job_published_at
+BEFORE INSERT OR UPDATE OF status ON job_postings
+FOR EACH ROW
+EXECUTE FUNCTION update_job_published_at();
+
+-- İş Alarmı Durumu ENUM'u
+CREATE TYPE job_alert_status AS ENUM ('pending', 'active', 'unsubscribed');
+
+-- İş Alarmı Sıklığı ENUM'u
+CREATE TYPE job_alert_frequency AS ENUM ('instant', 'daily');
+
+-- İş Alarmı Abonelikleri Tablosu (hesap gerektirmez, e-posta ile yönetilir)
+CREATE TABLE IF NOT EXISTS job_alert_subscriptions (
+    id UUID DEFAULT uuid_generate_v4 () PRIMARY KEY,
+    email TEXT NOT NULL,
+    categories TEXT[] NOT NULL DEFAULT '{}', -- job_categories.name listesi, boşsa tüm kategoriler
+    location TEXT NOT NULL DEFAULT '', -- Boşsa tüm lokasyonlar
+    work_mode TEXT NOT NULL DEFAULT '', -- Boşsa tüm çalışma şekilleri
+    frequency job_alert_frequency DEFAULT 'daily' NOT NULL,
+    status job_alert_status DEFAULT 'pending' NOT NULL,
+    ip_address TEXT,
+    confirmed_at TIMESTAMPTZ, -- Çift onay (double opt-in) zamanı
+    unsubscribed_at TIMESTAMPTZ,
+    last_notified_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW () NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT NOW () NOT NULL
+);
+
+-- Gönderilmiş ilanlar (aynı ilanın aynı aboneye iki kez gitmemesi için)
+CREATE TABLE IF NOT EXISTS job_alert_deliveries (
+    subscription_id UUID NOT NULL REFERENCES job_alert_subscriptions (id) ON DELETE CASCADE,
+    job_id UUID NOT NULL REFERENCES job_postings (id) ON DELETE CASCADE,
+    sent_at TIMESTAMPTZ DEFAULT NOW () NOT NULL,
+    PRIMARY KEY (subscription_id, job_id)
+);
+
+-- İndeksler
+CREATE INDEX idx_job_postings_published_at ON job_postings (published_at);
+
+CREATE INDEX idx_job_alert_subscriptions_email ON job_alert_subscriptions (email);
+
+CREATE INDEX idx_job_alert_subscriptions_status ON job_alert_subscriptions (status);
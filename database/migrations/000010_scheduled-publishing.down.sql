@@ -0,0 +1,26 @@
+-- İndeksleri kaldır
+DROP INDEX IF EXISTS idx_contents_unpublish_at;
+
+DROP INDEX IF EXISTS idx_contents_publish_at;
+
+DROP INDEX IF EXISTS idx_job_postings_unpublish_at;
+
+DROP INDEX IF EXISTS idx_job_postings_publish_at;
+
+-- Kısıtları kaldır
+ALTER TABLE contents DROP CONSTRAINT IF EXISTS chk_contents_schedule;
+
+ALTER TABLE job_postings DROP CONSTRAINT IF EXISTS chk_job_postings_schedule;
+
+-- Kolonları kaldır
+ALTER TABLE contents
+    DROP COLUMN IF EXISTS scheduled_at,
+    DROP COLUMN IF EXISTS scheduled_by,
+    DROP COLUMN IF EXISTS unpublish_at,
+    DROP COLUMN IF EXISTS publish_at;
+
+ALTER TABLE job_postings
+    DROP COLUMN IF EXISTS scheduled_at,
+    DROP COLUMN IF EXISTS scheduled_by,
+    DROP COLUMN IF EXISTS unpublish_at,
+    DROP COLUMN IF EXISTS publish_at;
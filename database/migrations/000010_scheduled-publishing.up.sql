@@ -0,0 +1,32 @@
+-- İş ilanları için zamanlanmış yayın alanları
+ALTER TABLE job_postings
+    ADD COLUMN IF NOT EXISTS publish_at TIMESTAMPTZ, -- Bu zamanda otomatik yayınlanır
+    ADD COLUMN IF NOT EXISTS unpublish_at TIMESTAMPTZ, -- Bu zamanda otomatik kapatılır
+    ADD COLUMN IF NOT EXISTS scheduled_by UUID REFERENCES users (id) ON DELETE SET NULL, -- Zamanlamayı yapan kullanıcı
+    ADD COLUMN IF NOT EXISTS scheduled_at TIMESTAMPTZ; -- Zamanlamanın yapıldığı an
+
+ALTER TABLE job_postings
+    ADD CONSTRAINT chk_job_postings_schedule CHECK (
+        publish_at IS NULL OR unpublish_at IS NULL OR unpublish_at > publish_at
+    );
+
+-- İçerikler için zamanlanmış yayın alanları
+ALTER TABLE contents
+    ADD COLUMN IF NOT EXISTS publish_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS unpublish_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS scheduled_by UUID REFERENCES users (id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS scheduled_at TIMESTAMPTZ;
+
+ALTER TABLE contents
+    ADD CONSTRAINT chk_contents_schedule CHECK (
+        publish_at IS NULL OR unpublish_at IS NULL OR unpublish_at > publish_at
+    );
+
+-- Zamanlayıcının taradığı kayıtlar için kısmi indeksler
+CREATE INDEX IF NOT EXISTS idx_job_postings_publish_at ON job_postings (publish_at) WHERE publish_at IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_job_postings_unpublish_at ON job_postings (unpublish_at) WHERE unpublish_at IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_contents_publish_at ON contents (publish_at) WHERE publish_at IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_contents_unpublish_at ON contents (unpublish_at) WHERE unpublish_at IS NOT NULL;
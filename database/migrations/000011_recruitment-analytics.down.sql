@@ -0,0 +1,19 @@
+-- İndeksleri kaldır
+DROP INDEX IF EXISTS idx_job_applications_source;
+
+DROP INDEX IF EXISTS idx_job_applications_created_at;
+
+DROP INDEX IF EXISTS idx_job_application_status_history_to_status;
+
+DROP INDEX IF EXISTS idx_job_application_status_history_application_id;
+
+-- Trigger ve fonksiyonu kaldır
+DROP TRIGGER IF EXISTS trg_job_application_status_history ON job_applications;
+
+DROP FUNCTION IF EXISTS log_job_application_status () CASCADE;
+
+-- Tabloyu kaldır
+DROP TABLE IF EXISTS job_application_status_history;
+
+-- Kolonu kaldır
+ALTER TABLE job_applications DROP COLUMN IF EXISTS source;
@@ -0,0 +1,50 @@
+-- Başvurunun geldiği kanal (linkedin, indeed, kariyer.net, direct, vb.)
+ALTER TABLE job_applications ADD COLUMN IF NOT EXISTS source TEXT NOT NULL DEFAULT 'direct';
+
+-- Başvuru Durum Geçmişi Tablosu (aşama süreleri ve dönüşüm oranları için)
+CREATE TABLE IF NOT EXISTS job_application_status_history (
+    id UUID DEFAULT uuid_generate_v4 () PRIMARY KEY,
+    application_id UUID NOT NULL REFERENCES job_applications (id) ON DELETE CASCADE,
+    from_status TEXT, -- İlk kayıtta NULL
+    to_status TEXT NOT NULL,
+    changed_by UUID REFERENCES users (id) ON DELETE SET NULL, -- Başvuru oluşturulurken NULL
+    changed_at TIMESTAMPTZ DEFAULT NOW () NOT NULL
+);
+
+-- Durum değişikliklerini geçmiş tablosuna yazan fonksiyon
+-- Değişikliği yapan kullanıcı, transaction içinde app.current_user_id ayarı ile verilir
+CREATE OR REPLACE FUNCTION log_job_application_status()
+RETURNS TRIGGER AS $$
+BEGIN
+  IF TG_OP = 'INSERT' THEN
+    INSERT INTO job_application_status_history (application_id, from_status, to_status, changed_at)
+    VALUES (NEW.id, NULL, NEW.status, NEW.created_at);
+  ELSIF NEW.status IS DISTINCT FROM OLD.status THEN
+    INSERT INTO job_application_status_history (application_id, from_status, to_status, changed_by)
+    VALUES (NEW.id, OLD.status, NEW.status, NULLIF(current_setting('app.current_user_id', true), '')::uuid);
+  END IF;
+
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_job_application_status_history
+AFTER INSERT OR UPDATE OF status ON job_applications
+FOR EACH ROW
+EXECUTE FUNCTION log_job_application_status();
+
+-- Mevcut başvurular için geçmişi oluştur (ilk kayıt + bilinen son durum)
+INSERT INTO job_application_status_history (application_id, from_status, to_status, changed_at)
+SELECT id, NULL, 'received', created_at FROM job_applications;
+
+INSERT INTO job_application_status_history (application_id, from_status, to_status, changed_at)
+SELECT id, 'received', status, updated_at FROM job_applications WHERE status != 'received';
+
+-- İndeksler
+CREATE INDEX idx_job_application_status_history_application_id ON job_application_status_history (application_id, changed_at);
+
+CREATE INDEX idx_job_application_status_history_to_status ON job_application_status_history (to_status);
+
+CREATE INDEX idx_job_applications_created_at ON job_applications (created_at);
+
+CREATE INDEX idx_job_applications_source ON job_applications (source);
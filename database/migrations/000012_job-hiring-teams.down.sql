@@ -0,0 +1,8 @@
+-- İndeksleri kaldır
+DROP INDEX IF EXISTS idx_job_hiring_team_user_id;
+
+-- Tabloyu kaldır
+DROP TABLE IF EXISTS job_hiring_team;
+
+-- Enum tipini kaldır
+DROP TYPE IF EXISTS job_team_role;
@@ -0,0 +1,20 @@
+-- İşe Alım Ekibi Rolü ENUM'u
+CREATE TYPE job_team_role AS ENUM ('owner', 'recruiter', 'interviewer');
+
+-- İlan bazında işe alım ekibi (başvurulara erişim bu tablo ile sınırlandırılır)
+CREATE TABLE IF NOT EXISTS job_hiring_team (
+    job_id UUID NOT NULL REFERENCES job_postings (id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    role job_team_role NOT NULL,
+    added_by UUID REFERENCES users (id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW () NOT NULL,
+    PRIMARY KEY (job_id, user_id)
+);
+
+-- Mevcut ilanları oluşturan kullanıcıları sahip olarak ekle
+INSERT INTO job_hiring_team (job_id, user_id, role, added_by)
+SELECT id, user_id, 'owner', user_id FROM job_postings
+ON CONFLICT (job_id, user_id) DO NOTHING;
+
+-- İndeksler
+CREATE INDEX idx_job_hiring_team_user_id ON job_hiring_team (user_id);
@@ -0,0 +1,14 @@
+-- İndeksleri kaldır
+DROP INDEX IF EXISTS idx_job_interview_interviewers_user_id;
+
+DROP INDEX IF EXISTS idx_job_interviews_starts_at;
+
+DROP INDEX IF EXISTS idx_job_interviews_application_id;
+
+-- Tabloları kaldır
+DROP TABLE IF EXISTS job_interview_interviewers;
+
+DROP TABLE IF EXISTS job_interviews;
+
+-- ENUM'u kaldır
+DROP TYPE IF EXISTS job_interview_status;
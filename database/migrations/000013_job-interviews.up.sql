@@ -0,0 +1,41 @@
+-- Mülakat Durumu ENUM'u
+CREATE TYPE job_interview_status AS ENUM (
+    'scheduled', -- Planlandı, aday onayı bekleniyor
+    'confirmed', -- Aday onayladı
+    'reschedule_requested', -- Aday yeni zaman istedi
+    'cancelled',
+    'completed'
+);
+
+-- Başvurulara bağlı mülakatlar
+CREATE TABLE IF NOT EXISTS job_interviews (
+    id UUID DEFAULT uuid_generate_v4 () PRIMARY KEY,
+    application_id UUID NOT NULL REFERENCES job_applications (id) ON DELETE CASCADE,
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+    location TEXT NOT NULL DEFAULT '', -- Yüz yüze mülakat adresi
+    video_url TEXT NOT NULL DEFAULT '', -- Online mülakat bağlantısı
+    notes TEXT NOT NULL DEFAULT '', -- Adaya iletilen not
+    status job_interview_status DEFAULT 'scheduled' NOT NULL,
+    sequence INTEGER DEFAULT 0 NOT NULL, -- iCalendar SEQUENCE, her yeniden planlamada artar
+    candidate_note TEXT NOT NULL DEFAULT '', -- Adayın yeniden planlama talebindeki notu
+    candidate_responded_at TIMESTAMPTZ,
+    created_by UUID REFERENCES users (id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW () NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT NOW () NOT NULL,
+    CONSTRAINT chk_job_interviews_time CHECK (ends_at > starts_at)
+);
+
+-- Mülakatçılar
+CREATE TABLE IF NOT EXISTS job_interview_interviewers (
+    interview_id UUID NOT NULL REFERENCES job_interviews (id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    PRIMARY KEY (interview_id, user_id)
+);
+
+-- İndeksler
+CREATE INDEX idx_job_interviews_application_id ON job_interviews (application_id);
+
+CREATE INDEX idx_job_interviews_starts_at ON job_interviews (starts_at);
+
+CREATE INDEX idx_job_interview_interviewers_user_id ON job_interview_interviewers (user_id);
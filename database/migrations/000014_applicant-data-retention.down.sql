@@ -0,0 +1,17 @@
+-- İndeksleri kaldır
+DROP INDEX IF EXISTS idx_data_privacy_requests_requested_at;
+
+DROP INDEX IF EXISTS idx_data_privacy_requests_email_hash;
+
+DROP INDEX IF EXISTS idx_job_applications_updated_at;
+
+-- Tabloyu kaldır
+DROP TABLE IF EXISTS data_privacy_requests;
+
+-- ENUM'ları kaldır
+DROP TYPE IF EXISTS data_privacy_request_status;
+
+DROP TYPE IF EXISTS data_privacy_request_type;
+
+-- Kolonu kaldır
+ALTER TABLE job_applications DROP COLUMN IF EXISTS anonymized_at;
@@ -0,0 +1,36 @@
+-- Anonimleştirilen başvurular (KVKK saklama süresi veya aday talebi)
+ALTER TABLE job_applications ADD COLUMN IF NOT EXISTS anonymized_at TIMESTAMPTZ;
+
+-- Kişisel Veri Talebi Tipi ENUM'u
+CREATE TYPE data_privacy_request_type AS ENUM (
+    'export', -- Aday verilerinin dışa aktarımı
+    'delete', -- Aday verilerinin silinmesi
+    'retention' -- Saklama süresi dolan başvuruların otomatik anonimleştirilmesi
+);
+
+-- Kişisel Veri Talebi Durumu ENUM'u
+CREATE TYPE data_privacy_request_status AS ENUM ('pending', 'completed', 'failed');
+
+-- Kişisel veri taleplerinin yerine getirildiğini kanıtlayan kayıt
+-- E-posta adresi saklanmaz, sadece SHA-256 özeti tutulur (silme talebinden sonra da eşleştirilebilir)
+CREATE TABLE IF NOT EXISTS data_privacy_requests (
+    id UUID DEFAULT uuid_generate_v4 () PRIMARY KEY,
+    request_type data_privacy_request_type NOT NULL,
+    status data_privacy_request_status DEFAULT 'pending' NOT NULL,
+    email_hash TEXT, -- Otomatik saklama süresi işlemlerinde boş
+    ip_address TEXT,
+    applications_affected INTEGER DEFAULT 0 NOT NULL,
+    files_deleted INTEGER DEFAULT 0 NOT NULL,
+    error TEXT NOT NULL DEFAULT '',
+    requested_at TIMESTAMPTZ DEFAULT NOW () NOT NULL,
+    completed_at TIMESTAMPTZ
+);
+
+-- İndeksler
+CREATE INDEX idx_job_applications_updated_at ON job_applications (updated_at)
+WHERE
+    anonymized_at IS NULL;
+
+CREATE INDEX idx_data_privacy_requests_email_hash ON data_privacy_requests (email_hash);
+
+CREATE INDEX idx_data_privacy_requests_requested_at ON data_privacy_requests (requested_at);
@@ -0,0 +1,13 @@
+-- İndeksleri kaldır
+DROP INDEX IF EXISTS idx_email_outbox_pending;
+
+DROP INDEX IF EXISTS idx_job_applications_tags;
+
+-- Tabloyu kaldır
+DROP TABLE IF EXISTS email_outbox;
+
+-- ENUM'u kaldır
+DROP TYPE IF EXISTS email_outbox_status;
+
+-- Kolonu kaldır
+ALTER TABLE job_applications DROP COLUMN IF EXISTS tags;
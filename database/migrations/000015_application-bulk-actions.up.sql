@@ -0,0 +1,28 @@
+-- Başvuru etiketleri (ekip içi sınıflandırma, adaylara gösterilmez)
+ALTER TABLE job_applications ADD COLUMN IF NOT EXISTS tags TEXT[] NOT NULL DEFAULT '{}';
+
+-- E-posta Kuyruğu Durumu ENUM'u
+CREATE TYPE email_outbox_status AS ENUM ('pending', 'sending', 'sent', 'failed');
+
+-- Gönderilecek e-postalar kuyruğu (toplu işlemlerle aynı transaction içinde yazılır, arka planda gönderilir)
+CREATE TABLE IF NOT EXISTS email_outbox (
+    id UUID DEFAULT uuid_generate_v4 () PRIMARY KEY,
+    to_email TEXT NOT NULL,
+    subject TEXT NOT NULL,
+    text_body TEXT NOT NULL,
+    html_body TEXT NOT NULL DEFAULT '',
+    status email_outbox_status DEFAULT 'pending' NOT NULL,
+    attempts INTEGER DEFAULT 0 NOT NULL,
+    last_error TEXT NOT NULL DEFAULT '',
+    send_after TIMESTAMPTZ DEFAULT NOW () NOT NULL, -- Başarısız gönderimler bu zamandan sonra tekrar denenir
+    locked_at TIMESTAMPTZ, -- Gönderim sırasında alınan kilit zamanı
+    sent_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW () NOT NULL
+);
+
+-- İndeksler
+CREATE INDEX idx_job_applications_tags ON job_applications USING GIN (tags);
+
+CREATE INDEX idx_email_outbox_pending ON email_outbox (send_after)
+WHERE
+    status IN ('pending', 'sending');
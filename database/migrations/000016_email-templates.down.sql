@@ -0,0 +1,5 @@
+-- Tabloyu kaldır
+DROP TABLE IF EXISTS email_templates;
+
+-- Kolonu kaldır
+ALTER TABLE job_applications DROP COLUMN IF EXISTS language;
@@ -0,0 +1,35 @@
+-- Başvurunun dili (aday e-postalarının hangi dilde gönderileceğini belirler)
+ALTER TABLE job_applications ADD COLUMN IF NOT EXISTS language TEXT NOT NULL DEFAULT 'tr';
+
+-- Aday e-posta şablonları (olay + durum + dil başına bir şablon)
+-- status boş ise şablon o olayın tüm durumları için varsayılandır (sadece status_changed olayında anlamlıdır)
+CREATE TABLE IF NOT EXISTS email_templates (
+    id UUID DEFAULT uuid_generate_v4 () PRIMARY KEY,
+    event TEXT NOT NULL, -- 'application_received', 'status_changed', 'application_deleted'
+    status TEXT NOT NULL DEFAULT '',
+    language TEXT NOT NULL,
+    subject TEXT NOT NULL,
+    text_body TEXT NOT NULL,
+    html_body TEXT NOT NULL DEFAULT '',
+    is_active BOOLEAN DEFAULT TRUE NOT NULL,
+    updated_by UUID REFERENCES users (id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW () NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT NOW () NOT NULL,
+    CONSTRAINT uq_email_template UNIQUE (event, status, language)
+);
+
+-- Varsayılan şablonlar
+INSERT INTO email_templates (event, status, language, subject, text_body) VALUES
+    ('application_received', '', 'tr', 'Başvurunuz alındı: {{job_title}}',
+     E'Merhaba {{candidate_name}},\n\n{{job_title}} pozisyonuna yaptığınız başvuru bize ulaştı. Değerlendirme süreci hakkında sizi bilgilendireceğiz.\n\nBaşvurularınızı takip etmek için: {{tracking_link}}\n\n{{organization}}'),
+    ('application_received', '', 'en', 'We received your application: {{job_title}}',
+     E'Hello {{candidate_name}},\n\nThank you for applying for the {{job_title}} position. We will keep you updated about the process.\n\nTrack your applications: {{tracking_link}}\n\n{{organization}}'),
+    ('status_changed', '', 'tr', 'Başvurunuz güncellendi: {{job_title}}',
+     E'Merhaba {{candidate_name}},\n\n{{job_title}} pozisyonuna yaptığınız başvurunun durumu güncellendi: {{status}}\n\n{{note}}\n\nBaşvurularınızı takip etmek için: {{tracking_link}}\n\n{{organization}}'),
+    ('status_changed', '', 'en', 'Your application was updated: {{job_title}}',
+     E'Hello {{candidate_name}},\n\nThe status of your application for the {{job_title}} position was updated: {{status}}\n\n{{note}}\n\nTrack your applications: {{tracking_link}}\n\n{{organization}}'),
+    ('application_deleted', '', 'tr', 'Başvurunuz kaldırıldı: {{job_title}}',
+     E'Merhaba {{candidate_name}},\n\n{{job_title}} pozisyonuna yaptığınız başvuru sistemimizden kaldırıldı.\n\n{{note}}\n\n{{organization}}'),
+    ('application_deleted', '', 'en', 'Your application was removed: {{job_title}}',
+     E'Hello {{candidate_name}},\n\nYour application for the {{job_title}} position was removed from our system.\n\n{{note}}\n\n{{organization}}')
+ON CONFLICT (event, status, language) DO NOTHING;
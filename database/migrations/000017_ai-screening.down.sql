@@ -0,0 +1,4 @@
+-- Kolonları kaldır
+ALTER TABLE job_applications DROP COLUMN IF EXISTS ai_screened_at;
+
+ALTER TABLE job_applications DROP COLUMN IF EXISTS ai_screening;
@@ -0,0 +1,4 @@
+-- Yapay zeka ön değerlendirme sonucu (son değerlendirme ve maliyeti başvuru üzerinde saklanır)
+ALTER TABLE job_applications ADD COLUMN IF NOT EXISTS ai_screening JSONB;
+
+ALTER TABLE job_applications ADD COLUMN IF NOT EXISTS ai_screened_at TIMESTAMPTZ;
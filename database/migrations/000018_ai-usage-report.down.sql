@@ -0,0 +1,9 @@
+-- İndeksleri kaldır
+DROP INDEX IF EXISTS idx_ai_usage_feature_created_at;
+
+DROP INDEX IF EXISTS idx_ai_usage_created_at;
+
+DROP INDEX IF EXISTS idx_ai_usage_user_created_at;
+
+-- Tabloyu kaldır
+DROP TABLE IF EXISTS ai_usage;
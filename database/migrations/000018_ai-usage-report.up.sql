@@ -0,0 +1,20 @@
+-- Yapay zeka kullanım kaydı (her model çağrısının token ve maliyet bilgisi)
+CREATE TABLE IF NOT EXISTS ai_usage (
+    id UUID DEFAULT uuid_generate_v4 () PRIMARY KEY,
+    feature TEXT NOT NULL, -- 'application_screening' vb.
+    model TEXT NOT NULL,
+    input_tokens INTEGER DEFAULT 0 NOT NULL,
+    output_tokens INTEGER DEFAULT 0 NOT NULL,
+    cost_usd NUMERIC(12, 6) DEFAULT 0 NOT NULL,
+    user_id UUID REFERENCES users (id) ON DELETE SET NULL,
+    reference_id UUID, -- İlgili kayıt (örn. başvuru ID'si)
+    created_at TIMESTAMPTZ DEFAULT NOW () NOT NULL
+);
+
+-- İndeksler
+CREATE INDEX idx_ai_usage_user_created_at ON ai_usage (user_id, created_at);
+
+CREATE INDEX idx_ai_usage_created_at ON ai_usage (created_at);
+
+-- Yapay zeka harcama raporu için özellik bazında indeks
+CREATE INDEX IF NOT EXISTS idx_ai_usage_feature_created_at ON ai_usage (feature, created_at);
@@ -0,0 +1,2 @@
+-- Tabloyu kaldır
+DROP TABLE IF EXISTS content_revisions;
@@ -0,0 +1,35 @@
+-- İÇERİK REVİZYONLARI
+-- Her oluşturma ve güncellemede içeriğin tam anlık görüntüsü saklanır
+CREATE TABLE IF NOT EXISTS content_revisions (
+    id UUID DEFAULT uuid_generate_v4 () PRIMARY KEY,
+    content_id UUID NOT NULL REFERENCES contents (id) ON DELETE CASCADE,
+    revision INTEGER NOT NULL,
+    slug TEXT NOT NULL,
+    identifier TEXT NOT NULL,
+    language TEXT NOT NULL,
+    title TEXT NOT NULL,
+    description TEXT,
+    category TEXT NOT NULL DEFAULT '',
+    image_url TEXT,
+    details_json JSONB,
+    content_json JSONB NOT NULL,
+    content_html TEXT NOT NULL,
+    status content_status NOT NULL,
+    author_id UUID REFERENCES users (id) ON DELETE SET NULL,
+    change_note TEXT NOT NULL DEFAULT '',
+    restored_from INTEGER, -- Geri yüklenen revizyon numarası
+    created_at TIMESTAMPTZ DEFAULT NOW () NOT NULL,
+    CONSTRAINT uq_content_revision UNIQUE (content_id, revision)
+);
+
+-- Mevcut içerikler ilk revizyon olarak kaydedilir
+INSERT INTO content_revisions (
+    content_id, revision, slug, identifier, language, title, description,
+    category, image_url, details_json, content_json, content_html, status,
+    author_id, change_note, created_at
+)
+SELECT
+    id, 1, slug, identifier, language, title, description,
+    category, image_url, details_json, content_json, content_html, status,
+    user_id, 'İlk revizyon', updated_at
+FROM contents;
@@ -0,0 +1,15 @@
+-- Tabloyu kaldır
+DROP TABLE IF EXISTS edit_locks;
+
+-- Önce trigger'ları kaldırın
+DROP TRIGGER IF EXISTS trg_contents_version ON contents;
+
+DROP TRIGGER IF EXISTS trg_job_postings_version ON job_postings;
+
+-- Sonra fonksiyonu kaldırın
+DROP FUNCTION IF EXISTS increment_row_version () CASCADE;
+
+-- Sütunları kaldır
+ALTER TABLE contents DROP COLUMN IF EXISTS version;
+
+ALTER TABLE job_postings DROP COLUMN IF EXISTS version;
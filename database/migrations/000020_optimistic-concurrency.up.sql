@@ -0,0 +1,35 @@
+-- EŞZAMANLI DÜZENLEME KONTROLÜ
+-- Her güncellemede artan sürüm numarası, ETag / If-Match kontrolünde kullanılır
+ALTER TABLE contents ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
+
+ALTER TABLE job_postings ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
+
+-- Sürüm numarasını artıran fonksiyon (durum ve zamanlama değişiklikleri dahil tüm güncellemeler)
+CREATE OR REPLACE FUNCTION increment_row_version()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.version = OLD.version + 1;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_contents_version
+BEFORE UPDATE ON contents
+FOR EACH ROW
+EXECUTE FUNCTION increment_row_version();
+
+CREATE TRIGGER trg_job_postings_version
+BEFORE UPDATE ON job_postings
+FOR EACH ROW
+EXECUTE FUNCTION increment_row_version();
+
+-- DÜZENLEME KİLİTLERİ
+-- Danışma niteliğindedir: kaydetmeyi engellemez, kimin düzenlediğini gösterir
+CREATE TABLE IF NOT EXISTS edit_locks (
+    resource_type TEXT NOT NULL CHECK (resource_type IN ('content', 'job')),
+    resource_id UUID NOT NULL,
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    acquired_at TIMESTAMPTZ DEFAULT NOW () NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (resource_type, resource_id)
+);
@@ -0,0 +1,5 @@
+-- İndeksi kaldır
+DROP INDEX IF EXISTS idx_preview_links_resource;
+
+-- Tabloyu kaldır
+DROP TABLE IF EXISTS preview_links;
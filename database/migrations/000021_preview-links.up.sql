@@ -0,0 +1,17 @@
+-- TASLAK ÖNİZLEME LİNKLERİ
+-- Hesabı olmayan paydaşların taslak içerik ve ilanları görmesi için imzalı, süreli linkler
+-- Token'ın kendisi saklanmaz; token içindeki ID bu kayda karşılık gelir ve iptal buradan yapılır
+CREATE TABLE IF NOT EXISTS preview_links (
+    id UUID DEFAULT uuid_generate_v4 () PRIMARY KEY,
+    resource_type TEXT NOT NULL CHECK (resource_type IN ('content', 'job')),
+    resource_id UUID NOT NULL,
+    created_by UUID REFERENCES users (id) ON DELETE SET NULL,
+    note TEXT NOT NULL DEFAULT '',
+    expires_at TIMESTAMPTZ NOT NULL,
+    revoked_at TIMESTAMPTZ,
+    view_count INTEGER NOT NULL DEFAULT 0,
+    last_viewed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW () NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_preview_links_resource ON preview_links (resource_type, resource_id);
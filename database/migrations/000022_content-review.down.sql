@@ -0,0 +1,14 @@
+-- Önce trigger'ı kaldırın
+DROP TRIGGER IF EXISTS trg_contents_status_transition ON contents;
+
+-- Sonra fonksiyonu kaldırın
+DROP FUNCTION IF EXISTS log_content_status_transition () CASCADE;
+
+-- Tabloyu kaldır
+DROP TABLE IF EXISTS content_status_transitions;
+
+-- İncelemedeki içerikler taslağa döner
+-- PostgreSQL enum değerlerini kaldırmayı desteklemediği için 'in_review' değeri tipte kalır
+UPDATE contents SET status = 'draft' WHERE status = 'in_review';
+
+UPDATE content_revisions SET status = 'draft' WHERE status = 'in_review';
@@ -0,0 +1,42 @@
+-- İÇERİK İNCELEME VE ONAY AKIŞI
+-- Taslak -> incelemede -> yayında; yayınlama yetkisi onaylayıcı rollere aittir
+ALTER TYPE content_status ADD VALUE IF NOT EXISTS 'in_review' AFTER 'draft';
+
+-- Her durum geçişinin kaydı
+CREATE TABLE IF NOT EXISTS content_status_transitions (
+    id UUID DEFAULT uuid_generate_v4 () PRIMARY KEY,
+    content_id UUID NOT NULL REFERENCES contents (id) ON DELETE CASCADE,
+    from_status content_status,
+    to_status content_status NOT NULL,
+    action TEXT NOT NULL DEFAULT 'status_change', -- submit, approve, request_changes, schedule, status_change
+    actor_id UUID REFERENCES users (id) ON DELETE SET NULL,
+    comment TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ DEFAULT NOW () NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_content_transitions_content ON content_status_transitions (content_id, created_at);
+
+-- Durum geçişlerini kaydeden fonksiyon
+-- İşlemi yapan kullanıcı, aksiyon ve yorum aynı transaction içinde set_config ile verilir;
+-- verilmezse (zamanlayıcı, silme vb.) kullanıcı boş ve aksiyon 'status_change' olarak kaydedilir.
+CREATE OR REPLACE FUNCTION log_content_status_transition()
+RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO content_status_transitions (content_id, from_status, to_status, action, actor_id, comment)
+    VALUES (
+        NEW.id,
+        OLD.status,
+        NEW.status,
+        COALESCE(NULLIF(current_setting('app.transition_action', true), ''), 'status_change'),
+        NULLIF(current_setting('app.transition_actor', true), '')::uuid,
+        COALESCE(current_setting('app.transition_comment', true), '')
+    );
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_contents_status_transition
+AFTER UPDATE OF status ON contents
+FOR EACH ROW
+WHEN (OLD.status IS DISTINCT FROM NEW.status)
+EXECUTE FUNCTION log_content_status_transition();
@@ -0,0 +1,13 @@
+-- İndeksi ve sütunu kaldır (category sütunu slug değerleriyle kalır)
+DROP INDEX IF EXISTS idx_contents_category_id;
+
+ALTER TABLE contents DROP COLUMN IF EXISTS category_id;
+
+-- Tabloları kaldır
+DROP TABLE IF EXISTS content_tag_links;
+
+DROP TABLE IF EXISTS content_tags;
+
+DROP TABLE IF EXISTS content_category_translations;
+
+DROP TABLE IF EXISTS content_categories;
@@ -0,0 +1,71 @@
+-- İÇERİK KATEGORİLERİ
+-- Serbest metin kategori yerine yönetilen, hiyerarşik ve dillere çevrilmiş kategoriler
+CREATE TABLE IF NOT EXISTS content_categories (
+    id UUID DEFAULT uuid_generate_v4 () PRIMARY KEY,
+    slug TEXT NOT NULL UNIQUE,
+    parent_id UUID REFERENCES content_categories (id) ON DELETE RESTRICT,
+    sort_order INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ DEFAULT NOW () NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT NOW () NOT NULL,
+    CONSTRAINT chk_category_parent CHECK (parent_id IS NULL OR parent_id <> id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_content_categories_parent ON content_categories (parent_id);
+
+-- Kategori adlarının dillere göre çevirileri
+CREATE TABLE IF NOT EXISTS content_category_translations (
+    category_id UUID NOT NULL REFERENCES content_categories (id) ON DELETE CASCADE,
+    language TEXT NOT NULL,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (category_id, language)
+);
+
+-- İÇERİK ETİKETLERİ
+CREATE TABLE IF NOT EXISTS content_tags (
+    id UUID DEFAULT uuid_generate_v4 () PRIMARY KEY,
+    slug TEXT NOT NULL UNIQUE,
+    name TEXT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW () NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS content_tag_links (
+    content_id UUID NOT NULL REFERENCES contents (id) ON DELETE CASCADE,
+    tag_id UUID NOT NULL REFERENCES content_tags (id) ON DELETE CASCADE,
+    PRIMARY KEY (content_id, tag_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_content_tag_links_tag ON content_tag_links (tag_id);
+
+-- İçeriklerin yönetilen kategoriye bağlantısı (category sütunu kategori slug'ını tutmaya devam eder)
+ALTER TABLE contents ADD COLUMN IF NOT EXISTS category_id UUID REFERENCES content_categories (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_contents_category_id ON contents (category_id);
+
+-- Mevcut serbest metin kategoriler taşınır; "News" ve "news" aynı kategori olur
+INSERT INTO content_categories (slug)
+SELECT DISTINCT slug
+FROM (
+    SELECT trim(both '-' from regexp_replace(lower(translate(category, 'ÇĞİÖŞÜçğıöşü', 'CGIOSUcgiosu')), '[^a-z0-9]+', '-', 'g')) AS slug
+    FROM contents
+) s
+WHERE slug <> ''
+ON CONFLICT (slug) DO NOTHING;
+
+-- Her dil için en çok kullanılan yazım kategori adı olur
+INSERT INTO content_category_translations (category_id, language, name)
+SELECT DISTINCT ON (category_id, language) category_id, language, name
+FROM (
+    SELECT cc.id AS category_id, c.language, trim(c.category) AS name, COUNT(*) AS uses
+    FROM contents c
+    INNER JOIN content_categories cc
+        ON cc.slug = trim(both '-' from regexp_replace(lower(translate(c.category, 'ÇĞİÖŞÜçğıöşü', 'CGIOSUcgiosu')), '[^a-z0-9]+', '-', 'g'))
+    GROUP BY cc.id, c.language, trim(c.category)
+) s
+ORDER BY category_id, language, uses DESC
+ON CONFLICT (category_id, language) DO NOTHING;
+
+UPDATE contents c
+SET category_id = cc.id, category = cc.slug
+FROM content_categories cc
+WHERE cc.slug = trim(both '-' from regexp_replace(lower(translate(c.category, 'ÇĞİÖŞÜçğıöşü', 'CGIOSUcgiosu')), '[^a-z0-9]+', '-', 'g'));
@@ -0,0 +1,10 @@
+-- Önce trigger'ı kaldırın
+DROP TRIGGER IF EXISTS trg_contents_content_updated_at ON contents;
+
+-- Sonra fonksiyonu kaldırın
+DROP FUNCTION IF EXISTS touch_content_updated_at () CASCADE;
+
+-- Sütunları kaldır
+ALTER TABLE contents DROP COLUMN IF EXISTS content_updated_at;
+
+ALTER TABLE contents DROP COLUMN IF EXISTS source_language;
@@ -0,0 +1,30 @@
+-- ÇEVİRİ GRUPLARI
+-- Aynı identifier'a sahip içerikler bir çeviri grubudur.
+-- source_language: çevirinin hangi dildeki içerikten üretildiği (boşsa sitenin varsayılan dili kaynak kabul edilir)
+ALTER TABLE contents ADD COLUMN IF NOT EXISTS source_language TEXT;
+
+-- content_updated_at: sadece metin ve görsel alanları değiştiğinde güncellenir.
+-- updated_at durum ve zamanlama değişikliklerinde de değiştiği için güncel olmayan çevirileri tespit etmekte kullanılamaz.
+ALTER TABLE contents ADD COLUMN IF NOT EXISTS content_updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+UPDATE contents SET content_updated_at = updated_at;
+
+CREATE OR REPLACE FUNCTION touch_content_updated_at()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.title IS DISTINCT FROM OLD.title
+        OR NEW.description IS DISTINCT FROM OLD.description
+        OR NEW.image_url IS DISTINCT FROM OLD.image_url
+        OR NEW.details_json IS DISTINCT FROM OLD.details_json
+        OR NEW.content_json IS DISTINCT FROM OLD.content_json
+        OR NEW.content_html IS DISTINCT FROM OLD.content_html THEN
+        NEW.content_updated_at = NOW();
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_contents_content_updated_at
+BEFORE UPDATE ON contents
+FOR EACH ROW
+EXECUTE FUNCTION touch_content_updated_at();
@@ -0,0 +1,34 @@
+-- Önce trigger'ları kaldırın
+DROP TRIGGER IF EXISTS trg_job_posting_details_search_vector ON job_posting_details;
+
+DROP TRIGGER IF EXISTS trg_contents_search_vector ON contents;
+
+-- Sonra fonksiyonları kaldırın
+DROP FUNCTION IF EXISTS update_job_search_vector () CASCADE;
+
+DROP FUNCTION IF EXISTS update_content_search_vector () CASCADE;
+
+-- İndeksleri ve sütunları kaldır
+DROP INDEX IF EXISTS idx_job_posting_details_search_vector;
+
+DROP INDEX IF EXISTS idx_contents_search_vector;
+
+ALTER TABLE job_posting_details DROP COLUMN IF EXISTS search_vector;
+
+ALTER TABLE job_posting_details DROP COLUMN IF EXISTS language;
+
+ALTER TABLE contents DROP COLUMN IF EXISTS search_vector;
+
+-- Yardımcı fonksiyonlar ve yapılandırmalar
+DROP FUNCTION IF EXISTS build_search_vector (regconfig, TEXT, TEXT, TEXT, TEXT);
+
+DROP FUNCTION IF EXISTS search_config (TEXT);
+
+DROP TEXT SEARCH CONFIGURATION IF EXISTS public.search_simple;
+DROP TEXT SEARCH CONFIGURATION IF EXISTS public.search_turkish;
+DROP TEXT SEARCH CONFIGURATION IF EXISTS public.search_english;
+DROP TEXT SEARCH CONFIGURATION IF EXISTS public.search_german;
+DROP TEXT SEARCH CONFIGURATION IF EXISTS public.search_french;
+DROP TEXT SEARCH CONFIGURATION IF EXISTS public.search_spanish;
+DROP TEXT SEARCH CONFIGURATION IF EXISTS public.search_italian;
+DROP TEXT SEARCH CONFIGURATION IF EXISTS public.search_russian;
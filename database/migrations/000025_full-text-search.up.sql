@@ -0,0 +1,97 @@
+-- TAM METİN ARAMA
+-- Aksan ve Türkçe karakterlerden bağımsız arama için unaccent eklentisi ("ışık" = "isik")
+CREATE EXTENSION IF NOT EXISTS unaccent;
+
+-- Dile özel arama yapılandırmaları: önce aksanlar kaldırılır, sonra dilin kök bulucusu uygulanır.
+-- search_simple kök bulma yapmaz, yapılandırması olmayan diller için kullanılır.
+DO $$
+DECLARE
+    lang TEXT;
+BEGIN
+    FOREACH lang IN ARRAY ARRAY['simple', 'turkish', 'english', 'german', 'french', 'spanish', 'italian', 'russian'] LOOP
+        IF NOT EXISTS (SELECT 1 FROM pg_ts_config WHERE cfgname = 'search_' || lang) THEN
+            EXECUTE format('CREATE TEXT SEARCH CONFIGURATION public.%I (COPY = pg_catalog.%I)', 'search_' || lang, lang);
+            EXECUTE format(
+                'ALTER TEXT SEARCH CONFIGURATION public.%I ALTER MAPPING FOR hword, hword_part, word WITH unaccent, %I',
+                'search_' || lang,
+                CASE WHEN lang = 'simple' THEN 'simple' ELSE lang || '_stem' END
+            );
+        END IF;
+    END LOOP;
+END;
+$$;
+
+-- Dil kodunu ("tr", "en-US") arama yapılandırmasına çevirir
+CREATE OR REPLACE FUNCTION search_config(language TEXT)
+RETURNS regconfig AS $$
+    SELECT CASE lower(split_part(COALESCE(language, ''), '-', 1))
+        WHEN 'tr' THEN 'public.search_turkish'
+        WHEN 'en' THEN 'public.search_english'
+        WHEN 'de' THEN 'public.search_german'
+        WHEN 'fr' THEN 'public.search_french'
+        WHEN 'es' THEN 'public.search_spanish'
+        WHEN 'it' THEN 'public.search_italian'
+        WHEN 'ru' THEN 'public.search_russian'
+        ELSE 'public.search_simple'
+    END::regconfig;
+$$ LANGUAGE sql IMMUTABLE;
+
+-- Ağırlıklı arama vektörü: başlık (A), açıklama (B), kategori/lokasyon (C), gövde (D)
+-- Gövdedeki HTML etiketleri indekse girmeden önce boşlukla değiştirilir.
+CREATE OR REPLACE FUNCTION build_search_vector(config regconfig, title TEXT, description TEXT, label TEXT, body TEXT)
+RETURNS tsvector AS $$
+    SELECT setweight(to_tsvector(config, COALESCE(title, '')), 'A')
+        || setweight(to_tsvector(config, COALESCE(description, '')), 'B')
+        || setweight(to_tsvector(config, COALESCE(label, '')), 'C')
+        || setweight(to_tsvector(config, regexp_replace(COALESCE(body, ''), '<[^>]*>', ' ', 'g')), 'D');
+$$ LANGUAGE sql IMMUTABLE;
+
+-- İÇERİKLER
+-- Yapılandırma içeriğin kendi dilinden (contents.language) seçilir
+ALTER TABLE contents ADD COLUMN IF NOT EXISTS search_vector TSVECTOR;
+
+CREATE OR REPLACE FUNCTION update_content_search_vector()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.search_vector = build_search_vector(
+        search_config(NEW.language), NEW.title, NEW.description, NEW.category, NEW.content_html
+    );
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_contents_search_vector
+BEFORE INSERT OR UPDATE OF language, title, description, category, content_html ON contents
+FOR EACH ROW
+EXECUTE FUNCTION update_content_search_vector();
+
+UPDATE contents
+SET search_vector = build_search_vector(search_config(language), title, description, category, content_html);
+
+CREATE INDEX IF NOT EXISTS idx_contents_search_vector ON contents USING GIN (search_vector);
+
+-- İŞ İLANLARI
+-- İlan metninin dili; mevcut ilanlar Türkçe kabul edilir, yeni ilanlarda boşsa sitenin varsayılan dili atanır
+ALTER TABLE job_posting_details ADD COLUMN IF NOT EXISTS language TEXT NOT NULL DEFAULT 'tr';
+
+ALTER TABLE job_posting_details ADD COLUMN IF NOT EXISTS search_vector TSVECTOR;
+
+CREATE OR REPLACE FUNCTION update_job_search_vector()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.search_vector = build_search_vector(
+        search_config(NEW.language), NEW.title, NEW.description, NEW.location, NEW.html
+    );
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_job_posting_details_search_vector
+BEFORE INSERT OR UPDATE OF language, title, description, location, html ON job_posting_details
+FOR EACH ROW
+EXECUTE FUNCTION update_job_search_vector();
+
+UPDATE job_posting_details
+SET search_vector = build_search_vector(search_config(language), title, description, location, html);
+
+CREATE INDEX IF NOT EXISTS idx_job_posting_details_search_vector ON job_posting_details USING GIN (search_vector);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_job_alert_subscriptions_unsubscribe_token;
+
+ALTER TABLE job_alert_subscriptions DROP COLUMN IF EXISTS unsubscribe_token;
@@ -0,0 +1,6 @@
+-- Abonelikten çıkma bağlantıları için süresi dolmayan, aboneliğe özel opak token
+-- Varsayılan değer her satır için ayrı hesaplandığından mevcut abonelikler de kendi token'ını alır
+ALTER TABLE job_alert_subscriptions
+ADD COLUMN IF NOT EXISTS unsubscribe_token TEXT NOT NULL DEFAULT replace(uuid_generate_v4 ()::TEXT || uuid_generate_v4 ()::TEXT, '-', '');
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_job_alert_subscriptions_unsubscribe_token ON job_alert_subscriptions (unsubscribe_token);
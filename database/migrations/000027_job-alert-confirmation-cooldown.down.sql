@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_job_alert_subscriptions_pending_email;
+
+ALTER TABLE job_alert_subscriptions DROP COLUMN IF EXISTS confirmation_sent_at;
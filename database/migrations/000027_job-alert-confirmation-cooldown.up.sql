@@ -0,0 +1,16 @@
+-- Son onay e-postasının gönderildiği an (tekrar gönderim bekleme süresi için)
+ALTER TABLE job_alert_subscriptions ADD COLUMN IF NOT EXISTS confirmation_sent_at TIMESTAMPTZ;
+
+UPDATE job_alert_subscriptions SET confirmation_sent_at = created_at WHERE status = 'pending' AND confirmation_sent_at IS NULL;
+
+-- Aynı e-posta için birden fazla onay bekleyen abonelik varsa en yenisi dışındakileri silin
+DELETE FROM job_alert_subscriptions s
+USING job_alert_subscriptions newer
+WHERE s.status = 'pending'
+  AND newer.status = 'pending'
+  AND newer.email = s.email
+  AND (newer.created_at, newer.id) > (s.created_at, s.id);
+
+-- Her e-posta için en fazla bir onay bekleyen abonelik (yeni istekler bu satırı günceller)
+CREATE UNIQUE INDEX IF NOT EXISTS idx_job_alert_subscriptions_pending_email ON job_alert_subscriptions (email)
+WHERE status = 'pending';
@@ -0,0 +1,14 @@
+-- Revizyonlarda içeriğin etiket adları da saklanır
+-- NULL: etiketler kaydedilmeden önce oluşturulmuş revizyon (karşılaştırma ve geri yüklemede etiketlere dokunulmaz)
+ALTER TABLE content_revisions ADD COLUMN IF NOT EXISTS tags TEXT[];
+
+-- Her içeriğin son revizyonu güncel etiketlerle doldurulur
+UPDATE content_revisions r
+SET tags = ARRAY(
+    SELECT t.name
+    FROM content_tag_links l
+    INNER JOIN content_tags t ON t.id = l.tag_id
+    WHERE l.content_id = r.content_id
+    ORDER BY t.name
+)
+WHERE r.revision = (SELECT MAX(revision) FROM content_revisions WHERE content_id = r.content_id);
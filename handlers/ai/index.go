@@ -0,0 +1,18 @@
+package AIHandler
+
+import (
+	"github.com/okanay/backend-holding/services/aiusage"
+	"github.com/okanay/backend-holding/services/writing"
+)
+
+type Handler struct {
+	Writing *writing.Service
+	Usage   *aiusage.Service
+}
+
+func NewHandler(w *writing.Service, u *aiusage.Service) *Handler {
+	return &Handler{
+		Writing: w,
+		Usage:   u,
+	}
+}
@@ -0,0 +1,64 @@
+package AIHandler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/backend-holding/services/aiusage"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// sseStream - Yanıtı ilk olayda SSE akışına çevirir
+// Akış başlamadan oluşan hatalar (kullanım sınırı, bağlantı hatası) normal JSON hata yanıtı olarak döner.
+type sseStream struct {
+	c       *gin.Context
+	started bool
+}
+
+func newSSEStream(c *gin.Context) *sseStream {
+	return &sseStream{c: c}
+}
+
+// send - Olayı gönderir ve tampon beklemeden istemciye iletir
+func (s *sseStream) send(event string, data any) {
+	if !s.started {
+		s.c.Header("Content-Type", "text/event-stream")
+		s.c.Header("Cache-Control", "no-cache")
+		s.c.Header("Connection", "keep-alive")
+		s.c.Header("X-Accel-Buffering", "no") // Nginx arabelleğe almasın
+		s.c.Status(http.StatusOK)
+		s.started = true
+	}
+
+	s.c.SSEvent(event, data)
+	s.c.Writer.Flush()
+}
+
+// delta - Modelden gelen metin parçasını iletir
+func (s *sseStream) delta(text string) {
+	s.send("delta", gin.H{"text": text})
+}
+
+// fail - Hatayı akış başladıysa "error" olayı, başlamadıysa JSON yanıt olarak döner
+func (s *sseStream) fail(err error, operation string) {
+	if !s.started && errors.Is(err, aiusage.ErrLimitExceeded) {
+		s.c.JSON(http.StatusTooManyRequests, gin.H{
+			"success": false,
+			"error":   "rate_limit_exceeded",
+			"message": "Yapay zeka kullanım sınırına ulaştınız. Lütfen daha sonra tekrar deneyin.",
+		})
+		return
+	}
+
+	log.Printf("[AI] %s başarısız: %v", operation, err)
+	message := operation + " başarısız oldu, lütfen tekrar deneyin"
+
+	if !s.started {
+		utils.SendError(s.c, utils.ErrorOperationFailed, message)
+		return
+	}
+
+	s.send("error", gin.H{"message": message})
+}
@@ -0,0 +1,29 @@
+package AIHandler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// GetMyUsage oturumdaki kullanıcının yapay zeka kullanımını ve kalan hakkını döner
+func (h *Handler) GetMyUsage(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		utils.Unauthorized(c, "Oturum bilgisi bulunamadı")
+		return
+	}
+
+	budget, err := h.Usage.Budget(c.Request.Context(), userID.(uuid.UUID))
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Yapay zeka kullanımı getirme")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    budget,
+	})
+}
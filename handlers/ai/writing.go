@@ -0,0 +1,78 @@
+package AIHandler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// DraftJobDescription yapılandırılmış alanlardan iş ilanı açıklaması taslağını SSE ile akıtır
+// Olaylar: "delta" ({text}), "done" (Tiptap belgesi, HTML ve maliyet), "error" ({message}).
+func (h *Handler) DraftJobDescription(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		utils.Unauthorized(c, "Oturum bilgisi bulunamadı")
+		return
+	}
+
+	var input types.AIJobDraftInput
+	if err := utils.ValidateRequest(c, &input); err != nil {
+		return
+	}
+
+	stream := newSSEStream(c)
+	result, err := h.Writing.DraftJobDescription(c.Request.Context(), input, userID.(uuid.UUID), stream.delta)
+	if err != nil {
+		stream.fail(err, "İlan taslağı oluşturma")
+		return
+	}
+
+	stream.send("done", result)
+}
+
+// RewriteSelection editörde seçili metni yeniden yazar veya kısaltır, sonucu SSE ile akıtır
+func (h *Handler) RewriteSelection(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		utils.Unauthorized(c, "Oturum bilgisi bulunamadı")
+		return
+	}
+
+	var input types.AIRewriteInput
+	if err := utils.ValidateRequest(c, &input); err != nil {
+		return
+	}
+
+	stream := newSSEStream(c)
+	result, err := h.Writing.RewriteSelection(c.Request.Context(), input, userID.(uuid.UUID), stream.delta)
+	if err != nil {
+		stream.fail(err, "Metni yeniden yazma")
+		return
+	}
+
+	stream.send("done", result)
+}
+
+// SuggestSEO içerik için SEO başlığı, açıklaması ve slug önerilerini SSE ile akıtır
+func (h *Handler) SuggestSEO(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		utils.Unauthorized(c, "Oturum bilgisi bulunamadı")
+		return
+	}
+
+	var input types.AISEOInput
+	if err := utils.ValidateRequest(c, &input); err != nil {
+		return
+	}
+
+	stream := newSSEStream(c)
+	result, err := h.Writing.SuggestSEO(c.Request.Context(), input, userID.(uuid.UUID), stream.delta)
+	if err != nil {
+		stream.fail(err, "SEO önerisi oluşturma")
+		return
+	}
+
+	stream.send("done", result)
+}
@@ -0,0 +1,175 @@
+package AnalyticsHandler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/backend-holding/configs"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// respond - Analitik yanıtını hazırlar, kısa TTL ile önbelleğe alır ve döner
+func (h *Handler) respond(c *gin.Context, cacheIdentifier string, data any) {
+	response := gin.H{
+		"success": true,
+		"data":    data,
+	}
+
+	h.Cache.SaveCacheTTL(response, Group, cacheIdentifier, configs.ANALYTICS_CACHE_DURATION)
+	c.Header("X-Cache", "MISS")
+	c.JSON(http.StatusOK, response)
+}
+
+// GetApplicationsPerDay - İlan bazında günlük başvuru sayıları
+func (h *Handler) GetApplicationsPerDay(c *gin.Context) {
+	params, ok := parseParams(c)
+	if !ok {
+		return
+	}
+
+	// Cache kontrolü
+	cacheIdentifier := cacheKey("applications-per-day", params)
+	if h.Cache.TryCache(c, Group, cacheIdentifier) {
+		return
+	}
+
+	results, err := h.Repository.GetApplicationsPerDay(c.Request.Context(), params)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Günlük başvuru raporu")
+		return
+	}
+
+	h.respond(c, cacheIdentifier, results)
+}
+
+// GetStatusConversion - Başvuru durumları arası dönüşüm oranları
+func (h *Handler) GetStatusConversion(c *gin.Context) {
+	params, ok := parseParams(c)
+	if !ok {
+		return
+	}
+
+	// Cache kontrolü
+	cacheIdentifier := cacheKey("status-conversion", params)
+	if h.Cache.TryCache(c, Group, cacheIdentifier) {
+		return
+	}
+
+	results, err := h.Repository.GetStatusConversion(c.Request.Context(), params, configs.GetAnalyticsConfig().StatusFunnel)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Dönüşüm raporu")
+		return
+	}
+
+	h.respond(c, cacheIdentifier, results)
+}
+
+// GetTimeInStage - Aşamalarda geçirilen süreler
+func (h *Handler) GetTimeInStage(c *gin.Context) {
+	params, ok := parseParams(c)
+	if !ok {
+		return
+	}
+
+	// Cache kontrolü
+	cacheIdentifier := cacheKey("time-in-stage", params)
+	if h.Cache.TryCache(c, Group, cacheIdentifier) {
+		return
+	}
+
+	results, err := h.Repository.GetTimeInStage(c.Request.Context(), params)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Aşama süresi raporu")
+		return
+	}
+
+	h.respond(c, cacheIdentifier, results)
+}
+
+// GetTimeToHire - Başvurudan işe alıma kadar geçen süre
+func (h *Handler) GetTimeToHire(c *gin.Context) {
+	params, ok := parseParams(c)
+	if !ok {
+		return
+	}
+
+	// Cache kontrolü
+	cacheIdentifier := cacheKey("time-to-hire", params)
+	if h.Cache.TryCache(c, Group, cacheIdentifier) {
+		return
+	}
+
+	results, err := h.Repository.GetTimeToHire(c.Request.Context(), params, configs.GetAnalyticsConfig().HiredStatus)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "İşe alım süresi raporu")
+		return
+	}
+
+	h.respond(c, cacheIdentifier, results)
+}
+
+// GetSourceBreakdown - Başvuru kanalı dağılımı
+func (h *Handler) GetSourceBreakdown(c *gin.Context) {
+	params, ok := parseParams(c)
+	if !ok {
+		return
+	}
+
+	// Cache kontrolü
+	cacheIdentifier := cacheKey("sources", params)
+	if h.Cache.TryCache(c, Group, cacheIdentifier) {
+		return
+	}
+
+	results, err := h.Repository.GetSourceBreakdown(c.Request.Context(), params, configs.GetAnalyticsConfig().HiredStatus)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Kanal raporu")
+		return
+	}
+
+	h.respond(c, cacheIdentifier, results)
+}
+
+// GetTopCategories - En çok başvuru alan kategoriler
+func (h *Handler) GetTopCategories(c *gin.Context) {
+	params, ok := parseParams(c)
+	if !ok {
+		return
+	}
+
+	// Cache kontrolü
+	cacheIdentifier := cacheKey("top-categories", params)
+	if h.Cache.TryCache(c, Group, cacheIdentifier) {
+		return
+	}
+
+	results, err := h.Repository.GetTopCategories(c.Request.Context(), params)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Kategori raporu")
+		return
+	}
+
+	h.respond(c, cacheIdentifier, results)
+}
+
+// GetAIUsage - Kullanıcı, özellik ve gün bazında yapay zeka harcaması
+func (h *Handler) GetAIUsage(c *gin.Context) {
+	params, ok := parseParams(c)
+	if !ok {
+		return
+	}
+
+	// Cache kontrolü
+	cacheIdentifier := cacheKey("ai-usage", params)
+	if h.Cache.TryCache(c, Group, cacheIdentifier) {
+		return
+	}
+
+	results, err := h.Repository.GetAIUsageReport(c.Request.Context(), params)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Yapay zeka kullanım raporu")
+		return
+	}
+
+	h.respond(c, cacheIdentifier, results)
+}
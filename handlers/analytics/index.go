@@ -0,0 +1,98 @@
+package AnalyticsHandler
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/configs"
+	AnalyticsRepository "github.com/okanay/backend-holding/repositories/analytics"
+	"github.com/okanay/backend-holding/services/cache"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+const Group = cache.GroupAnalytics
+
+type Handler struct {
+	Repository *AnalyticsRepository.Repository
+	Cache      cache.CacheService
+}
+
+func NewHandler(repo *AnalyticsRepository.Repository, cacheService cache.CacheService) *Handler {
+	return &Handler{
+		Repository: repo,
+		Cache:      cacheService,
+	}
+}
+
+// parseParams - Ortak filtreleri (from, to, category, jobId, limit) okur ve doğrular
+// Tarihler YYYY-MM-DD formatındadır, varsayılan aralık son 30 gündür.
+func parseParams(c *gin.Context) (types.AnalyticsParams, bool) {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	params := types.AnalyticsParams{
+		To:       today.Add(24 * time.Hour),
+		Category: c.Query("category"),
+		Limit:    configs.ANALYTICS_DEFAULT_LIMIT,
+	}
+	params.From = params.To.Add(-configs.ANALYTICS_DEFAULT_RANGE)
+
+	if to := c.Query("to"); to != "" {
+		parsed, err := time.Parse("2006-01-02", to)
+		if err != nil {
+			utils.BadRequest(c, "Geçersiz bitiş tarihi, YYYY-MM-DD formatında olmalıdır")
+			return params, false
+		}
+		params.To = parsed.Add(24 * time.Hour)
+		params.From = params.To.Add(-configs.ANALYTICS_DEFAULT_RANGE)
+	}
+
+	if from := c.Query("from"); from != "" {
+		parsed, err := time.Parse("2006-01-02", from)
+		if err != nil {
+			utils.BadRequest(c, "Geçersiz başlangıç tarihi, YYYY-MM-DD formatında olmalıdır")
+			return params, false
+		}
+		params.From = parsed
+	}
+
+	if !params.From.Before(params.To) {
+		utils.BadRequest(c, "Başlangıç tarihi bitiş tarihinden sonra olamaz")
+		return params, false
+	}
+
+	if params.To.Sub(params.From) > configs.ANALYTICS_MAX_RANGE {
+		utils.BadRequest(c, "Tarih aralığı en fazla 1 yıl olabilir")
+		return params, false
+	}
+
+	if jobID := c.Query("jobId"); jobID != "" {
+		parsed, err := uuid.Parse(jobID)
+		if err != nil {
+			utils.BadRequest(c, "Geçersiz iş ilanı ID'si")
+			return params, false
+		}
+		params.JobID = parsed
+	}
+
+	if limit, err := strconv.Atoi(c.Query("limit")); err == nil && limit > 0 && limit <= 100 {
+		params.Limit = limit
+	}
+
+	return params, true
+}
+
+// cacheKey - Rapor adı ve filtrelerden cache anahtarı üretir
+func cacheKey(report string, params types.AnalyticsParams) string {
+	return fmt.Sprintf("analytics:%s:f%s:t%s:c%s:j%s:l%d",
+		report,
+		params.From.Format("2006-01-02"),
+		params.To.Format("2006-01-02"),
+		params.Category,
+		params.JobID,
+		params.Limit,
+	)
+}
@@ -29,6 +29,20 @@ func (h *Handler) CreateContent(c *gin.Context) {
 		return
 	}
 
+	// Zengin metin izin listesine göre temizlenir, kaldırılanlar yanıtta raporlanır
+	var removed []types.HTMLRemoval
+	input.ContentHTML, removed = utils.SanitizeHTML(input.ContentHTML)
+
+	// Yayında oluşturma sadece onaylayıcılara açık
+	if input.Status == types.ContentStatusPublished && !requirePublisher(c) {
+		return
+	}
+
+	// Kategori yönetilen kategorilerden biri olmalı
+	if !h.resolveCategory(c, &input) {
+		return
+	}
+
 	// İçerik oluştur
 	content, err := h.Repository.CreateContent(c.Request.Context(), input, userID)
 	if err != nil {
@@ -40,9 +54,11 @@ func (h *Handler) CreateContent(c *gin.Context) {
 	h.Cache.ClearGroup(Group)
 
 	// Response
+	utils.SetETag(c, content.Version)
 	c.JSON(http.StatusCreated, gin.H{
-		"success": true,
-		"message": "İçerik başarıyla oluşturuldu",
-		"data":    mapContentToView(content),
+		"success":   true,
+		"message":   "İçerik başarıyla oluşturuldu",
+		"data":      mapContentToView(content),
+		"sanitized": removed,
 	})
 }
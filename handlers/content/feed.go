@@ -0,0 +1,274 @@
+package ContentHandler
+
+import (
+	"encoding/xml"
+	"fmt"
+	"hash/fnv"
+	"html"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/backend-holding/configs"
+	"github.com/okanay/backend-holding/services/cache"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// feedContentTypes - Desteklenen feed biçimleri
+var feedContentTypes = map[string]string{
+	"rss":  "application/rss+xml; charset=utf-8",
+	"atom": "application/atom+xml; charset=utf-8",
+}
+
+// GetContentFeed yayınlanmış içerikleri RSS 2.0 veya Atom olarak döner (/public/feeds/contents/rss.xml)
+// ?language= ve ?category= (alt kategoriler dahil) ile filtrelenebilir. If-None-Match ve If-Modified-Since desteklenir.
+func (h *Handler) GetContentFeed(c *gin.Context) {
+	format := strings.ToLower(strings.TrimSuffix(c.Param("format"), ".xml"))
+	contentType, exists := feedContentTypes[format]
+	if !exists {
+		utils.NotFound(c, "Feed")
+		return
+	}
+
+	params := types.ContentSearchParams{
+		Status:    types.ContentStatusPublished,
+		Language:  strings.ToLower(c.Query("language")),
+		Category:  utils.Slugify(c.Query("category")),
+		SortBy:    "created_at",
+		SortOrder: "desc",
+		Page:      1,
+		Limit:     configs.CONTENT_FEED_LIMIT,
+	}
+
+	// Koşullu GET - feed'i oluşturmadan önce listenin güncel durumu kontrol edilir
+	count, lastModified, err := h.Repository.GetListState(c.Request.Context(), params)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "İçerik feed'i oluşturma")
+		return
+	}
+
+	etag := feedETag(format, params, count, lastModified)
+	if utils.NotModifiedSince(c, etag, lastModified) {
+		return
+	}
+
+	// Cache kontrolü - anahtar ETag'i içerdiği için önbellek başlıklarla çelişmez
+	cacheIdentifier := "content:feed:" + strings.Trim(etag, `W/"`)
+	if h.Cache.TryCacheRaw(c, cache.GroupContent, cacheIdentifier, contentType) {
+		return
+	}
+
+	contents, _, err := h.Repository.ListContents(c.Request.Context(), params)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "İçerik feed'i oluşturma")
+		return
+	}
+
+	site := configs.GetSiteConfig()
+	selfURL := site.APIBaseURL + c.Request.URL.RequestURI()
+
+	var document any
+	if format == "atom" {
+		document = buildAtomFeed(contents, params, site, selfURL, lastModified)
+	} else {
+		document = buildRSSFeed(contents, params, site, selfURL, lastModified)
+	}
+
+	body, err := xml.MarshalIndent(document, "", "  ")
+	if err != nil {
+		utils.InternalError(c, "Feed oluşturulamadı")
+		return
+	}
+	body = append([]byte(xml.Header), body...)
+
+	h.Cache.SaveCacheRawTTL(body, cache.GroupContent, cacheIdentifier, configs.CONTENT_FEED_CACHE_DURATION)
+	c.Header("X-Cache", "MISS")
+	c.Data(http.StatusOK, contentType, body)
+}
+
+// buildRSSFeed - İçerikleri RSS 2.0 kanalına dönüştürür
+func buildRSSFeed(contents []types.Content, params types.ContentSearchParams, site configs.SiteConfig, selfURL string, lastModified time.Time) types.RSSFeedXML {
+	feed := types.RSSFeedXML{
+		Version:      "2.0",
+		XmlnsContent: "http://purl.org/rss/1.0/modules/content/",
+		XmlnsAtom:    "http://www.w3.org/2005/Atom",
+		Channel: types.RSSChannelXML{
+			Title:         feedTitle(params, site),
+			Link:          site.BaseURL,
+			Description:   feedTitle(params, site),
+			Language:      params.Language,
+			LastBuildDate: orNow(lastModified).UTC().Format(time.RFC1123Z),
+			SelfLink:      types.AtomLinkXML{Href: selfURL, Rel: "self", Type: "application/rss+xml"},
+			Items:         []types.RSSItemXML{},
+		},
+	}
+
+	for _, content := range contents {
+		item := types.RSSItemXML{
+			Title:       types.XMLCDATA{Value: content.Title},
+			Link:        site.ContentURL(content.Language, content.Slug),
+			GUID:        types.RSSGUIDXML{IsPermaLink: "false", Value: feedGUID(content)},
+			Description: types.XMLCDATA{Value: feedDescription(content)},
+			Category:    content.Category,
+			PubDate:     publishedAt(content).UTC().Format(time.RFC1123Z),
+		}
+
+		if excerpt := feedExcerpt(content.ContentHTML); excerpt != "" {
+			item.Content = &types.XMLCDATA{Value: excerpt}
+		}
+
+		if content.ImageURL != nil && *content.ImageURL != "" {
+			item.Enclosure = &types.RSSEnclosureXML{
+				URL:    *content.ImageURL,
+				Length: "0", // Dosya boyutu bilinmiyor, RSS 2.0 alanın bulunmasını zorunlu tutar
+				Type:   imageType(*content.ImageURL),
+			}
+		}
+
+		feed.Channel.Items = append(feed.Channel.Items, item)
+	}
+
+	return feed
+}
+
+// buildAtomFeed - İçerikleri Atom feed'ine dönüştürür
+func buildAtomFeed(contents []types.Content, params types.ContentSearchParams, site configs.SiteConfig, selfURL string, lastModified time.Time) types.AtomFeedXML {
+	feed := types.AtomFeedXML{
+		Xmlns:   "http://www.w3.org/2005/Atom",
+		Lang:    params.Language,
+		ID:      selfURL,
+		Title:   feedTitle(params, site),
+		Updated: orNow(lastModified).UTC().Format(time.RFC3339),
+		Links: []types.AtomLinkXML{
+			{Href: selfURL, Rel: "self", Type: "application/atom+xml"},
+			{Href: site.BaseURL, Rel: "alternate", Type: "text/html"},
+		},
+		Entries: []types.AtomEntryXML{},
+	}
+
+	for _, content := range contents {
+		entry := types.AtomEntryXML{
+			ID:        feedGUID(content),
+			Title:     types.AtomTextXML{Type: "text", Value: content.Title},
+			Updated:   content.UpdatedAt.UTC().Format(time.RFC3339),
+			Published: publishedAt(content).UTC().Format(time.RFC3339),
+			Links: []types.AtomLinkXML{
+				{Href: site.ContentURL(content.Language, content.Slug), Rel: "alternate", Type: "text/html"},
+			},
+		}
+
+		if description := feedDescription(content); description != "" {
+			entry.Summary = &types.AtomTextXML{Type: "text", Value: description}
+		}
+
+		if excerpt := feedExcerpt(content.ContentHTML); excerpt != "" {
+			entry.Content = &types.AtomTextXML{Type: "html", Value: excerpt}
+		}
+
+		if content.ImageURL != nil && *content.ImageURL != "" {
+			entry.Links = append(entry.Links, types.AtomLinkXML{
+				Href: *content.ImageURL,
+				Rel:  "enclosure",
+				Type: imageType(*content.ImageURL),
+			})
+		}
+
+		if content.Category != "" {
+			entry.Category = &types.AtomCategoryXML{Term: content.Category}
+		}
+
+		feed.Entries = append(feed.Entries, entry)
+	}
+
+	return feed
+}
+
+// feedETag - Biçim, filtreler ve listenin durumundan zayıf ETag üretir
+func feedETag(format string, params types.ContentSearchParams, count int, lastModified time.Time) string {
+	hash := fnv.New64a()
+	fmt.Fprintf(hash, "%s|%s|%s|%d|%d", format, params.Language, params.Category, count, lastModified.UnixNano())
+	return fmt.Sprintf(`W/"%x"`, hash.Sum64())
+}
+
+// feedGUID - Slug değişse de sabit kalan, içerik ID'sinden üretilen kimlik
+func feedGUID(content types.Content) string {
+	return "urn:uuid:" + content.ID.String()
+}
+
+// feedTitle - Kanal başlığı, kategori filtresi varsa eklenir
+func feedTitle(params types.ContentSearchParams, site configs.SiteConfig) string {
+	if params.Category != "" {
+		return site.OrganizationName + " - " + params.Category
+	}
+	return site.OrganizationName
+}
+
+// feedDescription - İçerik açıklaması, yoksa HTML'den üretilen kısa metin
+func feedDescription(content types.Content) string {
+	if content.Description != nil && strings.TrimSpace(*content.Description) != "" {
+		return strings.TrimSpace(*content.Description)
+	}
+	return truncateRunes(utils.StripHTML(content.ContentHTML), 200)
+}
+
+// feedExcerpt - content_html'i düz metne indirip kısaltır ve paragrafları kaçışlanmış HTML olarak döner
+// Kaynak HTML'deki etiketler, öznitelikler ve scriptler feed'e taşınmaz.
+func feedExcerpt(contentHTML string) string {
+	text := truncateRunes(utils.StripHTML(contentHTML), configs.CONTENT_FEED_EXCERPT_CHARS)
+	if text == "" {
+		return ""
+	}
+
+	var builder strings.Builder
+	for _, paragraph := range strings.Split(text, "\n") {
+		if paragraph = strings.TrimSpace(paragraph); paragraph != "" {
+			builder.WriteString("<p>" + html.EscapeString(paragraph) + "</p>")
+		}
+	}
+	return builder.String()
+}
+
+// truncateRunes - Metni kelime ortasında bölmeden verilen karakter sayısına kısaltır
+func truncateRunes(text string, limit int) string {
+	runes := []rune(text)
+	if len(runes) <= limit {
+		return text
+	}
+
+	cut := string(runes[:limit])
+	if i := strings.LastIndexAny(cut, " \n"); i > limit/2 {
+		cut = cut[:i]
+	}
+	return strings.TrimSpace(cut) + "…"
+}
+
+// publishedAt - Zamanlanmış yayında yayın zamanı, değilse oluşturulma zamanı
+func publishedAt(content types.Content) time.Time {
+	if content.PublishAt != nil && content.PublishAt.Before(time.Now()) {
+		return *content.PublishAt
+	}
+	return content.CreatedAt
+}
+
+// imageType - Görsel adresinin uzantısından MIME tipini tahmin eder
+func imageType(imageURL string) string {
+	if parsed, err := url.Parse(imageURL); err == nil {
+		if t := mime.TypeByExtension(strings.ToLower(path.Ext(parsed.Path))); strings.HasPrefix(t, "image/") {
+			return t
+		}
+	}
+	return "image/jpeg"
+}
+
+// orNow - Boş zaman yerine şimdiki zamanı döner (boş feed için)
+func orNow(t time.Time) time.Time {
+	if t.IsZero() {
+		return time.Now()
+	}
+	return t
+}
@@ -24,8 +24,24 @@ func (h *Handler) GetContentByID(c *gin.Context) {
 		return
 	}
 
-	// Cache kontrolü
-	cacheKey := fmt.Sprintf("content:id:%s", contentIDStr)
+	// Sürüm kontrolü - ETag her istekte güncel sürümden üretilir
+	version, err := h.Repository.GetContentVersion(c.Request.Context(), contentID)
+	if err != nil {
+		if strings.Contains(err.Error(), "bulunamadı") {
+			utils.NotFound(c, "İçerik bulunamadı")
+			return
+		}
+		utils.HandleDatabaseError(c, err, "İçerik getirme")
+		return
+	}
+
+	utils.SetETag(c, version)
+	if utils.NotModified(c, version) {
+		return
+	}
+
+	// Cache kontrolü - anahtar sürümü içerdiği için önbellek ETag ile çelişmez
+	cacheKey := fmt.Sprintf("content:id:%s:v%d", contentIDStr, version)
 	if h.Cache.TryCache(c, cache.GroupContent, cacheKey) {
 		return
 	}
@@ -115,14 +131,16 @@ func (h *Handler) ListContents(c *gin.Context) {
 	params := types.ContentSearchParams{
 		Page:       parseInt(c.DefaultQuery("page", "1")),
 		Limit:      parseInt(c.DefaultQuery("limit", "10")),
-		SortBy:     c.DefaultQuery("sortBy", "created_at"),
+		SortBy:     sortByParam(c),
 		SortOrder:  c.DefaultQuery("sortOrder", "desc"),
 		Status:     types.ContentStatus(c.Query("status")),
 		Language:   c.Query("language"),
-		Category:   c.Query("category"),
+		Category:   utils.Slugify(c.Query("category")),
+		Tags:       parseTags(c.Query("tags")),
 		Identifier: c.Query("identifier"),
 		Query:      c.Query("q"),
 		UserID:     c.Query("userId"),
+		Scheduled:  c.Query("scheduled") == "true",
 	}
 
 	// Cache key
@@ -164,11 +182,12 @@ func (h *Handler) ListPublishedContents(c *gin.Context) {
 	params := types.ContentSearchParams{
 		Page:      parseInt(c.DefaultQuery("page", "1")),
 		Limit:     parseInt(c.DefaultQuery("limit", "10")),
-		SortBy:    c.DefaultQuery("sortBy", "created_at"),
+		SortBy:    sortByParam(c),
 		SortOrder: c.DefaultQuery("sortOrder", "desc"),
 		Status:    types.ContentStatusPublished, // Sadece yayınlanmış
 		Language:  c.Query("language"),
-		Category:  c.Query("category"),
+		Category:  utils.Slugify(c.Query("category")),
+		Tags:      parseTags(c.Query("tags")),
 		Query:     c.Query("q"),
 	}
 
@@ -185,11 +204,19 @@ func (h *Handler) ListPublishedContents(c *gin.Context) {
 		return
 	}
 
+	// Filtre seçenekleri için kategori ve etiket sayıları
+	facets, err := h.Repository.GetContentFacets(c.Request.Context(), params)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "İçerik listeleme")
+		return
+	}
+
 	// Response
 	response := gin.H{
 		"success": true,
 		"data": gin.H{
 			"contents": mapContentsToViews(contents),
+			"facets":   facets,
 			"pagination": gin.H{
 				"page":       params.Page,
 				"limit":      params.Limit,
@@ -205,6 +232,25 @@ func (h *Handler) ListPublishedContents(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+// sortByParam - Sıralama alanı; arama yapılırken belirtilmemişse alaka sırası kullanılır
+func sortByParam(c *gin.Context) string {
+	if c.Query("q") != "" {
+		return c.DefaultQuery("sortBy", "relevance")
+	}
+	return c.DefaultQuery("sortBy", "created_at")
+}
+
+// parseTags - Virgülle ayrılmış etiketleri slug listesine çevirir
+func parseTags(s string) []string {
+	var tags []string
+	for _, part := range strings.Split(s, ",") {
+		if slug := utils.Slugify(part); slug != "" {
+			tags = append(tags, slug)
+		}
+	}
+	return tags
+}
+
 // Helper function
 func parseInt(s string) int {
 	val, _ := strconv.Atoi(s)
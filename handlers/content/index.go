@@ -5,6 +5,8 @@ import (
 
 	cr "github.com/okanay/backend-holding/repositories/content"
 	"github.com/okanay/backend-holding/services/cache"
+	"github.com/okanay/backend-holding/services/preview"
+	"github.com/okanay/backend-holding/services/writing"
 	"github.com/okanay/backend-holding/types"
 )
 
@@ -13,12 +15,16 @@ const Group = cache.GroupContent
 type Handler struct {
 	Repository *cr.Repository
 	Cache      cache.CacheService
+	Writing    *writing.Service
+	Preview    *preview.Service
 }
 
-func NewHandler(repo *cr.Repository, cacheService cache.CacheService) *Handler {
+func NewHandler(repo *cr.Repository, cacheService cache.CacheService, ws *writing.Service, ps *preview.Service) *Handler {
 	return &Handler{
 		Repository: repo,
 		Cache:      cacheService,
+		Writing:    ws,
+		Preview:    ps,
 	}
 }
 
@@ -37,6 +43,17 @@ func mapContentToView(content types.Content) types.ContentView {
 		Status:      content.Status,
 		CreatedAt:   content.CreatedAt,
 		UpdatedAt:   content.UpdatedAt,
+		PublishAt:   content.PublishAt,
+		UnpublishAt: content.UnpublishAt,
+		ScheduledBy: content.ScheduledBy,
+		ScheduledAt: content.ScheduledAt,
+		Version:     content.Version,
+		CategoryID:  content.CategoryID,
+		Tags:        content.Tags,
+	}
+
+	if view.Tags == nil {
+		view.Tags = []types.ContentTag{}
 	}
 
 	// DetailsJSON dönüşümü
@@ -0,0 +1,144 @@
+package ContentHandler
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/services/preview"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// CreatePreviewLink - Taslak içerik için hesap gerektirmeyen, süreli önizleme linki oluşturur
+func (h *Handler) CreatePreviewLink(c *gin.Context) {
+	contentID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.BadRequest(c, "Geçersiz içerik ID'si")
+		return
+	}
+
+	userID, exists := c.Get("user_id")
+	if !exists {
+		utils.Unauthorized(c, "Giriş yapmanız gerekiyor")
+		return
+	}
+
+	// Gövde opsiyoneldir, gönderilmezse varsayılan süre kullanılır
+	var input types.PreviewLinkInput
+	if c.Request.ContentLength > 0 {
+		if err := utils.ValidateRequest(c, &input); err != nil {
+			return
+		}
+	}
+
+	// İçerik var mı kontrol et
+	if _, err := h.Repository.GetContentVersion(c.Request.Context(), contentID); err != nil {
+		if strings.Contains(err.Error(), "bulunamadı") {
+			utils.NotFound(c, "İçerik")
+			return
+		}
+		utils.HandleDatabaseError(c, err, "Önizleme linki oluşturma")
+		return
+	}
+
+	link, err := h.Preview.CreateLink(c.Request.Context(), types.PreviewContent, contentID, userID.(uuid.UUID), input)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Önizleme linki oluşturma")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"message": "Önizleme linki oluşturuldu",
+		"data":    link,
+	})
+}
+
+// ListPreviewLinks - İçeriğin önizleme linklerini listeler
+func (h *Handler) ListPreviewLinks(c *gin.Context) {
+	contentID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.BadRequest(c, "Geçersiz içerik ID'si")
+		return
+	}
+
+	links, err := h.Preview.ListLinks(c.Request.Context(), types.PreviewContent, contentID)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Önizleme linklerini getirme")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    links,
+	})
+}
+
+// RevokePreviewLink - Önizleme linkini iptal eder
+func (h *Handler) RevokePreviewLink(c *gin.Context) {
+	contentID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.BadRequest(c, "Geçersiz içerik ID'si")
+		return
+	}
+
+	linkID, err := uuid.Parse(c.Param("linkId"))
+	if err != nil {
+		utils.BadRequest(c, "Geçersiz önizleme linki ID'si")
+		return
+	}
+
+	if err := h.Preview.RevokeLink(c.Request.Context(), types.PreviewContent, contentID, linkID); err != nil {
+		if strings.Contains(err.Error(), "bulunamadı") {
+			utils.NotFound(c, "Önizleme linki")
+			return
+		}
+		utils.HandleDatabaseError(c, err, "Önizleme linki iptal")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Önizleme linki iptal edildi",
+	})
+}
+
+// GetContentPreview - Önizleme token'ı ile taslak dahil içeriği getirir (public)
+// Yanıt önbelleğe alınmaz; iptal edilen link bir sonraki istekte çalışmaz.
+func (h *Handler) GetContentPreview(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		utils.BadRequest(c, "Önizleme token'ı zorunludur")
+		return
+	}
+
+	contentID, err := h.Preview.Resolve(c.Request.Context(), types.PreviewContent, token)
+	if err != nil {
+		if errors.Is(err, preview.ErrInvalidLink) {
+			utils.SendError(c, utils.ErrorNotFound, "Önizleme linki geçersiz, iptal edilmiş veya süresi dolmuş.")
+			return
+		}
+		utils.HandleDatabaseError(c, err, "İçerik önizleme")
+		return
+	}
+
+	content, err := h.Repository.GetContentByID(c.Request.Context(), contentID)
+	if err != nil {
+		if strings.Contains(err.Error(), "bulunamadı") {
+			utils.NotFound(c, "İçerik")
+			return
+		}
+		utils.HandleDatabaseError(c, err, "İçerik önizleme")
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.Header("X-Robots-Tag", "noindex, nofollow")
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    mapContentToView(content),
+	})
+}
@@ -0,0 +1,204 @@
+package ContentHandler
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/configs"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// requirePublisher - Oturumdaki kullanıcının içerik yayınlama ve inceleme onaylama yetkisi yoksa 403 döner
+func requirePublisher(c *gin.Context) bool {
+	if !canPublish(c) {
+		utils.Forbidden(c, "İçerik yayınlama yetkiniz yok. İçeriği incelemeye gönderebilirsiniz.")
+		return false
+	}
+	return true
+}
+
+// canPublish - Giriş yapan kullanıcının içerik yayınlama yetkisi olup olmadığını döner
+func canPublish(c *gin.Context) bool {
+	role, _ := c.Get("role")
+	userRole, _ := role.(types.Role)
+	return configs.CanPublishContent(userRole)
+}
+
+// handleTransitionError - Durum geçişi hatalarını uygun yanıta çevirir
+func handleTransitionError(c *gin.Context, err error, operation string) {
+	switch {
+	case strings.Contains(err.Error(), "durumunda değil"):
+		utils.SendError(c, utils.ErrorOperationFailed, err.Error())
+	case strings.Contains(err.Error(), "bulunamadı"):
+		utils.NotFound(c, "İçerik")
+	default:
+		utils.HandleDatabaseError(c, err, operation)
+	}
+}
+
+// bindReviewInput - Yorum gövdesi opsiyoneldir
+func bindReviewInput(c *gin.Context) (types.ContentReviewInput, bool) {
+	var input types.ContentReviewInput
+	if c.Request.ContentLength > 0 {
+		if err := utils.ValidateRequest(c, &input); err != nil {
+			return input, false
+		}
+	}
+	input.Comment = strings.TrimSpace(input.Comment)
+	return input, true
+}
+
+// SubmitForReview - Taslak içeriği onaylayıcıların incelemesine gönderir
+func (h *Handler) SubmitForReview(c *gin.Context) {
+	contentID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.BadRequest(c, "Geçersiz içerik ID'si")
+		return
+	}
+
+	userID, exists := c.Get("user_id")
+	if !exists {
+		utils.Unauthorized(c, "Giriş yapmanız gerekiyor")
+		return
+	}
+
+	input, ok := bindReviewInput(c)
+	if !ok {
+		return
+	}
+
+	content, err := h.Repository.SubmitForReview(c.Request.Context(), contentID, userID.(uuid.UUID), input.Comment)
+	if err != nil {
+		handleTransitionError(c, err, "İncelemeye gönderme")
+		return
+	}
+
+	h.Cache.ClearGroup(Group)
+
+	utils.SetETag(c, content.Version)
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "İçerik incelemeye gönderildi",
+		"data":    mapContentToView(content),
+	})
+}
+
+// ApproveReview - İncelemedeki içeriği onaylar ve yayınlar (sadece onaylayıcılar)
+func (h *Handler) ApproveReview(c *gin.Context) {
+	contentID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.BadRequest(c, "Geçersiz içerik ID'si")
+		return
+	}
+
+	if !requirePublisher(c) {
+		return
+	}
+
+	userID, exists := c.Get("user_id")
+	if !exists {
+		utils.Unauthorized(c, "Giriş yapmanız gerekiyor")
+		return
+	}
+
+	input, ok := bindReviewInput(c)
+	if !ok {
+		return
+	}
+
+	content, err := h.Repository.ApproveReview(c.Request.Context(), contentID, userID.(uuid.UUID), input.Comment)
+	if err != nil {
+		handleTransitionError(c, err, "İçerik onaylama")
+		return
+	}
+
+	h.Cache.ClearGroup(Group)
+
+	utils.SetETag(c, content.Version)
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "İçerik onaylandı ve yayınlandı",
+		"data":    mapContentToView(content),
+	})
+}
+
+// RequestChanges - İncelemedeki içeriği yorumla birlikte yazarına geri gönderir (sadece onaylayıcılar)
+func (h *Handler) RequestChanges(c *gin.Context) {
+	contentID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.BadRequest(c, "Geçersiz içerik ID'si")
+		return
+	}
+
+	if !requirePublisher(c) {
+		return
+	}
+
+	userID, exists := c.Get("user_id")
+	if !exists {
+		utils.Unauthorized(c, "Giriş yapmanız gerekiyor")
+		return
+	}
+
+	input, ok := bindReviewInput(c)
+	if !ok {
+		return
+	}
+
+	if input.Comment == "" {
+		utils.BadRequest(c, "Değişiklik isterken yorum yazılması zorunludur")
+		return
+	}
+
+	content, err := h.Repository.RequestChanges(c.Request.Context(), contentID, userID.(uuid.UUID), input.Comment)
+	if err != nil {
+		handleTransitionError(c, err, "Değişiklik isteme")
+		return
+	}
+
+	h.Cache.ClearGroup(Group)
+
+	utils.SetETag(c, content.Version)
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "İçerik değişiklik için yazarına geri gönderildi",
+		"data":    mapContentToView(content),
+	})
+}
+
+// ListReviewQueue - İnceleme bekleyen içerikleri listeler
+func (h *Handler) ListReviewQueue(c *gin.Context) {
+	items, err := h.Repository.ListReviewQueue(c.Request.Context())
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "İnceleme kuyruğu getirme")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    items,
+	})
+}
+
+// ListTransitions - İçeriğin durum geçişlerini ve inceleme yorumlarını listeler
+func (h *Handler) ListTransitions(c *gin.Context) {
+	contentID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.BadRequest(c, "Geçersiz içerik ID'si")
+		return
+	}
+
+	transitions, err := h.Repository.ListTransitions(c.Request.Context(), contentID)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Durum geçmişi getirme")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    transitions,
+	})
+}
@@ -0,0 +1,266 @@
+package ContentHandler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	cr "github.com/okanay/backend-holding/repositories/content"
+	"github.com/okanay/backend-holding/services/tiptap"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// ListRevisions - İçeriğin revizyon geçmişini listeler
+func (h *Handler) ListRevisions(c *gin.Context) {
+	contentID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.BadRequest(c, "Geçersiz içerik ID'si")
+		return
+	}
+
+	revisions, err := h.Repository.ListRevisions(c.Request.Context(), contentID)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Revizyon listeleme")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    revisions,
+	})
+}
+
+// DiffRevisions - İki revizyon arasındaki alan ve blok farklarını döner (?from=1&to=3)
+// "to" verilmezse en son revizyon ile karşılaştırılır.
+func (h *Handler) DiffRevisions(c *gin.Context) {
+	contentID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.BadRequest(c, "Geçersiz içerik ID'si")
+		return
+	}
+
+	from, err := strconv.Atoi(c.Query("from"))
+	if err != nil || from < 1 {
+		utils.BadRequest(c, "Geçersiz başlangıç revizyonu")
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	to := 0
+	if value := c.Query("to"); value != "" {
+		if to, err = strconv.Atoi(value); err != nil || to < 1 {
+			utils.BadRequest(c, "Geçersiz bitiş revizyonu")
+			return
+		}
+	} else {
+		revisions, err := h.Repository.ListRevisions(ctx, contentID)
+		if err != nil {
+			utils.HandleDatabaseError(c, err, "Revizyon karşılaştırma")
+			return
+		}
+		if len(revisions) > 0 {
+			to = revisions[0].Revision
+		}
+	}
+
+	fromRevision, err := h.Repository.GetRevision(ctx, contentID, from)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Revizyon karşılaştırma")
+		return
+	}
+	toRevision, err := h.Repository.GetRevision(ctx, contentID, to)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Revizyon karşılaştırma")
+		return
+	}
+	if fromRevision.ID == uuid.Nil || toRevision.ID == uuid.Nil {
+		utils.NotFound(c, "Revizyon")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    diffRevisions(fromRevision, toRevision),
+	})
+}
+
+// RestoreRevision - Revizyonu içeriğe geri yükler; geri yükleme yeni bir revizyon olarak kaydedilir
+// Revizyonun kategorisi silinmişse geri yükleme reddedilir. Etiketsiz kaydedilmiş eski revizyonlarda
+// güncel etiketler korunur, yanıttaki tagsRestored bunu belirtir.
+func (h *Handler) RestoreRevision(c *gin.Context) {
+	contentID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.BadRequest(c, "Geçersiz içerik ID'si")
+		return
+	}
+
+	// Kullanıcı kontrolü
+	userIDValue, exists := c.Get("user_id")
+	if !exists {
+		utils.Unauthorized(c, "Giriş yapmanız gerekiyor")
+		return
+	}
+
+	userID, ok := userIDValue.(uuid.UUID)
+	if !ok {
+		utils.InternalError(c, "Kullanıcı bilgisi alınamadı")
+		return
+	}
+
+	// Eşzamanlı düzenleme kontrolü - istemcinin bildiği sürüm
+	expectedVersion, ok := utils.RequireIfMatch(c)
+	if !ok {
+		return
+	}
+
+	var input types.ContentRestoreInput
+	if err := utils.ValidateRequest(c, &input); err != nil {
+		return
+	}
+
+	revision, err := h.Repository.GetRevision(c.Request.Context(), contentID, input.Revision)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Revizyon getirme")
+		return
+	}
+
+	if revision.ID == uuid.Nil {
+		utils.NotFound(c, "İçerik veya revizyon")
+		return
+	}
+
+	// Eski revizyonlar temizleyiciden önce kaydedilmiş olabilir, gövde güncelleme ile aynı izin listesinden geçer
+	snapshot := *revision.Snapshot
+	var removed []types.HTMLRemoval
+	snapshot.ContentHTML, removed = utils.SanitizeHTML(snapshot.ContentHTML)
+
+	// Kategori taşınmadan önceki revizyonlar serbest metin kategori tutar, slug'a çözümlenir
+	if snapshot.Category != "" {
+		category, err := h.Repository.ResolveCategory(c.Request.Context(), snapshot.Category)
+		if err != nil {
+			if strings.Contains(err.Error(), "bulunamadı") {
+				utils.SendError(c, utils.ErrorInvalidValue, "Revizyondaki '"+snapshot.Category+"' kategorisi artık mevcut değil. Önce kategoriyi oluşturun veya içeriği güncel bir kategoriyle düzenleyin.")
+				return
+			}
+			utils.HandleDatabaseError(c, err, "Kategori kontrolü")
+			return
+		}
+		snapshot.Category = category.Slug
+	}
+
+	// Yayındaki içeriğe geri yükleme, düzenleme gibi sadece onaylayıcılara açıktır
+	content, err := h.Repository.RestoreRevision(c.Request.Context(), contentID, revision.Revision, snapshot, input.ChangeNote, userID, expectedVersion, canPublish(c))
+	if err != nil {
+		var conflict *types.VersionConflictError
+		switch {
+		case errors.As(err, &conflict):
+			utils.PreconditionFailed(c, conflict.CurrentVersion)
+		case errors.Is(err, cr.ErrPublishedContentLocked):
+			utils.Forbidden(c, "Yayındaki içeriği düzenleme yetkiniz yok.")
+		case errors.Is(err, cr.ErrRevisionCategoryDeleted):
+			utils.SendError(c, utils.ErrorInvalidValue, "Revizyondaki kategori geri yükleme sırasında silindi.")
+		case strings.Contains(err.Error(), "bulunamadı"):
+			utils.NotFound(c, "İçerik veya revizyon")
+		default:
+			utils.HandleDatabaseError(c, err, "Revizyon geri yükleme")
+		}
+		return
+	}
+
+	// Cache temizle
+	h.Cache.ClearGroup(Group)
+
+	utils.SetETag(c, content.Version)
+	c.JSON(http.StatusOK, gin.H{
+		"success":      true,
+		"message":      "Revizyon geri yüklendi",
+		"data":         mapContentToView(content),
+		"sanitized":    removed,
+		"tagsRestored": snapshot.Tags != nil,
+	})
+}
+
+// diffRevisions - Anlık görüntülerdeki alanları ve Tiptap belgelerini karşılaştırır
+func diffRevisions(from, to types.ContentRevision) types.ContentRevisionDiff {
+	a, b := from.Snapshot, to.Snapshot
+	diff := types.ContentRevisionDiff{
+		From:   from,
+		To:     to,
+		Fields: []types.ContentFieldChange{},
+	}
+
+	compare := func(field string, before, after any) {
+		if before != after {
+			diff.Fields = append(diff.Fields, types.ContentFieldChange{Field: field, From: before, To: after})
+		}
+	}
+
+	compare("title", a.Title, b.Title)
+	compare("slug", a.Slug, b.Slug)
+	compare("status", a.Status, b.Status)
+	compare("category", a.Category, b.Category)
+	// Etiketsiz kaydedilmiş eski revizyonlarda etiket farkı bilinemez
+	if a.Tags != nil && b.Tags != nil {
+		fromTags, toTags := tagNames(a.Tags), tagNames(b.Tags)
+		if !slices.Equal(fromTags, toTags) {
+			diff.Fields = append(diff.Fields, types.ContentFieldChange{Field: "tags", From: fromTags, To: toTags})
+		}
+	}
+	compare("description", stringValue(a.Description), stringValue(b.Description))
+	compare("imageUrl", stringValue(a.ImageURL), stringValue(b.ImageURL))
+	if !sameJSON(stringValue(a.DetailsJSON), stringValue(b.DetailsJSON)) {
+		diff.Fields = append(diff.Fields, types.ContentFieldChange{Field: "detailsJson", From: a.DetailsJSON, To: b.DetailsJSON})
+	}
+
+	fromDoc, fromErr := tiptap.Parse([]byte(a.ContentJSON))
+	toDoc, toErr := tiptap.Parse([]byte(b.ContentJSON))
+	if fromErr != nil || toErr != nil {
+		// Belge okunamazsa HTML karşılaştırmasına düşülür
+		compare("contentHtml", a.ContentHTML, b.ContentHTML)
+		diff.Blocks = []tiptap.BlockChange{}
+		return diff
+	}
+	diff.Blocks = tiptap.DiffBlocks(fromDoc, toDoc)
+
+	return diff
+}
+
+// tagNames - Etiket adlarını sıralı döner
+func tagNames(tags []types.ContentTag) []string {
+	names := make([]string, len(tags))
+	for i, tag := range tags {
+		names[i] = tag.Name
+	}
+	slices.Sort(names)
+	return names
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+// sameJSON - İki JSON metnini anlamca karşılaştırır (anahtar sırası ve boşluklar önemsiz)
+func sameJSON(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	var left, right any
+	if json.Unmarshal([]byte(a), &left) != nil || json.Unmarshal([]byte(b), &right) != nil {
+		return false
+	}
+
+	leftJSON, _ := json.Marshal(left)
+	rightJSON, _ := json.Marshal(right)
+	return string(leftJSON) == string(rightJSON)
+}
@@ -0,0 +1,66 @@
+package ContentHandler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// ScheduleContent - İçerik için zamanlanmış yayın ve kapatma zamanlarını ayarlar
+func (h *Handler) ScheduleContent(c *gin.Context) {
+	// ID parse
+	contentID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.BadRequest(c, "Geçersiz içerik ID'si")
+		return
+	}
+
+	// Kullanıcı kontrolü
+	userIDValue, exists := c.Get("user_id")
+	if !exists {
+		utils.Unauthorized(c, "Giriş yapmanız gerekiyor")
+		return
+	}
+
+	userID, ok := userIDValue.(uuid.UUID)
+	if !ok {
+		utils.InternalError(c, "Kullanıcı bilgisi alınamadı")
+		return
+	}
+
+	// Input validasyonu
+	var input types.ScheduleInput
+	if err := utils.ValidateRequest(c, &input); err != nil {
+		return
+	}
+
+	if message := utils.ValidateSchedule(input); message != "" {
+		utils.SendError(c, utils.ErrorInvalidValue, message)
+		return
+	}
+
+	// Zamanlanmış yayın da yayına alma sayılır
+	if input.PublishAt != nil && !requirePublisher(c) {
+		return
+	}
+
+	// Zamanlamayı kaydet
+	err = h.Repository.ScheduleContent(c.Request.Context(), contentID, input, userID)
+	if err != nil {
+		handleTransitionError(c, err, "İçerik zamanlama")
+		return
+	}
+
+	// Cache temizle
+	h.Cache.ClearGroup(Group)
+
+	// Response
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "İçerik zamanlaması kaydedildi",
+		"data":    input,
+	})
+}
@@ -0,0 +1,54 @@
+package ContentHandler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/backend-holding/configs"
+	"github.com/okanay/backend-holding/services/cache"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// SearchContents - Yayınlanmış içeriklerde alaka sırasına göre tam metin arama (public)
+// Sonuçlar eşleşen kelimeleri <mark> ile işaretlenmiş kısa bir metin parçası içerir.
+func (h *Handler) SearchContents(c *gin.Context) {
+	params, ok := utils.ParseSearchParams(c)
+	if !ok {
+		return
+	}
+	params.Category = utils.Slugify(params.Category)
+
+	cacheKey := fmt.Sprintf("content:search:%+v", params)
+	if h.Cache.TryCache(c, cache.GroupContent, cacheKey) {
+		return
+	}
+
+	results, total, err := h.Repository.SearchContents(c.Request.Context(), params)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "İçerik arama")
+		return
+	}
+
+	site := configs.GetSiteConfig()
+	for i := range results {
+		results[i].URL = site.ContentURL(results[i].Language, results[i].Slug)
+	}
+
+	response := gin.H{
+		"success": true,
+		"data": gin.H{
+			"results": results,
+			"pagination": gin.H{
+				"page":       params.Page,
+				"limit":      params.Limit,
+				"total":      total,
+				"totalPages": (total + params.Limit - 1) / params.Limit,
+			},
+		},
+	}
+
+	h.Cache.SaveCacheTTL(response, cache.GroupContent, cacheKey, configs.SEARCH_CACHE_DURATION)
+	c.Header("X-Cache", "MISS")
+	c.JSON(http.StatusOK, response)
+}
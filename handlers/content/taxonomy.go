@@ -0,0 +1,241 @@
+package ContentHandler
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/services/cache"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// resolveCategory - İçerik girdisindeki kategoriyi yönetilen bir kategoriye çözümler ve slug'ına çevirir
+func (h *Handler) resolveCategory(c *gin.Context, input *types.ContentInput) bool {
+	category, err := h.Repository.ResolveCategory(c.Request.Context(), input.Category)
+	if err != nil {
+		if strings.Contains(err.Error(), "bulunamadı") {
+			utils.SendError(c, utils.ErrorInvalidValue, err.Error()+". Önce kategoriyi oluşturun veya mevcut bir kategori seçin.")
+			return false
+		}
+		utils.HandleDatabaseError(c, err, "Kategori kontrolü")
+		return false
+	}
+
+	input.Category = category.Slug
+	return true
+}
+
+// ListCategories - Tüm kategorileri çevirileriyle düz liste olarak döner (panel için)
+func (h *Handler) ListCategories(c *gin.Context) {
+	categories, err := h.Repository.ListCategories(c.Request.Context())
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Kategori listeleme")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    categories,
+	})
+}
+
+// GetCategoryTree - Kategorileri istenen dildeki adlarıyla ağaç olarak döner (public)
+func (h *Handler) GetCategoryTree(c *gin.Context) {
+	language := strings.ToLower(c.Query("language"))
+
+	cacheKey := fmt.Sprintf("content:categories:%s", language)
+	if h.Cache.TryCache(c, cache.GroupContent, cacheKey) {
+		return
+	}
+
+	categories, err := h.Repository.ListCategories(c.Request.Context())
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Kategori listeleme")
+		return
+	}
+
+	response := gin.H{
+		"success": true,
+		"data":    buildCategoryTree(categories, language),
+	}
+
+	h.Cache.SaveCacheTTL(response, cache.GroupContent, cacheKey, 30*time.Minute)
+	c.Header("X-Cache", "MISS")
+	c.JSON(http.StatusOK, response)
+}
+
+// CreateCategory - Yeni kategori oluşturur
+func (h *Handler) CreateCategory(c *gin.Context) {
+	if !requirePublisher(c) {
+		return
+	}
+
+	var input types.ContentCategoryInput
+	if err := utils.ValidateRequest(c, &input); err != nil {
+		return
+	}
+
+	category, err := h.Repository.CreateCategory(c.Request.Context(), input)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Kategori oluşturma")
+		return
+	}
+
+	h.Cache.ClearGroup(Group)
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"message": "Kategori oluşturuldu",
+		"data":    category,
+	})
+}
+
+// UpdateCategory - Kategoriyi, üst kategorisini ve çevirilerini günceller
+func (h *Handler) UpdateCategory(c *gin.Context) {
+	if !requirePublisher(c) {
+		return
+	}
+
+	categoryID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.BadRequest(c, "Geçersiz kategori ID'si")
+		return
+	}
+
+	var input types.ContentCategoryInput
+	if err := utils.ValidateRequest(c, &input); err != nil {
+		return
+	}
+
+	category, err := h.Repository.UpdateCategory(c.Request.Context(), categoryID, input)
+	if err != nil {
+		if strings.Contains(err.Error(), "kategori bulunamadı") {
+			utils.NotFound(c, "Kategori")
+			return
+		}
+		utils.HandleDatabaseError(c, err, "Kategori güncelleme")
+		return
+	}
+
+	h.Cache.ClearGroup(Group)
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Kategori güncellendi",
+		"data":    category,
+	})
+}
+
+// DeleteCategory - Boş kategoriyi siler
+func (h *Handler) DeleteCategory(c *gin.Context) {
+	if !requirePublisher(c) {
+		return
+	}
+
+	categoryID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.BadRequest(c, "Geçersiz kategori ID'si")
+		return
+	}
+
+	if err := h.Repository.DeleteCategory(c.Request.Context(), categoryID); err != nil {
+		if strings.Contains(err.Error(), "bulunamadı") {
+			utils.NotFound(c, "Kategori")
+			return
+		}
+		utils.HandleDatabaseError(c, err, "Kategori silme")
+		return
+	}
+
+	h.Cache.ClearGroup(Group)
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Kategori silindi",
+	})
+}
+
+// ListTags - Etiketleri içerik sayılarıyla listeler
+func (h *Handler) ListTags(c *gin.Context) {
+	tags, err := h.Repository.ListTags(c.Request.Context())
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Etiket listeleme")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    tags,
+	})
+}
+
+// DeleteTag - Etiketi tüm içeriklerden kaldırarak siler
+func (h *Handler) DeleteTag(c *gin.Context) {
+	if !requirePublisher(c) {
+		return
+	}
+
+	tagID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.BadRequest(c, "Geçersiz etiket ID'si")
+		return
+	}
+
+	if err := h.Repository.DeleteTag(c.Request.Context(), tagID); err != nil {
+		if strings.Contains(err.Error(), "bulunamadı") {
+			utils.NotFound(c, "Etiket")
+			return
+		}
+		utils.HandleDatabaseError(c, err, "Etiket silme")
+		return
+	}
+
+	h.Cache.ClearGroup(Group)
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Etiket silindi",
+	})
+}
+
+// buildCategoryTree - Düz kategori listesini dile göre adlandırılmış ağaca çevirir
+// Çeviri yoksa ilk çeviri (o da yoksa slug) kullanılır.
+func buildCategoryTree(categories []types.ContentCategory, language string) []types.ContentCategory {
+	children := make(map[uuid.UUID][]types.ContentCategory)
+	var roots []types.ContentCategory
+
+	for _, category := range categories {
+		for _, t := range category.Translations {
+			if t.Language == language {
+				category.Name = t.Name
+				break
+			}
+		}
+		if language != "" {
+			category.Translations = nil
+		}
+
+		if category.ParentID == nil {
+			roots = append(roots, category)
+		} else {
+			children[*category.ParentID] = append(children[*category.ParentID], category)
+		}
+	}
+
+	var attach func(nodes []types.ContentCategory) []types.ContentCategory
+	attach = func(nodes []types.ContentCategory) []types.ContentCategory {
+		for i := range nodes {
+			nodes[i].Children = attach(children[nodes[i].ID])
+		}
+		return nodes
+	}
+
+	if roots == nil {
+		return []types.ContentCategory{}
+	}
+	return attach(roots)
+}
@@ -0,0 +1,191 @@
+package ContentHandler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/services/aiusage"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// TranslateContent - İçeriği yapay zeka ile başka bir dile çevirir ve aynı identifier ile taslak olarak kaydeder
+func (h *Handler) TranslateContent(c *gin.Context) {
+	// ID parse
+	contentID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.BadRequest(c, "Geçersiz içerik ID'si")
+		return
+	}
+
+	// Kullanıcı kontrolü
+	userIDValue, exists := c.Get("user_id")
+	if !exists {
+		utils.Unauthorized(c, "Giriş yapmanız gerekiyor")
+		return
+	}
+
+	userID, ok := userIDValue.(uuid.UUID)
+	if !ok {
+		utils.InternalError(c, "Kullanıcı bilgisi alınamadı")
+		return
+	}
+
+	// Input validasyonu
+	var input types.ContentTranslateInput
+	if err := utils.ValidateRequest(c, &input); err != nil {
+		return
+	}
+	language := strings.ToLower(input.Language)
+	ctx := c.Request.Context()
+
+	// Kaynak içeriği getir
+	content, err := h.Repository.GetContentByID(ctx, contentID)
+	if err != nil {
+		if strings.Contains(err.Error(), "bulunamadı") {
+			utils.NotFound(c, "İçerik bulunamadı")
+			return
+		}
+		utils.HandleDatabaseError(c, err, "İçerik getirme")
+		return
+	}
+
+	if content.Language == language {
+		utils.SendError(c, utils.ErrorInvalidValue, "Hedef dil içeriğin mevcut dili ile aynı")
+		return
+	}
+
+	// Token harcamadan önce çakışmaları kontrol et
+	exists, err = h.Repository.LanguageVersionExists(ctx, content.Identifier, language)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "İçerik çevirisi")
+		return
+	}
+	if exists {
+		utils.SendError(c, utils.ErrorDuplicateEntry, fmt.Sprintf("Bu içeriğin '%s' dilinde zaten bir versiyonu var", language))
+		return
+	}
+
+	if input.Slug != "" {
+		taken, err := h.Repository.SlugExists(ctx, input.Slug, language)
+		if err != nil {
+			utils.HandleDatabaseError(c, err, "İçerik çevirisi")
+			return
+		}
+		if taken {
+			utils.SendError(c, utils.ErrorDuplicateEntry, fmt.Sprintf("'%s' URL'i '%s' dilinde zaten kullanımda", input.Slug, language))
+			return
+		}
+	}
+
+	// Çevir
+	translation, err := h.Writing.TranslateContent(ctx, content, language, userID)
+	if err != nil {
+		if errors.Is(err, aiusage.ErrLimitExceeded) {
+			c.JSON(http.StatusTooManyRequests, gin.H{
+				"success": false,
+				"error":   "rate_limit_exceeded",
+				"message": "Yapay zeka kullanım sınırına ulaştınız. Lütfen daha sonra tekrar deneyin.",
+			})
+			return
+		}
+
+		log.Printf("[TRANSLATION] İçerik çevrilemedi (%s -> %s): %v", contentID, language, err)
+		utils.SendError(c, utils.ErrorOperationFailed, "İçerik çevrilemedi, lütfen tekrar deneyin")
+		return
+	}
+
+	slug := input.Slug
+	if slug == "" {
+		slug, err = h.availableSlug(ctx, utils.Slugify(translation.Title), content.Slug, language)
+		if err != nil {
+			utils.HandleDatabaseError(c, err, "İçerik çevirisi")
+			return
+		}
+	}
+
+	// Çeviri her zaman taslak olarak oluşturulur, editör kontrol edip yayınlar
+	contentInput := variantInput(content, language, slug)
+	contentInput.Title = translation.Title
+	contentInput.Description = translation.Description
+	contentInput.ContentJSON = translation.ContentJSON
+	contentInput.ContentHTML, _ = utils.SanitizeHTML(translation.ContentHTML) // Model çıktısı da editör girdisi gibi temizlenir
+	contentInput.ChangeNote = fmt.Sprintf("'%s' dilinden yapay zeka ile çevrildi", content.Language)
+
+	created, err := h.Repository.CreateContent(ctx, contentInput, userID)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Çeviri kaydetme")
+		return
+	}
+
+	// Cache temizle
+	h.Cache.ClearGroup(Group)
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"message": "İçerik çevrildi ve taslak olarak kaydedildi",
+		"data": gin.H{
+			"content": mapContentToView(created),
+			"usage":   translation.Usage,
+		},
+	})
+}
+
+// availableSlug - Çevrilen başlıktan üretilen slug doluysa sonuna sayı ekleyerek boş olanı bulur
+func (h *Handler) availableSlug(ctx context.Context, slug, fallback, language string) (string, error) {
+	if len(slug) < 3 {
+		slug = fallback
+	}
+
+	candidate := slug
+	for i := 2; ; i++ {
+		taken, err := h.Repository.SlugExists(ctx, candidate, language)
+		if err != nil {
+			return "", err
+		}
+		if !taken {
+			return candidate, nil
+		}
+		candidate = fmt.Sprintf("%s-%d", slug, i)
+	}
+}
+
+// variantInput - Kaynak içerikten aynı identifier ile yeni dil versiyonunun taslak girdisini hazırlar
+func variantInput(source types.Content, language, slug string) types.ContentInput {
+	input := types.ContentInput{
+		Slug:           slug,
+		Identifier:     source.Identifier,
+		Language:       language,
+		Title:          source.Title,
+		Category:       source.Category,
+		ContentJSON:    source.ContentJSON,
+		ContentHTML:    sanitizedHTML(source.ContentHTML),
+		Status:         types.ContentStatusDraft,
+		SourceLanguage: source.Language,
+	}
+	if source.Description != nil {
+		input.Description = *source.Description
+	}
+	if source.ImageURL != nil {
+		input.ImageURL = *source.ImageURL
+	}
+	if source.DetailsJSON != nil {
+		input.DetailsJSON = *source.DetailsJSON
+	}
+	for _, tag := range source.Tags {
+		input.Tags = append(input.Tags, tag.Name)
+	}
+	return input
+}
+
+// sanitizedHTML - Temizleyiciden önce kaydedilmiş içeriklerin kopyalanırken de temizlenmesini sağlar
+func sanitizedHTML(contentHTML string) string {
+	sanitized, _ := utils.SanitizeHTML(contentHTML)
+	return sanitized
+}
@@ -0,0 +1,200 @@
+package ContentHandler
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/configs"
+	"github.com/okanay/backend-holding/services/cache"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// ListTranslationGroups - Çeviri gruplarını mevcut, eksik ve güncel olmayan dilleriyle listeler
+// ?missing=en sadece İngilizcesi eksik grupları, ?outdated=true kaynağı sonradan güncellenmiş grupları getirir.
+func (h *Handler) ListTranslationGroups(c *gin.Context) {
+	site := configs.GetSiteConfig()
+
+	params := types.ContentTranslationParams{
+		Missing:  strings.ToLower(c.Query("missing")),
+		Outdated: c.Query("outdated") == "true",
+		Category: utils.Slugify(c.Query("category")),
+		Query:    c.Query("q"),
+		Page:     parseInt(c.DefaultQuery("page", "1")),
+		Limit:    parseInt(c.DefaultQuery("limit", "20")),
+	}
+
+	if params.Missing != "" && !site.HasLanguage(params.Missing) {
+		utils.BadRequest(c, fmt.Sprintf("'%s' sitenin dillerinden biri değil (%s)", params.Missing, strings.Join(site.Languages, ", ")))
+		return
+	}
+
+	cacheKey := fmt.Sprintf("content:translations:%v:%+v", site.Languages, params)
+	if h.Cache.TryCache(c, cache.GroupContent, cacheKey) {
+		return
+	}
+
+	groups, total, err := h.Repository.ListTranslationGroups(c.Request.Context(), params, site.Languages)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Çeviri grupları listeleme")
+		return
+	}
+
+	response := gin.H{
+		"success": true,
+		"data": gin.H{
+			"languages": site.Languages,
+			"groups":    groups,
+			"pagination": gin.H{
+				"page":       params.Page,
+				"limit":      params.Limit,
+				"total":      total,
+				"totalPages": (total + params.Limit - 1) / params.Limit,
+			},
+		},
+	}
+
+	h.Cache.SaveCacheTTL(response, cache.GroupContent, cacheKey, 5*time.Minute)
+	c.Header("X-Cache", "MISS")
+	c.JSON(http.StatusOK, response)
+}
+
+// GetTranslationGroup - Tek bir identifier'ın çeviri grubunu getirir
+func (h *Handler) GetTranslationGroup(c *gin.Context) {
+	site := configs.GetSiteConfig()
+
+	group, err := h.Repository.GetTranslationGroup(c.Request.Context(), c.Param("identifier"), site.Languages)
+	if err != nil {
+		if strings.Contains(err.Error(), "bulunamadı") {
+			utils.NotFound(c, "Çeviri grubu")
+			return
+		}
+		utils.HandleDatabaseError(c, err, "Çeviri grubu getirme")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    group,
+	})
+}
+
+// GetTranslationCoverage - Panel için site dillerine göre çeviri kapsamını döner
+func (h *Handler) GetTranslationCoverage(c *gin.Context) {
+	site := configs.GetSiteConfig()
+
+	cacheKey := fmt.Sprintf("content:translations:coverage:%v", site.Languages)
+	if h.Cache.TryCache(c, cache.GroupContent, cacheKey) {
+		return
+	}
+
+	coverage, err := h.Repository.GetTranslationCoverage(c.Request.Context(), site.Languages)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Çeviri kapsamı")
+		return
+	}
+
+	response := gin.H{
+		"success": true,
+		"data":    coverage,
+	}
+
+	h.Cache.SaveCacheTTL(response, cache.GroupContent, cacheKey, 5*time.Minute)
+	c.Header("X-Cache", "MISS")
+	c.JSON(http.StatusOK, response)
+}
+
+// CreateLanguageVariant - Mevcut içerikten yeni dil versiyonunu çeviri yapmadan taslak olarak oluşturur
+// Metin kaynak dilde kopyalanır; editör çevirip yayına gönderir.
+func (h *Handler) CreateLanguageVariant(c *gin.Context) {
+	contentID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.BadRequest(c, "Geçersiz içerik ID'si")
+		return
+	}
+
+	userIDValue, exists := c.Get("user_id")
+	if !exists {
+		utils.Unauthorized(c, "Giriş yapmanız gerekiyor")
+		return
+	}
+
+	userID, ok := userIDValue.(uuid.UUID)
+	if !ok {
+		utils.InternalError(c, "Kullanıcı bilgisi alınamadı")
+		return
+	}
+
+	var input types.ContentVariantInput
+	if err := utils.ValidateRequest(c, &input); err != nil {
+		return
+	}
+	language := strings.ToLower(input.Language)
+	ctx := c.Request.Context()
+
+	site := configs.GetSiteConfig()
+	if !site.HasLanguage(language) {
+		utils.SendError(c, utils.ErrorInvalidValue, fmt.Sprintf("'%s' sitenin dillerinden biri değil (%s)", language, strings.Join(site.Languages, ", ")))
+		return
+	}
+
+	// Kaynak içeriği getir
+	source, err := h.Repository.GetContentByID(ctx, contentID)
+	if err != nil {
+		if strings.Contains(err.Error(), "bulunamadı") {
+			utils.NotFound(c, "İçerik")
+			return
+		}
+		utils.HandleDatabaseError(c, err, "İçerik getirme")
+		return
+	}
+
+	if source.Language == language {
+		utils.SendError(c, utils.ErrorInvalidValue, "Hedef dil içeriğin mevcut dili ile aynı")
+		return
+	}
+
+	exists, err = h.Repository.LanguageVersionExists(ctx, source.Identifier, language)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Dil versiyonu oluşturma")
+		return
+	}
+	if exists {
+		utils.SendError(c, utils.ErrorDuplicateEntry, fmt.Sprintf("Bu içeriğin '%s' dilinde zaten bir versiyonu var", language))
+		return
+	}
+
+	slug := input.Slug
+	if slug == "" {
+		slug, err = h.availableSlug(ctx, source.Slug, source.Slug, language)
+		if err != nil {
+			utils.HandleDatabaseError(c, err, "Dil versiyonu oluşturma")
+			return
+		}
+	}
+
+	contentInput := variantInput(source, language, slug)
+	if input.Title != "" {
+		contentInput.Title = input.Title
+	}
+	contentInput.ChangeNote = fmt.Sprintf("'%s' dilindeki içerikten oluşturuldu", source.Language)
+
+	created, err := h.Repository.CreateContent(ctx, contentInput, userID)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Dil versiyonu oluşturma")
+		return
+	}
+
+	h.Cache.ClearGroup(Group)
+
+	utils.SetETag(c, created.Version)
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"message": "Dil versiyonu taslak olarak oluşturuldu",
+		"data":    mapContentToView(created),
+	})
+}
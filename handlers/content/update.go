@@ -1,10 +1,12 @@
 package ContentHandler
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	cr "github.com/okanay/backend-holding/repositories/content"
 	"github.com/okanay/backend-holding/types"
 	"github.com/okanay/backend-holding/utils"
 )
@@ -30,15 +32,44 @@ func (h *Handler) UpdateContent(c *gin.Context) {
 		return
 	}
 
+	// Eşzamanlı düzenleme kontrolü - istemcinin bildiği sürüm
+	expectedVersion, ok := utils.RequireIfMatch(c)
+	if !ok {
+		return
+	}
+
 	// Input validasyonu
 	var input types.ContentInput
 	if err := utils.ValidateRequest(c, &input); err != nil {
 		return
 	}
 
-	// Güncelle
-	content, err := h.Repository.UpdateContent(c.Request.Context(), contentID, input, userID)
+	// Zengin metin izin listesine göre temizlenir, kaldırılanlar yanıtta raporlanır
+	var removed []types.HTMLRemoval
+	input.ContentHTML, removed = utils.SanitizeHTML(input.ContentHTML)
+
+	// Yayına alma sadece onaylayıcılara açık, diğerleri incelemeye gönderir
+	if input.Status == types.ContentStatusPublished && !requirePublisher(c) {
+		return
+	}
+
+	// Kategori değişiyorsa yönetilen kategorilerden biri olmalı
+	if input.Category != "" && !h.resolveCategory(c, &input) {
+		return
+	}
+
+	// Güncelle - yayındaki içeriği sadece onaylayıcılar düzenleyebilir, kontrol güncelleme sorgusunda yapılır
+	content, err := h.Repository.UpdateContent(c.Request.Context(), contentID, input, userID, expectedVersion, canPublish(c))
 	if err != nil {
+		var conflict *types.VersionConflictError
+		if errors.As(err, &conflict) {
+			utils.PreconditionFailed(c, conflict.CurrentVersion)
+			return
+		}
+		if errors.Is(err, cr.ErrPublishedContentLocked) {
+			utils.Forbidden(c, "Yayındaki içeriği düzenleme yetkiniz yok.")
+			return
+		}
 		utils.HandleDatabaseError(c, err, "İçerik güncelleme")
 		return
 	}
@@ -47,10 +78,12 @@ func (h *Handler) UpdateContent(c *gin.Context) {
 	h.Cache.ClearGroup(Group)
 
 	// Response
+	utils.SetETag(c, content.Version)
 	c.JSON(http.StatusOK, gin.H{
-		"success": true,
-		"message": "İçerik başarıyla güncellendi",
-		"data":    mapContentToView(content),
+		"success":   true,
+		"message":   "İçerik başarıyla güncellendi",
+		"data":      mapContentToView(content),
+		"sanitized": removed,
 	})
 }
 
@@ -62,14 +95,25 @@ func (h *Handler) UpdateContentStatus(c *gin.Context) {
 		return
 	}
 
+	userID, exists := c.Get("user_id")
+	if !exists {
+		utils.Unauthorized(c, "Giriş yapmanız gerekiyor")
+		return
+	}
+
 	// Input validasyonu
 	var input types.ContentStatusInput
 	if err := utils.ValidateRequest(c, &input); err != nil {
 		return
 	}
 
+	// Yayına alma sadece onaylayıcılara açık, diğerleri incelemeye gönderir
+	if input.Status == types.ContentStatusPublished && !requirePublisher(c) {
+		return
+	}
+
 	// Status güncelle
-	err = h.Repository.UpdateContentStatus(c.Request.Context(), contentID, input.Status)
+	err = h.Repository.UpdateContentStatus(c.Request.Context(), contentID, input.Status, userID.(uuid.UUID))
 	if err != nil {
 		utils.HandleDatabaseError(c, err, "Durum güncelleme")
 		return
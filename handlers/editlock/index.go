@@ -0,0 +1,36 @@
+package EditLockHandler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	EditLockRepository "github.com/okanay/backend-holding/repositories/editlock"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+type Handler struct {
+	Repository *EditLockRepository.Repository
+}
+
+func NewHandler(repo *EditLockRepository.Repository) *Handler {
+	return &Handler{
+		Repository: repo,
+	}
+}
+
+// parseTarget - Kilit alınacak kaydın türünü ve ID'sini okur
+func parseTarget(c *gin.Context) (types.EditLockResource, uuid.UUID, bool) {
+	resource := types.EditLockResource(c.Param("type"))
+	if resource != types.EditLockContent && resource != types.EditLockJob {
+		utils.BadRequest(c, "Geçersiz kayıt türü, 'content' veya 'job' olmalıdır")
+		return resource, uuid.Nil, false
+	}
+
+	resourceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.BadRequest(c, "Geçersiz kayıt ID'si")
+		return resource, uuid.Nil, false
+	}
+
+	return resource, resourceID, true
+}
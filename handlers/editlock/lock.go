@@ -0,0 +1,102 @@
+package EditLockHandler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/configs"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// GetLock kaydı şu anda kimin düzenlediğini döner
+func (h *Handler) GetLock(c *gin.Context) {
+	resource, resourceID, ok := parseTarget(c)
+	if !ok {
+		return
+	}
+
+	lock, err := h.Repository.GetLock(c.Request.Context(), resource, resourceID)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Düzenleme kilidi getirme")
+		return
+	}
+
+	if lock.UserID == uuid.Nil {
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"locked":  false,
+			"data":    nil,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"locked":  true,
+		"data":    lock,
+	})
+}
+
+// AcquireLock düzenleme kilidini alır veya süresini uzatır
+// Kilit danışma niteliğindedir; başka bir kullanıcı düzenliyorsa 409 ile o kullanıcı bildirilir,
+// kaydetme işlemleri yine de If-Match sürüm kontrolüne tabidir.
+func (h *Handler) AcquireLock(c *gin.Context) {
+	resource, resourceID, ok := parseTarget(c)
+	if !ok {
+		return
+	}
+
+	userID, exists := c.Get("user_id")
+	if !exists {
+		utils.Unauthorized(c, "Oturum bilgisi bulunamadı")
+		return
+	}
+
+	lock, acquired, err := h.Repository.AcquireLock(c.Request.Context(), resource, resourceID, userID.(uuid.UUID), configs.EDIT_LOCK_DURATION)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Düzenleme kilidi alma")
+		return
+	}
+
+	if !acquired {
+		c.JSON(http.StatusConflict, gin.H{
+			"success": false,
+			"error":   "edit_locked",
+			"message": fmt.Sprintf("Bu kayıt şu anda %s tarafından düzenleniyor.", lock.Username),
+			"data":    lock,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Düzenleme kilidi alındı",
+		"data":    lock,
+	})
+}
+
+// ReleaseLock kullanıcının düzenleme kilidini bırakır
+func (h *Handler) ReleaseLock(c *gin.Context) {
+	resource, resourceID, ok := parseTarget(c)
+	if !ok {
+		return
+	}
+
+	userID, exists := c.Get("user_id")
+	if !exists {
+		utils.Unauthorized(c, "Oturum bilgisi bulunamadı")
+		return
+	}
+
+	if err := h.Repository.ReleaseLock(c.Request.Context(), resource, resourceID, userID.(uuid.UUID)); err != nil {
+		utils.HandleDatabaseError(c, err, "Düzenleme kilidi bırakma")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Düzenleme kilidi bırakıldı",
+	})
+}
@@ -0,0 +1,15 @@
+package EmailTemplateHandler
+
+import (
+	EmailTemplateRepository "github.com/okanay/backend-holding/repositories/emailtemplate"
+)
+
+type Handler struct {
+	EmailTemplateRepository *EmailTemplateRepository.Repository
+}
+
+func NewHandler(t *EmailTemplateRepository.Repository) *Handler {
+	return &Handler{
+		EmailTemplateRepository: t,
+	}
+}
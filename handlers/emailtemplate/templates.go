@@ -0,0 +1,207 @@
+package EmailTemplateHandler
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/services/candidate"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// validateTemplateInput - Durum sadece status_changed olayında kullanılabilir, şablon bilinmeyen değişken içermemeli
+func validateTemplateInput(input *types.EmailTemplateInput) string {
+	input.Language = strings.ToLower(strings.TrimSpace(input.Language))
+	input.Status = strings.TrimSpace(input.Status)
+
+	if input.Status != "" && input.Event != types.EmailEventStatusChanged {
+		return "Durum sadece durum değişikliği şablonlarında belirtilebilir"
+	}
+
+	if unknown := candidate.UnknownPlaceholders(input.Subject, input.TextBody, input.HTMLBody); len(unknown) > 0 {
+		return "Şablonda desteklenmeyen değişkenler var: " + strings.Join(unknown, ", ")
+	}
+
+	return ""
+}
+
+// ListTemplates e-posta şablonlarını listeler (?event=, ?language=)
+func (h *Handler) ListTemplates(c *gin.Context) {
+	templates, err := h.EmailTemplateRepository.ListTemplates(c.Request.Context(), c.Query("event"), c.Query("language"))
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "E-posta şablonları listeleme")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    templates,
+	})
+}
+
+func (h *Handler) GetTemplate(c *gin.Context) {
+	template, ok := h.findTemplate(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    template,
+	})
+}
+
+func (h *Handler) CreateTemplate(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		utils.Unauthorized(c, "Oturum bilgisi bulunamadı")
+		return
+	}
+
+	var input types.EmailTemplateInput
+	if err := utils.ValidateRequest(c, &input); err != nil {
+		return
+	}
+
+	if msg := validateTemplateInput(&input); msg != "" {
+		utils.SendError(c, utils.ErrorInvalidValue, msg)
+		return
+	}
+
+	template, err := h.EmailTemplateRepository.CreateTemplate(c.Request.Context(), input, userID.(uuid.UUID))
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "E-posta şablonu oluşturma")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"message": "E-posta şablonu başarıyla oluşturuldu",
+		"data":    template,
+	})
+}
+
+func (h *Handler) UpdateTemplate(c *gin.Context) {
+	templateID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.BadRequest(c, "Geçersiz şablon ID'si")
+		return
+	}
+
+	userID, exists := c.Get("user_id")
+	if !exists {
+		utils.Unauthorized(c, "Oturum bilgisi bulunamadı")
+		return
+	}
+
+	var input types.EmailTemplateInput
+	if err := utils.ValidateRequest(c, &input); err != nil {
+		return
+	}
+
+	if msg := validateTemplateInput(&input); msg != "" {
+		utils.SendError(c, utils.ErrorInvalidValue, msg)
+		return
+	}
+
+	template, err := h.EmailTemplateRepository.UpdateTemplate(c.Request.Context(), templateID, input, userID.(uuid.UUID))
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "E-posta şablonu güncelleme")
+		return
+	}
+
+	if template.ID == uuid.Nil {
+		utils.NotFound(c, "E-posta şablonu")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "E-posta şablonu başarıyla güncellendi",
+		"data":    template,
+	})
+}
+
+func (h *Handler) DeleteTemplate(c *gin.Context) {
+	templateID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.BadRequest(c, "Geçersiz şablon ID'si")
+		return
+	}
+
+	deleted, err := h.EmailTemplateRepository.DeleteTemplate(c.Request.Context(), templateID)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "E-posta şablonu silme")
+		return
+	}
+
+	if !deleted {
+		utils.NotFound(c, "E-posta şablonu")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "E-posta şablonu başarıyla silindi",
+	})
+}
+
+// PreviewTemplate kayıtlı şablonu örnek değerlerle işler
+func (h *Handler) PreviewTemplate(c *gin.Context) {
+	template, ok := h.findTemplate(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    candidate.RenderTemplate(template.Subject, template.TextBody, template.HTMLBody, candidate.SampleVariables()),
+	})
+}
+
+// PreviewDraftTemplate kaydedilmemiş şablonu verilen veya örnek değerlerle işler
+func (h *Handler) PreviewDraftTemplate(c *gin.Context) {
+	var input types.EmailTemplatePreviewInput
+	if err := utils.ValidateRequest(c, &input); err != nil {
+		return
+	}
+
+	if unknown := candidate.UnknownPlaceholders(input.Subject, input.TextBody, input.HTMLBody); len(unknown) > 0 {
+		utils.SendError(c, utils.ErrorInvalidValue, "Şablonda desteklenmeyen değişkenler var: "+strings.Join(unknown, ", "))
+		return
+	}
+
+	variables := candidate.SampleVariables()
+	if input.Variables != nil {
+		variables = *input.Variables
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    candidate.RenderTemplate(input.Subject, input.TextBody, input.HTMLBody, variables),
+	})
+}
+
+// findTemplate - URL'deki ID ile şablonu getirir
+func (h *Handler) findTemplate(c *gin.Context) (types.EmailTemplate, bool) {
+	templateID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.BadRequest(c, "Geçersiz şablon ID'si")
+		return types.EmailTemplate{}, false
+	}
+
+	template, err := h.EmailTemplateRepository.GetTemplateByID(c.Request.Context(), templateID)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "E-posta şablonu getirme")
+		return template, false
+	}
+
+	if template.ID == uuid.Nil {
+		utils.NotFound(c, "E-posta şablonu")
+		return template, false
+	}
+
+	return template, true
+}
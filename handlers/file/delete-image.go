@@ -42,10 +42,8 @@ func (h *Handler) DeleteFile(c *gin.Context) {
 		return
 	}
 
-	// R2'den dosyayı sil
-	// URL'den object key'i çıkarmak gerekiyor, eğer URL: https://base/uploads/image.jpg ise
-	// objectKey: uploads/image.jpg olmalı
-	objectKey := extractObjectKeyFromURL(file.URL)
+	// R2'den dosyayı sil (URL: https://base/uploads/image.jpg -> objectKey: uploads/image.jpg)
+	objectKey := h.R2Repository.ObjectKeyFromURL(file.URL)
 
 	err = h.R2Repository.DeleteObject(c.Request.Context(), objectKey)
 	if err != nil {
@@ -70,14 +68,3 @@ func (h *Handler) DeleteFile(c *gin.Context) {
 		"message": "Dosya başarıyla silindi",
 	})
 }
-
-// URL'den object key'i çıkaran yardımcı fonksiyon
-func extractObjectKeyFromURL(url string) string {
-	// Base URL'yi çıkar, örn: https://files.project-test.info/uploads/image.jpg -> uploads/image.jpg
-	// Bu örnek için basit bir implementasyon, gerçek uygulamada daha sağlam bir çözüm gerekebilir
-	baseURL := "https://files.project-test.info/"
-	if len(url) > len(baseURL) && url[:len(baseURL)] == baseURL {
-		return url[len(baseURL):]
-	}
-	return url
-}
@@ -0,0 +1,120 @@
+package JobHandler
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/configs"
+	"github.com/okanay/backend-holding/services/cache"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// validateBulkInput - Seçim ve işlem parametrelerinin tutarlılığını kontrol eder
+func validateBulkInput(input types.JobApplicationBulkInput, isAdmin bool) string {
+	if (len(input.IDs) == 0) == (input.Filter == nil) {
+		return "Başvuru ID listesi veya filtreden yalnızca biri gönderilmelidir"
+	}
+
+	// Boş filtre tüm başvuruları seçeceği için en az bir kriter zorunludur
+	if f := input.Filter; f != nil && f.JobID == uuid.Nil && f.Status == "" && f.Tag == "" &&
+		f.FullName == "" && f.Email == "" && f.StartDate == "" && f.EndDate == "" {
+		return "Filtre en az bir kriter içermelidir"
+	}
+
+	switch input.Action {
+	case types.JobApplicationBulkStatus:
+		if input.Status == "" {
+			return "Durum değişikliği için yeni durum girilmelidir"
+		}
+	case types.JobApplicationBulkTag, types.JobApplicationBulkUntag:
+		if input.Tag == "" {
+			return "Etiket işlemi için etiket girilmelidir"
+		}
+		if input.Notify {
+			return "Etiket işlemlerinde adaylara bildirim gönderilemez"
+		}
+	case types.JobApplicationBulkDelete:
+		if !isAdmin {
+			return "Başvuruları sadece yöneticiler silebilir"
+		}
+	}
+
+	return ""
+}
+
+func (h *Handler) BulkUpdateApplications(c *gin.Context) {
+	// Kullanıcı ID'sini al
+	userID, exists := c.Get("user_id")
+	if !exists {
+		utils.Unauthorized(c, "Oturum bilgisi bulunamadı")
+		return
+	}
+
+	// Yönetici değilse işlem yalnızca sahip veya işe alım uzmanı olduğu ilanların başvurularına uygulanır
+	scope, ok := applicantScope(c)
+	if !ok {
+		return
+	}
+
+	// İstek verilerini doğrula
+	var input types.JobApplicationBulkInput
+	if err := utils.ValidateRequest(c, &input); err != nil {
+		return
+	}
+
+	if msg := validateBulkInput(input, scope == uuid.Nil); msg != "" {
+		if input.Action == types.JobApplicationBulkDelete && scope != uuid.Nil {
+			utils.Forbidden(c, msg)
+			return
+		}
+		utils.SendError(c, utils.ErrorInvalidValue, msg)
+		return
+	}
+
+	// Bildirim e-postaları işlemle aynı transaction içinde kuyruğa alınır
+	var compose func([]types.JobApplicationRecipient) []types.OutboxMessage
+	if input.Notify {
+		compose = h.Candidate.BulkNotifications(c.Request.Context(), input)
+	}
+
+	result, err := h.JobRepository.BulkUpdateApplications(c.Request.Context(), input, scope, userID.(uuid.UUID), compose)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Toplu başvuru işlemi")
+		return
+	}
+
+	// Deneme çalıştırması sınırı aşsa da sayıyı döner, istemci overLimit ile uyarı gösterir
+	if result.DryRun {
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"message": "İşlemden etkilenecek başvuru sayısı hesaplandı",
+			"data":    result,
+		})
+		return
+	}
+
+	if result.OverLimit {
+		utils.SendError(c, utils.ErrorInvalidValue, fmt.Sprintf("Tek seferde en fazla %d başvuru işlenebilir, filtreyi daraltın", configs.BULK_APPLICATION_MAX))
+		return
+	}
+
+	// Silinen başvuruların ekleri veritabanı işlemi tamamlandıktan sonra temizlenir
+	if len(result.DeletedForms) > 0 {
+		deleted, err := h.Privacy.DeleteApplicationAttachments(c.Request.Context(), result.DeletedForms)
+		result.FilesDeleted = deleted
+		if err != nil {
+			log.Printf("[JOB] Silinen başvuruların ekleri temizlenemedi: %v", err)
+		}
+	}
+
+	h.Cache.ClearGroup(cache.GroupJobs)
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Toplu işlem başarıyla uygulandı",
+		"data":    result,
+	})
+}
@@ -2,9 +2,11 @@ package JobHandler
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/configs"
 	"github.com/okanay/backend-holding/services/cache"
 	"github.com/okanay/backend-holding/types"
 	"github.com/okanay/backend-holding/utils"
@@ -24,6 +26,21 @@ func (h *Handler) CreateJobApplication(c *gin.Context) {
 		return
 	}
 
+	// Başvuru kanalını belirle (gövde > utm_source > direct)
+	input.Source = strings.ToLower(strings.TrimSpace(input.Source))
+	if input.Source == "" {
+		input.Source = strings.ToLower(strings.TrimSpace(c.Query("utm_source")))
+	}
+	if input.Source == "" || len(input.Source) > 50 {
+		input.Source = "direct"
+	}
+
+	// Aday e-postalarının dili
+	input.Language = strings.ToLower(strings.TrimSpace(input.Language))
+	if input.Language == "" {
+		input.Language = configs.EMAIL_DEFAULT_LANGUAGE
+	}
+
 	// Başvuruyu oluştur
 	application, err := h.JobRepository.CreateJobApplication(c.Request.Context(), jobID, input)
 	if err != nil {
@@ -31,6 +48,9 @@ func (h *Handler) CreateJobApplication(c *gin.Context) {
 		return
 	}
 
+	// Başvuru alındı e-postasını kuyruğa al, hata başvuruyu etkilemez
+	h.notifyApplicant(c, application.ID, types.EmailEventApplicationReceived, application.Status)
+
 	h.Cache.ClearGroup(cache.GroupJobs)
 	c.JSON(http.StatusCreated, gin.H{
 		"success": true,
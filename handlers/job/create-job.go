@@ -5,6 +5,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/configs"
 	"github.com/okanay/backend-holding/services/cache"
 	"github.com/okanay/backend-holding/types"
 	"github.com/okanay/backend-holding/utils"
@@ -24,6 +25,18 @@ func (h *Handler) CreateJob(c *gin.Context) {
 		return
 	}
 
+	// İlan metni izin listesine göre temizlenir, kaldırılanlar yanıtta raporlanır
+	var removed []types.HTMLRemoval
+	input.HTML, removed = utils.SanitizeHTML(input.HTML)
+
+	// İlan dili - boşsa sitenin varsayılan dili
+	if !normalizeJobLanguage(c, &input) {
+		return
+	}
+	if input.Language == "" {
+		input.Language = configs.GetSiteConfig().DefaultLanguage()
+	}
+
 	// İş ilanını oluştur
 	job, err := h.JobRepository.CreateJob(c.Request.Context(), input, userID.(uuid.UUID))
 	if err != nil {
@@ -32,9 +45,11 @@ func (h *Handler) CreateJob(c *gin.Context) {
 	}
 
 	h.Cache.ClearGroup(cache.GroupJobs)
+	utils.SetETag(c, job.Version)
 	c.JSON(http.StatusCreated, gin.H{
-		"success": true,
-		"message": "İş ilanı başarıyla oluşturuldu",
-		"data":    job,
+		"success":   true,
+		"message":   "İş ilanı başarıyla oluşturuldu",
+		"data":      job,
+		"sanitized": removed,
 	})
 }
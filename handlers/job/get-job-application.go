@@ -22,6 +22,7 @@ func (h *Handler) ListJobApplications(c *gin.Context) {
 	sortBy := c.DefaultQuery("sortBy", "created_at")
 	sortOrder := c.DefaultQuery("sortOrder", "desc")
 	status := c.DefaultQuery("status", "")
+	tag := c.DefaultQuery("tag", "")
 	email := c.DefaultQuery("email", "")
 	startDate := c.DefaultQuery("startDate", "")
 	endDate := c.DefaultQuery("endDate", "")
@@ -37,9 +38,15 @@ func (h *Handler) ListJobApplications(c *gin.Context) {
 		}
 	}
 
-	// Cache identifier oluştur - tüm parametreleri içerir
-	cacheIdentifier := fmt.Sprintf("applications:list:p%d:l%d:fn%s:s%s:o%s:st%s:e%s:sd%s:ed%s:jid%s",
-		page, limit, fullName, sortBy, sortOrder, status, email, startDate, endDate, jobIDStr)
+	// Erişim kapsamı - yöneticiler tüm başvuruları, diğerleri ekibinde oldukları ilanları görür
+	scope, ok := applicantScope(c)
+	if !ok {
+		return
+	}
+
+	// Cache identifier oluştur - tüm parametreleri ve kapsamı içerir
+	cacheIdentifier := fmt.Sprintf("applications:list:%s:p%d:l%d:fn%s:s%s:o%s:st%s:tg%s:e%s:sd%s:ed%s:jid%s",
+		scopeKey(scope), page, limit, fullName, sortBy, sortOrder, status, tag, email, startDate, endDate, jobIDStr)
 
 	// Cache kontrolü - önbellekte varsa doğrudan dön
 	if h.Cache.TryCache(c, cache.GroupJobs, cacheIdentifier) {
@@ -49,7 +56,9 @@ func (h *Handler) ListJobApplications(c *gin.Context) {
 	// Parametreleri SearchParams yapısına dönüştür
 	params := types.JobApplicationSearchParams{
 		JobID:     jobID,
+		ScopeUser: scope,
 		Status:    status,
+		Tag:       tag,
 		FullName:  fullName,
 		Email:     email,
 		StartDate: startDate,
@@ -100,8 +109,13 @@ func (h *Handler) GetJobApplication(c *gin.Context) {
 		return
 	}
 
+	scope, ok := applicantScope(c)
+	if !ok {
+		return
+	}
+
 	// Cache identifier oluştur
-	cacheIdentifier := "application:detail:" + applicationID.String()
+	cacheIdentifier := "application:detail:" + scopeKey(scope) + ":" + applicationID.String()
 
 	// Cache kontrolü - önbellekte varsa doğrudan dön
 	if h.Cache.TryCache(c, cache.GroupJobs, cacheIdentifier) {
@@ -121,6 +135,19 @@ func (h *Handler) GetJobApplication(c *gin.Context) {
 		return
 	}
 
+	// Yönetici değilse ilanın işe alım ekibinde olmalı
+	if scope != uuid.Nil {
+		role, err := h.JobRepository.GetTeamRole(c.Request.Context(), application.JobID, scope)
+		if err != nil {
+			utils.HandleDatabaseError(c, err, "Ekip rolü kontrolü")
+			return
+		}
+		if role == "" {
+			utils.NotFound(c, "Başvuru")
+			return
+		}
+	}
+
 	// Yanıt hazırla
 	response := gin.H{
 		"success": true,
@@ -139,15 +166,8 @@ func (h *Handler) GetJobApplication(c *gin.Context) {
 
 func (h *Handler) GetJobApplicationsByEmail(c *gin.Context) {
 	// Middleware'den "tracking_email" değerini al
-	emailAny, exists := c.Get("tracking_email")
-	if !exists {
-		utils.Unauthorized(c, "Oturum bilgisi bulunamadı")
-		return
-	}
-
-	email, ok := emailAny.(string)
-	if !ok || email == "" {
-		utils.Unauthorized(c, "Geçersiz oturum bilgisi")
+	email, ok := utils.TrackingEmail(c)
+	if !ok {
 		return
 	}
 
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -21,8 +22,25 @@ func (h *Handler) GetJobByID(c *gin.Context) {
 		return
 	}
 
-	// Cache kontrolü - önbellekte varsa doğrudan dön
-	cacheIdentifier := "job:detail:" + jobID.String()
+	// Sürüm kontrolü - ETag her istekte güncel sürümden üretilir
+	version, err := h.JobRepository.GetJobVersion(c.Request.Context(), jobID)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "İş ilanı getirme")
+		return
+	}
+
+	if version == 0 {
+		utils.NotFound(c, "İş ilanı")
+		return
+	}
+
+	utils.SetETag(c, version)
+	if utils.NotModified(c, version) {
+		return
+	}
+
+	// Cache kontrolü - anahtar sürümü içerdiği için önbellek ETag ile çelişmez
+	cacheIdentifier := fmt.Sprintf("job:detail:%s:v%d", jobID, version)
 	if h.Cache.TryCache(c, cache.GroupJobs, cacheIdentifier) {
 		return
 	}
@@ -40,6 +58,13 @@ func (h *Handler) GetJobByID(c *gin.Context) {
 		return
 	}
 
+	// İşe alım ekibini ekle
+	job.HiringTeam, err = h.JobRepository.GetHiringTeam(c.Request.Context(), jobID)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "İşe alım ekibi getirme")
+		return
+	}
+
 	// Yanıt hazırla
 	response := gin.H{
 		"success": true,
@@ -56,7 +81,7 @@ func (h *Handler) GetJobByID(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
-// GetJobBySlug bir iş ilanını slug'a göre getirir
+// GetJobBySlug bir iş ilanını slug'a göre getirir (?format=jsonld ile schema.org JobPosting döner)
 func (h *Handler) GetJobBySlug(c *gin.Context) {
 	// Slug'ı al
 	slug := c.Param("id")
@@ -65,6 +90,12 @@ func (h *Handler) GetJobBySlug(c *gin.Context) {
 		return
 	}
 
+	// Google for Jobs için yapılandırılmış veri istenmişse
+	if c.Query("format") == "jsonld" {
+		h.getJobJSONLD(c, slug)
+		return
+	}
+
 	// Cache kontrolü - önbellekte varsa doğrudan dön
 	cacheIdentifier := "job:slug:" + slug
 	if h.Cache.TryCache(c, cache.GroupJobs, cacheIdentifier) {
@@ -112,10 +143,17 @@ func (h *Handler) ListJobs(c *gin.Context) {
 	category := c.DefaultQuery("category", "")
 	location := c.DefaultQuery("location", "")
 	query := c.DefaultQuery("q", "")
+	language := strings.ToLower(c.Query("language"))
+	scheduled := c.Query("scheduled") == "true"
+
+	// Arama yapılırken sıralama belirtilmemişse alaka sırası kullanılır
+	if query != "" && c.Query("sortBy") == "" {
+		sortBy = "relevance"
+	}
 
 	// Cache identifier oluştur - tüm parametreleri içerir
-	cacheIdentifier := fmt.Sprintf("job:list:p%d:l%d:s%s:o%s:st%s:c%s:loc%s:q%s",
-		page, limit, sortBy, sortOrder, status, category, location, query)
+	cacheIdentifier := fmt.Sprintf("job:list:p%d:l%d:s%s:o%s:st%s:c%s:loc%s:q%s:lang%s:sch%t",
+		page, limit, sortBy, sortOrder, status, category, location, query, language, scheduled)
 
 	// Cache kontrolü - önbellekte varsa doğrudan dön
 	if h.Cache.TryCache(c, cache.GroupJobs, cacheIdentifier) {
@@ -128,6 +166,8 @@ func (h *Handler) ListJobs(c *gin.Context) {
 		Category:  category,
 		Location:  location,
 		Query:     query,
+		Language:  language,
+		Scheduled: scheduled,
 		Page:      page,
 		Limit:     limit,
 		SortBy:    sortBy,
@@ -175,10 +215,16 @@ func (h *Handler) ListPublishedJobs(c *gin.Context) {
 	category := c.DefaultQuery("category", "")
 	location := c.DefaultQuery("location", "")
 	query := c.DefaultQuery("q", "")
+	language := strings.ToLower(c.Query("language"))
+
+	// Arama yapılırken sıralama belirtilmemişse alaka sırası kullanılır
+	if query != "" && c.Query("sortBy") == "" {
+		sortBy = "relevance"
+	}
 
 	// Cache identifier oluştur - tüm parametreleri içerir
-	cacheIdentifier := fmt.Sprintf("job:published:p%d:l%d:s%s:o%s:c%s:loc%s:q%s",
-		page, limit, sortBy, sortOrder, category, location, query)
+	cacheIdentifier := fmt.Sprintf("job:published:p%d:l%d:s%s:o%s:c%s:loc%s:q%s:lang%s",
+		page, limit, sortBy, sortOrder, category, location, query, language)
 
 	// Cache kontrolü - önbellekte varsa doğrudan dön
 	if h.Cache.TryCache(c, cache.GroupJobs, cacheIdentifier) {
@@ -191,6 +237,7 @@ func (h *Handler) ListPublishedJobs(c *gin.Context) {
 		Category:  category,
 		Location:  location,
 		Query:     query,
+		Language:  language,
 		Page:      page,
 		Limit:     limit,
 		SortBy:    sortBy,
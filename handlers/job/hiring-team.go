@@ -0,0 +1,97 @@
+package JobHandler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// applicantScope - Başvuru erişim kapsamını döner; yöneticiler için uuid.Nil (tüm ilanlar)
+func applicantScope(c *gin.Context) (uuid.UUID, bool) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		utils.Unauthorized(c, "Oturum bilgisi bulunamadı")
+		return uuid.Nil, false
+	}
+
+	if role, _ := c.Get("role"); role == types.RoleAdmin {
+		return uuid.Nil, true
+	}
+
+	return userID.(uuid.UUID), true
+}
+
+// authorizeApplication - Başvuruyu getirir ve kullanıcının ilanın ekibinde olduğunu doğrular
+// manage true ise sadece sahip ve işe alım uzmanı yetkilidir, mülakatçılar salt okunurdur.
+func (h *Handler) authorizeApplication(c *gin.Context, applicationID uuid.UUID, manage bool) (types.JobApplication, bool) {
+	scope, ok := applicantScope(c)
+	if !ok {
+		return types.JobApplication{}, false
+	}
+
+	application, err := h.JobRepository.GetJobApplicationByID(c.Request.Context(), applicationID)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Başvuru getirme")
+		return application, false
+	}
+
+	if application.ID == uuid.Nil {
+		utils.NotFound(c, "Başvuru")
+		return application, false
+	}
+
+	// Yöneticiler tüm başvurulara erişebilir
+	if scope == uuid.Nil {
+		return application, true
+	}
+
+	role, err := h.JobRepository.GetTeamRole(c.Request.Context(), application.JobID, scope)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Ekip rolü kontrolü")
+		return application, false
+	}
+
+	if role == "" {
+		utils.NotFound(c, "Başvuru")
+		return application, false
+	}
+
+	if manage && role != types.JobTeamRoleOwner && role != types.JobTeamRoleRecruiter {
+		utils.Forbidden(c, "Mülakatçılar bu işlemi yapamaz")
+		return application, false
+	}
+
+	return application, true
+}
+
+// scopeKey - Cache anahtarında kullanılacak kapsam değeri
+func scopeKey(scope uuid.UUID) string {
+	if scope == uuid.Nil {
+		return "admin"
+	}
+	return scope.String()
+}
+
+// validateHiringTeam - Ekip listesinde tekrar eden kullanıcı olmamalı ve en az bir sahip bulunmalı
+func validateHiringTeam(team []types.JobTeamMemberInput) string {
+	seen := make(map[uuid.UUID]bool, len(team))
+	hasOwner := false
+
+	for _, member := range team {
+		if seen[member.UserID] {
+			return "İşe alım ekibinde aynı kullanıcı birden fazla kez yer alamaz"
+		}
+		seen[member.UserID] = true
+
+		if member.Role == types.JobTeamRoleOwner {
+			hasOwner = true
+		}
+	}
+
+	if !hasOwner {
+		return "İşe alım ekibinde en az bir sahip (owner) olmalıdır"
+	}
+
+	return ""
+}
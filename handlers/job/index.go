@@ -5,6 +5,11 @@ import (
 	JobRepository "github.com/okanay/backend-holding/repositories/job"
 	R2Repository "github.com/okanay/backend-holding/repositories/r2"
 	"github.com/okanay/backend-holding/services/cache"
+	"github.com/okanay/backend-holding/services/candidate"
+	"github.com/okanay/backend-holding/services/jobalert"
+	"github.com/okanay/backend-holding/services/preview"
+	"github.com/okanay/backend-holding/services/privacy"
+	"github.com/okanay/backend-holding/services/screening"
 )
 
 // handler/job.go
@@ -13,13 +18,23 @@ type Handler struct {
 	R2Repository   *R2Repository.Repository
 	JobRepository  *JobRepository.Repository
 	Cache          cache.CacheService // İşaretçi değil, doğrudan arayüz
+	JobAlert       *jobalert.Service
+	Candidate      *candidate.Service
+	Privacy        *privacy.Service
+	Screening      *screening.Service
+	Preview        *preview.Service
 }
 
-func NewHandler(f *FileRepository.Repository, r2 *R2Repository.Repository, j *JobRepository.Repository, c cache.CacheService) *Handler {
+func NewHandler(f *FileRepository.Repository, r2 *R2Repository.Repository, j *JobRepository.Repository, c cache.CacheService, ja *jobalert.Service, cs *candidate.Service, ps *privacy.Service, ss *screening.Service, pvs *preview.Service) *Handler {
 	return &Handler{
 		FileRepository: f,
 		R2Repository:   r2,
 		JobRepository:  j,
 		Cache:          c,
+		JobAlert:       ja,
+		Candidate:      cs,
+		Privacy:        ps,
+		Screening:      ss,
+		Preview:        pvs,
 	}
 }
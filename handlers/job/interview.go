@@ -0,0 +1,204 @@
+package JobHandler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/services/cache"
+	"github.com/okanay/backend-holding/services/candidate"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// validateInterviewInput - Mülakat gelecekte olmalı ve bir adres veya görüntülü görüşme bağlantısı içermeli
+func validateInterviewInput(input types.JobInterviewInput) string {
+	if !input.StartsAt.After(time.Now()) {
+		return "Mülakat zamanı gelecekte olmalıdır"
+	}
+
+	if input.Location == "" && input.VideoURL == "" {
+		return "Mülakat için adres veya görüntülü görüşme bağlantısı girilmelidir"
+	}
+
+	return ""
+}
+
+func (h *Handler) CreateInterview(c *gin.Context) {
+	// Başvuru ID'sini al
+	applicationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.BadRequest(c, "Geçersiz başvuru ID'si")
+		return
+	}
+
+	// Kullanıcı ID'sini al
+	userID, exists := c.Get("user_id")
+	if !exists {
+		utils.Unauthorized(c, "Oturum bilgisi bulunamadı")
+		return
+	}
+
+	// İstek verilerini doğrula
+	var input types.JobInterviewInput
+	if err := utils.ValidateRequest(c, &input); err != nil {
+		return
+	}
+
+	if msg := validateInterviewInput(input); msg != "" {
+		utils.SendError(c, utils.ErrorInvalidValue, msg)
+		return
+	}
+
+	// Sadece yöneticiler, ilan sahipleri ve işe alım uzmanları mülakat planlayabilir
+	if _, ok := h.authorizeApplication(c, applicationID, true); !ok {
+		return
+	}
+
+	// Mülakatı oluştur, başvuru mülakat aşamasına taşınır
+	interview, err := h.JobRepository.CreateInterview(c.Request.Context(), applicationID, input, userID.(uuid.UUID))
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Mülakat planlama")
+		return
+	}
+
+	// Davet e-postalarını (.ics ekli) arka planda gönder
+	h.Candidate.NotifyInterview(interview, candidate.InterviewScheduled)
+
+	h.Cache.ClearGroup(cache.GroupJobs)
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"message": "Mülakat başarıyla planlandı",
+		"data":    interview,
+	})
+}
+
+func (h *Handler) ListApplicationInterviews(c *gin.Context) {
+	// Başvuru ID'sini al
+	applicationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.BadRequest(c, "Geçersiz başvuru ID'si")
+		return
+	}
+
+	// Ekibin tüm üyeleri (mülakatçılar dahil) mülakatları görebilir
+	if _, ok := h.authorizeApplication(c, applicationID, false); !ok {
+		return
+	}
+
+	interviews, err := h.JobRepository.ListInterviewsByApplication(c.Request.Context(), applicationID)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Mülakatları getirme")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    interviews,
+	})
+}
+
+func (h *Handler) RescheduleInterview(c *gin.Context) {
+	// Mülakat ID'sini al
+	interviewID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.BadRequest(c, "Geçersiz mülakat ID'si")
+		return
+	}
+
+	// Kullanıcı ID'sini al
+	userID, exists := c.Get("user_id")
+	if !exists {
+		utils.Unauthorized(c, "Oturum bilgisi bulunamadı")
+		return
+	}
+
+	// İstek verilerini doğrula
+	var input types.JobInterviewInput
+	if err := utils.ValidateRequest(c, &input); err != nil {
+		return
+	}
+
+	if msg := validateInterviewInput(input); msg != "" {
+		utils.SendError(c, utils.ErrorInvalidValue, msg)
+		return
+	}
+
+	if _, ok := h.authorizeInterview(c, interviewID); !ok {
+		return
+	}
+
+	interview, err := h.JobRepository.RescheduleInterview(c.Request.Context(), interviewID, input, userID.(uuid.UUID))
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Mülakat yeniden planlama")
+		return
+	}
+
+	// Güncellenmiş daveti gönder (aynı UID ve artırılmış SEQUENCE ile takvimdeki kayıt güncellenir)
+	h.Candidate.NotifyInterview(interview, candidate.InterviewRescheduled)
+
+	h.Cache.ClearGroup(cache.GroupJobs)
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Mülakat başarıyla güncellendi",
+		"data":    interview,
+	})
+}
+
+func (h *Handler) UpdateInterviewStatus(c *gin.Context) {
+	// Mülakat ID'sini al
+	interviewID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.BadRequest(c, "Geçersiz mülakat ID'si")
+		return
+	}
+
+	// İstek verilerini doğrula
+	var input types.JobInterviewStatusInput
+	if err := utils.ValidateRequest(c, &input); err != nil {
+		return
+	}
+
+	if _, ok := h.authorizeInterview(c, interviewID); !ok {
+		return
+	}
+
+	interview, err := h.JobRepository.UpdateInterviewStatus(c.Request.Context(), interviewID, input.Status)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Mülakat durumu güncelleme")
+		return
+	}
+
+	// İptal edilen mülakat katılımcıların takviminden kaldırılır
+	if input.Status == types.JobInterviewStatusCancelled {
+		h.Candidate.NotifyInterview(interview, candidate.InterviewCancelled)
+	}
+
+	h.Cache.ClearGroup(cache.GroupJobs)
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Mülakat durumu başarıyla güncellendi",
+		"data":    interview,
+	})
+}
+
+// authorizeInterview - Mülakatı getirir ve kullanıcının başvuruyu yönetme yetkisini doğrular
+func (h *Handler) authorizeInterview(c *gin.Context, interviewID uuid.UUID) (types.JobInterviewView, bool) {
+	interview, err := h.JobRepository.GetInterviewByID(c.Request.Context(), interviewID)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Mülakat getirme")
+		return interview, false
+	}
+
+	if interview.ID == uuid.Nil {
+		utils.NotFound(c, "Mülakat")
+		return interview, false
+	}
+
+	if _, ok := h.authorizeApplication(c, interview.ApplicationID, true); !ok {
+		return interview, false
+	}
+
+	return interview, true
+}
@@ -0,0 +1,145 @@
+package JobHandler
+
+import (
+	"encoding/xml"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/backend-holding/configs"
+	"github.com/okanay/backend-holding/services/cache"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+const feedContentType = "application/xml; charset=utf-8"
+
+// GetJobFeed yayındaki iş ilanlarını harici ilan siteleri için XML feed olarak döner
+func (h *Handler) GetJobFeed(c *gin.Context) {
+	// Feed varyantını al
+	boardName := strings.ToLower(strings.TrimSuffix(c.Param("board"), ".xml"))
+	board, exists := configs.JobFeedBoards[boardName]
+	if !exists {
+		utils.NotFound(c, "Feed")
+		return
+	}
+
+	// Cache kontrolü - önbellekte varsa doğrudan dön
+	cacheIdentifier := "job:feed:" + boardName
+	if h.Cache.TryCacheRaw(c, cache.GroupJobs, cacheIdentifier, feedContentType) {
+		return
+	}
+
+	// Yayındaki ilanları getir
+	jobs, err := h.JobRepository.GetAllJobs(c.Request.Context())
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "İş ilanı feed'i oluşturma")
+		return
+	}
+
+	site := configs.GetSiteConfig()
+	feed := types.JobFeedXML{
+		Publisher:     board.Publisher,
+		PublisherURL:  site.BaseURL,
+		LastBuildDate: time.Now().UTC().Format(time.RFC1123Z),
+	}
+
+	now := time.Now()
+	for _, job := range jobs {
+		// Süresi dolmuş ilanlar varsayılan olarak verilmez
+		if !board.IncludeExpired && job.Deadline != nil && job.Deadline.Before(now) {
+			continue
+		}
+		feed.Jobs = append(feed.Jobs, mapJobToFeedItem(job, board, site))
+	}
+
+	// XML'i oluştur
+	body, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		utils.InternalError(c, "Feed oluşturulamadı")
+		return
+	}
+	body = append([]byte(xml.Header), body...)
+
+	// Yanıtı önbelleğe al
+	h.Cache.SaveCacheRawTTL(body, cache.GroupJobs, cacheIdentifier, configs.JOB_FEED_CACHE_DURATION)
+
+	// Cache header'ı ekle
+	c.Header("X-Cache", "MISS")
+
+	// Yanıtı döndür
+	c.Data(http.StatusOK, feedContentType, body)
+}
+
+// mapJobToFeedItem - JobView'ı feed elemanına dönüştürür
+func mapJobToFeedItem(job types.JobView, board configs.JobFeedBoard, site configs.SiteConfig) types.JobFeedItemXML {
+	description := utils.StripHTML(job.Details.HTML)
+	if board.HTMLDescription {
+		description = job.Details.HTML
+	}
+	if strings.TrimSpace(description) == "" {
+		description = job.Details.Description
+	}
+
+	item := types.JobFeedItemXML{
+		Title:           types.XMLCDATA{Value: job.Details.Title},
+		Date:            job.CreatedAt.UTC().Format(time.RFC1123Z),
+		ReferenceNumber: job.ID.String(),
+		URL:             types.XMLCDATA{Value: withUTMSource(site.JobURL(job.Slug), board.UTMSource)},
+		ApplyURL:        types.XMLCDATA{Value: withUTMSource(site.JobApplyURL(job.Slug), board.UTMSource)},
+		Company:         types.XMLCDATA{Value: site.OrganizationName},
+		Country:         site.Country,
+		Description:     types.XMLCDATA{Value: description},
+	}
+
+	if location := strings.TrimSpace(job.Details.Location); location != "" {
+		item.City = &types.XMLCDATA{Value: location}
+	}
+
+	if telecommuteWorkModes[strings.ToLower(strings.TrimSpace(job.Details.WorkMode))] {
+		item.RemoteType = job.Details.WorkMode
+	}
+
+	if employmentType := mapEmploymentType(job.Details.EmploymentType); employmentType != "" {
+		item.JobType = strings.ToLower(strings.ReplaceAll(employmentType, "_", ""))
+	}
+
+	if level := strings.TrimSpace(job.Details.ExperienceLevel); level != "" {
+		item.Experience = &types.XMLCDATA{Value: level}
+	}
+
+	if len(job.Categories) > 0 {
+		names := make([]string, 0, len(job.Categories))
+		for _, category := range job.Categories {
+			names = append(names, category.DisplayName)
+		}
+		item.Category = &types.XMLCDATA{Value: strings.Join(names, ", ")}
+	}
+
+	if job.Deadline != nil {
+		item.ExpirationDate = job.Deadline.UTC().Format(time.RFC1123Z)
+	}
+
+	return item
+}
+
+// withUTMSource - Linke feed kaynağını belirten utm parametrelerini ekler
+func withUTMSource(rawURL, source string) string {
+	if source == "" {
+		return rawURL
+	}
+
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+
+	query := parsed.Query()
+	query.Set("utm_source", source)
+	query.Set("utm_medium", "job_feed")
+	parsed.RawQuery = query.Encode()
+
+	return parsed.String()
+}
@@ -0,0 +1,211 @@
+package JobHandler
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/configs"
+	"github.com/okanay/backend-holding/services/cache"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// employmentTypeMap - Serbest metin çalışma tiplerini schema.org değerlerine eşler
+var employmentTypeMap = map[string]string{
+	"full-time":    "FULL_TIME",
+	"full time":    "FULL_TIME",
+	"fulltime":     "FULL_TIME",
+	"tam zamanlı":  "FULL_TIME",
+	"tam zamanli":  "FULL_TIME",
+	"part-time":    "PART_TIME",
+	"part time":    "PART_TIME",
+	"parttime":     "PART_TIME",
+	"yarı zamanlı": "PART_TIME",
+	"yari zamanli": "PART_TIME",
+	"contract":     "CONTRACTOR",
+	"contractor":   "CONTRACTOR",
+	"freelance":    "CONTRACTOR",
+	"sözleşmeli":   "CONTRACTOR",
+	"sozlesmeli":   "CONTRACTOR",
+	"temporary":    "TEMPORARY",
+	"geçici":       "TEMPORARY",
+	"gecici":       "TEMPORARY",
+	"internship":   "INTERN",
+	"intern":       "INTERN",
+	"staj":         "INTERN",
+	"stajyer":      "INTERN",
+	"volunteer":    "VOLUNTEER",
+	"gönüllü":      "VOLUNTEER",
+	"gonullu":      "VOLUNTEER",
+	"per diem":     "PER_DIEM",
+}
+
+// telecommuteWorkModes - TELECOMMUTE olarak işaretlenecek çalışma şekilleri
+// Google bu değeri sadece tamamen uzaktan işler için kabul eder; hibrit ilanlar fiziksel konumlarıyla yayınlanır.
+var telecommuteWorkModes = map[string]bool{
+	"remote":  true,
+	"uzaktan": true,
+}
+
+// getJobJSONLD iş ilanını schema.org JobPosting olarak döner (?format=jsonld)
+func (h *Handler) getJobJSONLD(c *gin.Context, slug string) {
+	// Cache kontrolü - önbellekte varsa doğrudan dön
+	cacheIdentifier := "job:slug:jsonld:" + slug
+	if h.Cache.TryCache(c, cache.GroupJobs, cacheIdentifier) {
+		return
+	}
+
+	// İş ilanını getir
+	job, err := h.JobRepository.GetJobBySlug(c.Request.Context(), slug)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "İş ilanı getirme")
+		return
+	}
+
+	// İş ilanı bulunamadıysa
+	if job.ID == uuid.Nil {
+		utils.NotFound(c, "İş ilanı")
+		return
+	}
+
+	posting := mapJobToJSONLD(job, configs.GetSiteConfig())
+
+	// Zorunlu alanlar eksikse Google bu çıktıyı reddeder, yayınlamak yerine hata dön
+	if missing := validateJobPostingJSONLD(posting); len(missing) > 0 {
+		utils.SendError(c, utils.ErrorInvalidValue, "JobPosting zorunlu alanları eksik: "+strings.Join(missing, ", "))
+		return
+	}
+
+	// Yanıt hazırla
+	response := gin.H{
+		"success": true,
+		"data":    posting,
+	}
+
+	// Yanıtı önbelleğe al
+	h.Cache.SaveCache(response, cache.GroupJobs, cacheIdentifier)
+
+	// Cache header'ı ekle
+	c.Header("X-Cache", "MISS")
+
+	// Yanıtı döndür
+	c.JSON(http.StatusOK, response)
+}
+
+// mapJobToJSONLD - JobView'ı schema.org JobPosting yapısına dönüştürür
+func mapJobToJSONLD(job types.JobView, site configs.SiteConfig) types.JobPostingJSONLD {
+	description := job.Details.HTML
+	if strings.TrimSpace(description) == "" {
+		description = job.Details.Description
+	}
+
+	posting := types.JobPostingJSONLD{
+		Context:     "https://schema.org/",
+		Type:        "JobPosting",
+		Title:       job.Details.Title,
+		Description: description,
+		Identifier: &types.JSONLDPropertyValue{
+			Type:  "PropertyValue",
+			Name:  site.OrganizationName,
+			Value: job.ID.String(),
+		},
+		DatePosted:     job.CreatedAt.Format(time.RFC3339),
+		EmploymentType: mapEmploymentType(job.Details.EmploymentType),
+		HiringOrganization: types.JSONLDOrganization{
+			Type:   "Organization",
+			Name:   site.OrganizationName,
+			SameAs: site.OrganizationURL,
+			Logo:   site.OrganizationLogo,
+		},
+		Image: job.Details.Image,
+	}
+
+	// Son başvuru tarihi
+	if job.Deadline != nil {
+		posting.ValidThrough = job.Deadline.Format(time.RFC3339)
+	}
+
+	// Fiziksel konum
+	if location := strings.TrimSpace(job.Details.Location); location != "" {
+		posting.JobLocation = &types.JSONLDPlace{
+			Type: "Place",
+			Address: types.JSONLDPostalAddress{
+				Type:            "PostalAddress",
+				AddressLocality: location,
+				AddressCountry:  site.Country,
+			},
+		}
+	}
+
+	// Uzaktan çalışma - Google bu durumda başvuru yapılabilecek ülkeyi de ister
+	if telecommuteWorkModes[strings.ToLower(strings.TrimSpace(job.Details.WorkMode))] {
+		posting.JobLocationType = "TELECOMMUTE"
+		posting.ApplicantLocationRequirements = &types.JSONLDCountry{
+			Type: "Country",
+			Name: site.Country,
+		}
+	}
+
+	// Deneyim seviyesi
+	if level := strings.TrimSpace(job.Details.ExperienceLevel); level != "" {
+		posting.ExperienceRequirements = &types.JSONLDExperienceSummary{
+			Type:        "OccupationalExperienceRequirements",
+			Description: level,
+		}
+	}
+
+	// Kategoriler
+	for _, category := range job.Categories {
+		posting.Industry = append(posting.Industry, category.DisplayName)
+	}
+
+	return posting
+}
+
+// mapEmploymentType - Serbest metin çalışma tipini schema.org employmentType değerine çevirir
+func mapEmploymentType(employmentType string) string {
+	normalized := strings.ToLower(strings.TrimSpace(employmentType))
+	if normalized == "" {
+		return ""
+	}
+
+	if mapped, ok := employmentTypeMap[normalized]; ok {
+		return mapped
+	}
+
+	return "OTHER"
+}
+
+// validateJobPostingJSONLD - Google for Jobs'ın zorunlu tuttuğu alanları kontrol eder,
+// eksik olan alanların adlarını döner
+func validateJobPostingJSONLD(posting types.JobPostingJSONLD) []string {
+	var missing []string
+
+	if strings.TrimSpace(posting.Title) == "" {
+		missing = append(missing, "title")
+	}
+
+	if strings.TrimSpace(posting.Description) == "" {
+		missing = append(missing, "description")
+	}
+
+	if posting.DatePosted == "" {
+		missing = append(missing, "datePosted")
+	}
+
+	if strings.TrimSpace(posting.HiringOrganization.Name) == "" {
+		missing = append(missing, "hiringOrganization.name")
+	}
+
+	// Konum: ya fiziksel adres ya da uzaktan çalışma + ülke şartı olmalı
+	hasLocation := posting.JobLocation != nil && posting.JobLocation.Address.AddressCountry != ""
+	isTelecommute := posting.JobLocationType == "TELECOMMUTE" && posting.ApplicantLocationRequirements != nil
+	if !hasLocation && !isTelecommute {
+		missing = append(missing, "jobLocation")
+	}
+
+	return missing
+}
@@ -0,0 +1,208 @@
+package JobHandler
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/configs"
+	"github.com/okanay/backend-holding/types"
+)
+
+var testSite = configs.SiteConfig{
+	OrganizationName: "Holding",
+	OrganizationURL:  "https://example.com",
+	Country:          "TR",
+}
+
+// testJob - Tüm zorunlu alanları dolu, yerinde çalışılan bir ilan
+func testJob() types.JobView {
+	deadline := time.Date(2026, 12, 31, 23, 59, 0, 0, time.UTC)
+	return types.JobView{
+		ID:        uuid.New(),
+		Slug:      "backend-developer",
+		CreatedAt: time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC),
+		Deadline:  &deadline,
+		Details: types.JobDetailsView{
+			Title:          "Backend Developer",
+			Description:    "Go ile servis geliştirme",
+			HTML:           "<p>Go ile servis geliştirme</p>",
+			Location:       "İstanbul",
+			WorkMode:       "On-site",
+			EmploymentType: "Tam zamanlı",
+		},
+	}
+}
+
+func TestValidateJobPostingJSONLD(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(p *types.JobPostingJSONLD)
+		missing []string
+	}{
+		{
+			name:    "tüm zorunlu alanlar dolu",
+			mutate:  func(p *types.JobPostingJSONLD) {},
+			missing: nil,
+		},
+		{
+			name:    "başlık eksik",
+			mutate:  func(p *types.JobPostingJSONLD) { p.Title = "  " },
+			missing: []string{"title"},
+		},
+		{
+			name:    "açıklama eksik",
+			mutate:  func(p *types.JobPostingJSONLD) { p.Description = "" },
+			missing: []string{"description"},
+		},
+		{
+			name:    "yayın tarihi eksik",
+			mutate:  func(p *types.JobPostingJSONLD) { p.DatePosted = "" },
+			missing: []string{"datePosted"},
+		},
+		{
+			name:    "kurum adı eksik",
+			mutate:  func(p *types.JobPostingJSONLD) { p.HiringOrganization.Name = "" },
+			missing: []string{"hiringOrganization.name"},
+		},
+		{
+			name:    "konum eksik",
+			mutate:  func(p *types.JobPostingJSONLD) { p.JobLocation = nil },
+			missing: []string{"jobLocation"},
+		},
+		{
+			name: "uzaktan çalışmada ülke şartı varsa fiziksel konum gerekmez",
+			mutate: func(p *types.JobPostingJSONLD) {
+				p.JobLocation = nil
+				p.JobLocationType = "TELECOMMUTE"
+				p.ApplicantLocationRequirements = &types.JSONLDCountry{Type: "Country", Name: "TR"}
+			},
+			missing: nil,
+		},
+		{
+			name: "birden fazla alan eksik",
+			mutate: func(p *types.JobPostingJSONLD) {
+				p.Title = ""
+				p.JobLocation = nil
+			},
+			missing: []string{"title", "jobLocation"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			posting := mapJobToJSONLD(testJob(), testSite)
+			tt.mutate(&posting)
+
+			missing := validateJobPostingJSONLD(posting)
+			if !slices.Equal(missing, tt.missing) {
+				t.Errorf("eksik alanlar = %v, beklenen %v", missing, tt.missing)
+			}
+		})
+	}
+}
+
+func TestMapJobToJSONLD(t *testing.T) {
+	tests := []struct {
+		name             string
+		mutate           func(j *types.JobView)
+		validThrough     string
+		employmentType   string
+		jobLocationType  string
+		hasPhysicalPlace bool
+	}{
+		{
+			name:             "son başvuru tarihi validThrough olur",
+			mutate:           func(j *types.JobView) {},
+			validThrough:     "2026-12-31T23:59:00Z",
+			employmentType:   "FULL_TIME",
+			hasPhysicalPlace: true,
+		},
+		{
+			name:             "son başvuru tarihi yoksa validThrough boş kalır",
+			mutate:           func(j *types.JobView) { j.Deadline = nil },
+			employmentType:   "FULL_TIME",
+			hasPhysicalPlace: true,
+		},
+		{
+			name:             "yarı zamanlı",
+			mutate:           func(j *types.JobView) { j.Details.EmploymentType = "Part-time" },
+			validThrough:     "2026-12-31T23:59:00Z",
+			employmentType:   "PART_TIME",
+			hasPhysicalPlace: true,
+		},
+		{
+			name:             "staj",
+			mutate:           func(j *types.JobView) { j.Details.EmploymentType = "Staj" },
+			validThrough:     "2026-12-31T23:59:00Z",
+			employmentType:   "INTERN",
+			hasPhysicalPlace: true,
+		},
+		{
+			name:             "bilinmeyen çalışma tipi OTHER olur",
+			mutate:           func(j *types.JobView) { j.Details.EmploymentType = "Dönemsel" },
+			validThrough:     "2026-12-31T23:59:00Z",
+			employmentType:   "OTHER",
+			hasPhysicalPlace: true,
+		},
+		{
+			name:             "çalışma tipi yoksa boş kalır",
+			mutate:           func(j *types.JobView) { j.Details.EmploymentType = "" },
+			validThrough:     "2026-12-31T23:59:00Z",
+			hasPhysicalPlace: true,
+		},
+		{
+			name: "uzaktan çalışma TELECOMMUTE olur",
+			mutate: func(j *types.JobView) {
+				j.Details.WorkMode = "Remote"
+				j.Details.Location = ""
+			},
+			validThrough:    "2026-12-31T23:59:00Z",
+			employmentType:  "FULL_TIME",
+			jobLocationType: "TELECOMMUTE",
+		},
+		{
+			name:             "uzaktan (Türkçe) TELECOMMUTE olur",
+			mutate:           func(j *types.JobView) { j.Details.WorkMode = "Uzaktan" },
+			validThrough:     "2026-12-31T23:59:00Z",
+			employmentType:   "FULL_TIME",
+			jobLocationType:  "TELECOMMUTE",
+			hasPhysicalPlace: true,
+		},
+		{
+			name:             "hibrit ilan fiziksel konumuyla yayınlanır",
+			mutate:           func(j *types.JobView) { j.Details.WorkMode = "Hybrid" },
+			validThrough:     "2026-12-31T23:59:00Z",
+			employmentType:   "FULL_TIME",
+			hasPhysicalPlace: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := testJob()
+			tt.mutate(&job)
+			posting := mapJobToJSONLD(job, testSite)
+
+			if posting.ValidThrough != tt.validThrough {
+				t.Errorf("validThrough = %q, beklenen %q", posting.ValidThrough, tt.validThrough)
+			}
+			if posting.EmploymentType != tt.employmentType {
+				t.Errorf("employmentType = %q, beklenen %q", posting.EmploymentType, tt.employmentType)
+			}
+			if posting.JobLocationType != tt.jobLocationType {
+				t.Errorf("jobLocationType = %q, beklenen %q", posting.JobLocationType, tt.jobLocationType)
+			}
+			if (posting.JobLocation != nil) != tt.hasPhysicalPlace {
+				t.Errorf("jobLocation var = %t, beklenen %t", posting.JobLocation != nil, tt.hasPhysicalPlace)
+			}
+			if tt.jobLocationType == "TELECOMMUTE" && posting.ApplicantLocationRequirements == nil {
+				t.Error("uzaktan çalışmada applicantLocationRequirements boş olmamalı")
+			}
+			if missing := validateJobPostingJSONLD(posting); len(missing) > 0 {
+				t.Errorf("geçerli ilan için eksik alan bildirildi: %v", missing)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package JobHandler
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/services/preview"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// CreatePreviewLink taslak iş ilanı için hesap gerektirmeyen, süreli önizleme linki oluşturur
+func (h *Handler) CreatePreviewLink(c *gin.Context) {
+	jobID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.BadRequest(c, "Geçersiz iş ilanı ID'si")
+		return
+	}
+
+	userID, exists := c.Get("user_id")
+	if !exists {
+		utils.Unauthorized(c, "Bu işlem için giriş yapmanız gerekiyor")
+		return
+	}
+
+	// Gövde opsiyoneldir, gönderilmezse varsayılan süre kullanılır
+	var input types.PreviewLinkInput
+	if c.Request.ContentLength > 0 {
+		if err := utils.ValidateRequest(c, &input); err != nil {
+			return
+		}
+	}
+
+	// İlan var mı kontrol et
+	version, err := h.JobRepository.GetJobVersion(c.Request.Context(), jobID)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Önizleme linki oluşturma")
+		return
+	}
+
+	if version == 0 {
+		utils.NotFound(c, "İş ilanı")
+		return
+	}
+
+	link, err := h.Preview.CreateLink(c.Request.Context(), types.PreviewJob, jobID, userID.(uuid.UUID), input)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Önizleme linki oluşturma")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"message": "Önizleme linki oluşturuldu",
+		"data":    link,
+	})
+}
+
+// ListPreviewLinks iş ilanının önizleme linklerini listeler
+func (h *Handler) ListPreviewLinks(c *gin.Context) {
+	jobID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.BadRequest(c, "Geçersiz iş ilanı ID'si")
+		return
+	}
+
+	links, err := h.Preview.ListLinks(c.Request.Context(), types.PreviewJob, jobID)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Önizleme linklerini getirme")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    links,
+	})
+}
+
+// RevokePreviewLink önizleme linkini iptal eder
+func (h *Handler) RevokePreviewLink(c *gin.Context) {
+	jobID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.BadRequest(c, "Geçersiz iş ilanı ID'si")
+		return
+	}
+
+	linkID, err := uuid.Parse(c.Param("linkId"))
+	if err != nil {
+		utils.BadRequest(c, "Geçersiz önizleme linki ID'si")
+		return
+	}
+
+	if err := h.Preview.RevokeLink(c.Request.Context(), types.PreviewJob, jobID, linkID); err != nil {
+		if strings.Contains(err.Error(), "bulunamadı") {
+			utils.NotFound(c, "Önizleme linki")
+			return
+		}
+		utils.HandleDatabaseError(c, err, "Önizleme linki iptal")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Önizleme linki iptal edildi",
+	})
+}
+
+// GetJobPreview önizleme token'ı ile taslak dahil iş ilanını getirir (public)
+// Yanıt önbelleğe alınmaz ve işe alım ekibi bilgisi içermez.
+func (h *Handler) GetJobPreview(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		utils.BadRequest(c, "Önizleme token'ı zorunludur")
+		return
+	}
+
+	jobID, err := h.Preview.Resolve(c.Request.Context(), types.PreviewJob, token)
+	if err != nil {
+		if errors.Is(err, preview.ErrInvalidLink) {
+			utils.SendError(c, utils.ErrorNotFound, "Önizleme linki geçersiz, iptal edilmiş veya süresi dolmuş.")
+			return
+		}
+		utils.HandleDatabaseError(c, err, "İş ilanı önizleme")
+		return
+	}
+
+	job, err := h.JobRepository.GetJobByID(c.Request.Context(), jobID)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "İş ilanı önizleme")
+		return
+	}
+
+	if job.ID == uuid.Nil {
+		utils.NotFound(c, "İş ilanı")
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.Header("X-Robots-Tag", "noindex, nofollow")
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    job,
+	})
+}
@@ -0,0 +1,53 @@
+package JobHandler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/services/cache"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// ScheduleJob iş ilanı için zamanlanmış yayın ve kapatma zamanlarını ayarlar
+func (h *Handler) ScheduleJob(c *gin.Context) {
+	// İş ID'sini al
+	jobID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.BadRequest(c, "Geçersiz iş ilanı ID'si")
+		return
+	}
+
+	// Kullanıcı ID'sini al
+	userID, exists := c.Get("user_id")
+	if !exists {
+		utils.Unauthorized(c, "Oturum bilgisi bulunamadı")
+		return
+	}
+
+	// İstek verilerini doğrula
+	var input types.ScheduleInput
+	if err := utils.ValidateRequest(c, &input); err != nil {
+		return
+	}
+
+	if message := utils.ValidateSchedule(input); message != "" {
+		utils.SendError(c, utils.ErrorInvalidValue, message)
+		return
+	}
+
+	// Zamanlamayı kaydet
+	err = h.JobRepository.ScheduleJob(c.Request.Context(), jobID, input, userID.(uuid.UUID))
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "İş ilanı zamanlama")
+		return
+	}
+
+	h.Cache.ClearGroup(cache.GroupJobs)
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "İş ilanı zamanlaması kaydedildi",
+		"data":    input,
+	})
+}
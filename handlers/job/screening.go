@@ -0,0 +1,59 @@
+package JobHandler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/services/aiusage"
+	"github.com/okanay/backend-holding/services/cache"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// ScreenApplication başvuruyu yapay zeka ile ilanın gereksinimlerine göre değerlendirir ve sonucu başvuruya kaydeder
+func (h *Handler) ScreenApplication(c *gin.Context) {
+	// Başvuru ID'sini al
+	applicationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.BadRequest(c, "Geçersiz başvuru ID'si")
+		return
+	}
+
+	// Kullanıcı ID'sini al
+	userID, exists := c.Get("user_id")
+	if !exists {
+		utils.Unauthorized(c, "Oturum bilgisi bulunamadı")
+		return
+	}
+
+	// Değerlendirme sonucu başvuruya yazıldığı için mülakatçılar bu işlemi yapamaz
+	application, ok := h.authorizeApplication(c, applicationID, true)
+	if !ok {
+		return
+	}
+
+	result, err := h.Screening.ScreenApplication(c.Request.Context(), application, userID.(uuid.UUID))
+	if err != nil {
+		if errors.Is(err, aiusage.ErrLimitExceeded) {
+			c.JSON(http.StatusTooManyRequests, gin.H{
+				"success": false,
+				"error":   "rate_limit_exceeded",
+				"message": "Yapay zeka kullanım sınırına ulaştınız. Lütfen daha sonra tekrar deneyin.",
+			})
+			return
+		}
+
+		log.Printf("[SCREENING] Başvuru değerlendirilemedi (%s): %v", applicationID, err)
+		utils.SendError(c, utils.ErrorOperationFailed, "Başvuru değerlendirilemedi, lütfen tekrar deneyin")
+		return
+	}
+
+	h.Cache.ClearGroup(cache.GroupJobs)
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Başvuru değerlendirmesi tamamlandı",
+		"data":    result,
+	})
+}
@@ -0,0 +1,52 @@
+package JobHandler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/backend-holding/configs"
+	"github.com/okanay/backend-holding/services/cache"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// SearchJobs - Yayındaki iş ilanlarında alaka sırasına göre tam metin arama (public)
+func (h *Handler) SearchJobs(c *gin.Context) {
+	params, ok := utils.ParseSearchParams(c)
+	if !ok {
+		return
+	}
+
+	cacheIdentifier := fmt.Sprintf("job:search:%+v", params)
+	if h.Cache.TryCache(c, cache.GroupJobs, cacheIdentifier) {
+		return
+	}
+
+	results, total, err := h.JobRepository.SearchJobs(c.Request.Context(), params)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "İş ilanı arama")
+		return
+	}
+
+	site := configs.GetSiteConfig()
+	for i := range results {
+		results[i].URL = site.JobURL(results[i].Slug)
+	}
+
+	response := gin.H{
+		"success": true,
+		"data": gin.H{
+			"results": results,
+			"pagination": gin.H{
+				"currentPage": params.Page,
+				"pageSize":    params.Limit,
+				"totalItems":  total,
+				"totalPages":  (total + params.Limit - 1) / params.Limit,
+			},
+		},
+	}
+
+	h.Cache.SaveCacheTTL(response, cache.GroupJobs, cacheIdentifier, configs.SEARCH_CACHE_DURATION)
+	c.Header("X-Cache", "MISS")
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,265 @@
+package JobHandler
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/configs"
+	"github.com/okanay/backend-holding/services/candidate"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// generateTrackingCode - Sadece rakamlardan oluşan takip kodu üretir
+func generateTrackingCode() string {
+	var b strings.Builder
+	for range configs.JOBS_TRACKING_CODE_LENGTH {
+		b.WriteString(strconv.Itoa(utils.GenerateRandomInt(0, 10)))
+	}
+	return b.String()
+}
+
+// RequestTrackingCode başvurusu olan e-posta adresine tek kullanımlık takip kodu gönderir
+func (h *Handler) RequestTrackingCode(c *gin.Context) {
+	var input types.JobTrackingCodeInput
+	if err := utils.ValidateRequest(c, &input); err != nil {
+		return
+	}
+
+	// Başvuru yapılmış adresleri ifşa etmemek için yanıt her durumda aynıdır
+	response := gin.H{
+		"success": true,
+		"message": "Bu e-posta adresine ait başvuru varsa takip kodu gönderildi",
+	}
+
+	hasApplications, err := h.JobRepository.HasApplications(c.Request.Context(), input.Email)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Takip kodu oluşturma")
+		return
+	}
+
+	if !hasApplications {
+		c.JSON(http.StatusOK, response)
+		return
+	}
+
+	// Kod benzersiz olmalı, çakışma durumunda yeniden üret
+	var code string
+	for range 3 {
+		code = generateTrackingCode()
+		created, err := h.JobRepository.CreateTrackingCode(c.Request.Context(), input.Email, code, time.Now().Add(configs.JOBS_TRACKING_CODE_DURATION))
+		if err != nil {
+			utils.HandleDatabaseError(c, err, "Takip kodu oluşturma")
+			return
+		}
+		if created {
+			break
+		}
+		code = ""
+	}
+
+	if code == "" {
+		utils.SendError(c, utils.ErrorOperationFailed, "Takip kodu oluşturulamadı, lütfen tekrar deneyin")
+		return
+	}
+
+	if err := h.Candidate.SendTrackingCode(c.Request.Context(), input.Email, code); err != nil {
+		log.Printf("[TRACKING] Takip kodu gönderilemedi: %v", err)
+		utils.SendError(c, utils.ErrorOperationFailed, "Takip kodu gönderilemedi, lütfen tekrar deneyin")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// VerifyTrackingCode takip kodunu doğrular ve başvuru takip oturumunu başlatır
+func (h *Handler) VerifyTrackingCode(c *gin.Context) {
+	var input types.JobTrackingVerifyInput
+	if err := utils.ValidateRequest(c, &input); err != nil {
+		return
+	}
+
+	valid, err := h.JobRepository.UseTrackingCode(c.Request.Context(), input.Email, input.TrackingCode)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Takip kodu doğrulama")
+		return
+	}
+
+	if !valid {
+		utils.Unauthorized(c, "Takip kodu geçersiz veya süresi dolmuş")
+		return
+	}
+
+	token, err := utils.GenerateApplicationTrackingToken(types.TokenClaims{Email: input.Email})
+	if err != nil {
+		utils.SendError(c, utils.ErrorOperationFailed, "Takip oturumu oluşturulamadı")
+		return
+	}
+
+	err = h.JobRepository.CreateTrackingSession(
+		c.Request.Context(),
+		input.Email,
+		token,
+		c.ClientIP(),
+		c.Request.UserAgent(),
+		time.Now().Add(configs.JOBS_TRACKING_DURATION),
+	)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Takip oturumu oluşturma")
+		return
+	}
+
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(
+		configs.JOBS_TRACKING_COOKIE,
+		token,
+		int(configs.JOBS_TRACKING_DURATION.Seconds()),
+		"/",
+		"",    // Domain
+		false, // Secure
+		true,  // HttpOnly
+	)
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Takip oturumu başlatıldı",
+	})
+}
+
+// EndTrackingSession takip oturumunu sonlandırır
+func (h *Handler) EndTrackingSession(c *gin.Context) {
+	c.SetCookie(configs.JOBS_TRACKING_COOKIE, "", -1, "/", "", false, true)
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Takip oturumu sonlandırıldı",
+	})
+}
+
+// toCandidateInterview - Mülakatı adayın görebileceği alanlara indirger
+func toCandidateInterview(interview types.JobInterviewView) types.JobInterviewCandidateView {
+	interviewers := make([]string, 0, len(interview.Interviewers))
+	for _, interviewer := range interview.Interviewers {
+		interviewers = append(interviewers, interviewer.Username)
+	}
+
+	return types.JobInterviewCandidateView{
+		ID:            interview.ID,
+		ApplicationID: interview.ApplicationID,
+		JobTitle:      interview.JobTitle,
+		StartsAt:      interview.StartsAt,
+		EndsAt:        interview.EndsAt,
+		Location:      interview.Location,
+		VideoURL:      interview.VideoURL,
+		Notes:         interview.Notes,
+		Status:        interview.Status,
+		CandidateNote: interview.CandidateNote,
+		Interviewers:  interviewers,
+	}
+}
+
+// ListTrackingInterviews adayın tüm mülakatlarını döner
+func (h *Handler) ListTrackingInterviews(c *gin.Context) {
+	email, ok := utils.TrackingEmail(c)
+	if !ok {
+		return
+	}
+
+	interviews, err := h.JobRepository.ListInterviewsByEmail(c.Request.Context(), email)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Mülakatları getirme")
+		return
+	}
+
+	data := make([]types.JobInterviewCandidateView, 0, len(interviews))
+	for _, interview := range interviews {
+		data = append(data, toCandidateInterview(interview))
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    data,
+	})
+}
+
+// ConfirmTrackingInterview adayın mülakatı onaylaması
+func (h *Handler) ConfirmTrackingInterview(c *gin.Context) {
+	h.respondToInterview(c, types.JobInterviewStatusConfirmed, "")
+}
+
+// RequestTrackingInterviewReschedule adayın mülakat için yeni zaman istemesi
+func (h *Handler) RequestTrackingInterviewReschedule(c *gin.Context) {
+	var input types.JobInterviewRescheduleRequestInput
+	if err := utils.ValidateRequest(c, &input); err != nil {
+		return
+	}
+
+	h.respondToInterview(c, types.JobInterviewStatusRescheduleRequested, input.Note)
+}
+
+// respondToInterview - Adayın mülakat yanıtını kaydeder ve mülakatçılara bildirir
+func (h *Handler) respondToInterview(c *gin.Context, status types.JobInterviewStatus, note string) {
+	interviewID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.BadRequest(c, "Geçersiz mülakat ID'si")
+		return
+	}
+
+	email, ok := utils.TrackingEmail(c)
+	if !ok {
+		return
+	}
+
+	interview, err := h.JobRepository.RespondToInterview(c.Request.Context(), interviewID, email, status, note)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Mülakat yanıtı")
+		return
+	}
+
+	h.Candidate.NotifyCandidateResponse(interview)
+
+	message := "Mülakat onaylandı"
+	if status == types.JobInterviewStatusRescheduleRequested {
+		message = "Yeni zaman talebiniz iletildi"
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": message,
+		"data":    toCandidateInterview(interview),
+	})
+}
+
+// DownloadTrackingInterviewCalendar mülakatın .ics dosyasını indirir
+func (h *Handler) DownloadTrackingInterviewCalendar(c *gin.Context) {
+	interviewID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.BadRequest(c, "Geçersiz mülakat ID'si")
+		return
+	}
+
+	email, ok := utils.TrackingEmail(c)
+	if !ok {
+		return
+	}
+
+	interview, err := h.JobRepository.GetInterviewByID(c.Request.Context(), interviewID)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Mülakat getirme")
+		return
+	}
+
+	// Başka bir adaya ait mülakatın varlığı ifşa edilmez
+	if interview.ID == uuid.Nil || interview.CandidateEmail != email {
+		utils.NotFound(c, "Mülakat")
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="mulakat.ics"`)
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", candidate.InterviewCalendar(interview))
+}
@@ -1,6 +1,7 @@
 package JobHandler
 
 import (
+	"log"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -10,6 +11,19 @@ import (
 	"github.com/okanay/backend-holding/utils"
 )
 
+// notifyApplicant - Başvuru sahibine olayın şablonlu e-postasını kuyruğa yazar, hatalar sadece loglanır
+func (h *Handler) notifyApplicant(c *gin.Context, applicationID uuid.UUID, event types.EmailTemplateEvent, status string) {
+	recipient, err := h.JobRepository.GetApplicationRecipient(c.Request.Context(), applicationID)
+	if err != nil || recipient.ID == uuid.Nil {
+		log.Printf("[JOB] Başvuru bildirimi için aday bilgisi alınamadı (%s): %v", applicationID, err)
+		return
+	}
+
+	if err := h.Candidate.NotifyApplication(c.Request.Context(), event, recipient, status); err != nil {
+		log.Printf("[JOB] Başvuru bildirimi gönderilemedi (%s): %v", applicationID, err)
+	}
+}
+
 func (h *Handler) UpdateJobApplicationStatus(c *gin.Context) {
 	// Başvuru ID'sini al
 	applicationID, err := uuid.Parse(c.Param("id"))
@@ -18,19 +32,37 @@ func (h *Handler) UpdateJobApplicationStatus(c *gin.Context) {
 		return
 	}
 
+	// Kullanıcı ID'sini al
+	userID, exists := c.Get("user_id")
+	if !exists {
+		utils.Unauthorized(c, "Oturum bilgisi bulunamadı")
+		return
+	}
+
 	// İstek verilerini doğrula
 	var input types.JobApplicationStatusInput
 	if err := utils.ValidateRequest(c, &input); err != nil {
 		return
 	}
 
+	// Yönetici değilse yalnızca ilanın sahibi veya işe alım uzmanı durumu değiştirebilir
+	application, ok := h.authorizeApplication(c, applicationID, true)
+	if !ok {
+		return
+	}
+
 	// Başvuru durumunu güncelle
-	err = h.JobRepository.UpdateJobApplicationStatus(c.Request.Context(), applicationID, input.Status)
+	err = h.JobRepository.UpdateJobApplicationStatus(c.Request.Context(), applicationID, input.Status, userID.(uuid.UUID))
 	if err != nil {
 		utils.HandleDatabaseError(c, err, "Başvuru durumu güncelleme")
 		return
 	}
 
+	// Durum gerçekten değiştiyse adaya şablonlu bildirim gönder
+	if application.Status != input.Status {
+		h.notifyApplicant(c, applicationID, types.EmailEventStatusChanged, input.Status)
+	}
+
 	h.Cache.ClearGroup(cache.GroupJobs)
 
 	c.JSON(http.StatusOK, gin.H{
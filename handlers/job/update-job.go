@@ -1,10 +1,14 @@
 package JobHandler
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/configs"
 	"github.com/okanay/backend-holding/services/cache"
 	"github.com/okanay/backend-holding/types"
 	"github.com/okanay/backend-holding/utils"
@@ -25,24 +29,54 @@ func (h *Handler) UpdateJob(c *gin.Context) {
 		return
 	}
 
+	// Eşzamanlı düzenleme kontrolü - istemcinin bildiği sürüm
+	expectedVersion, ok := utils.RequireIfMatch(c)
+	if !ok {
+		return
+	}
+
 	// İstek verilerini doğrula
 	var input types.JobInput
 	if err := utils.ValidateRequest(c, &input); err != nil {
 		return
 	}
 
+	// İlan metni izin listesine göre temizlenir, kaldırılanlar yanıtta raporlanır
+	var removed []types.HTMLRemoval
+	input.HTML, removed = utils.SanitizeHTML(input.HTML)
+
+	// İlan dili gönderildiyse sitenin dillerinden biri olmalı, boşsa mevcut dil korunur
+	if !normalizeJobLanguage(c, &input) {
+		return
+	}
+
+	// İşe alım ekibi gönderildiyse geçerliliğini kontrol et
+	if input.HiringTeam != nil {
+		if msg := validateHiringTeam(input.HiringTeam); msg != "" {
+			utils.SendError(c, utils.ErrorInvalidValue, msg)
+			return
+		}
+	}
+
 	// İş ilanını güncelle
-	job, err := h.JobRepository.UpdateJob(c.Request.Context(), jobID, input, userID.(uuid.UUID))
+	job, err := h.JobRepository.UpdateJob(c.Request.Context(), jobID, input, userID.(uuid.UUID), expectedVersion)
 	if err != nil {
+		var conflict *types.VersionConflictError
+		if errors.As(err, &conflict) {
+			utils.PreconditionFailed(c, conflict.CurrentVersion)
+			return
+		}
 		utils.HandleDatabaseError(c, err, "İş ilanı güncelleme")
 		return
 	}
 
 	h.Cache.ClearGroup(cache.GroupJobs)
+	utils.SetETag(c, job.Version)
 	c.JSON(http.StatusOK, gin.H{
-		"success": true,
-		"message": "İş ilanı başarıyla güncellendi",
-		"data":    job,
+		"success":   true,
+		"message":   "İş ilanı başarıyla güncellendi",
+		"data":      job,
+		"sanitized": removed,
 	})
 }
 
@@ -67,9 +101,29 @@ func (h *Handler) UpdateJobStatus(c *gin.Context) {
 		return
 	}
 
+	// Yayınlanan ilanı anlık bildirim isteyen abonelere gönder
+	if input.Status == types.JobStatusPublished {
+		h.JobAlert.NotifyJobPublished()
+	}
+
 	h.Cache.ClearGroup(cache.GroupJobs)
 	c.JSON(http.StatusOK, gin.H{
 		"success": true,
 		"message": "İş ilanı durumu başarıyla güncellendi",
 	})
 }
+
+// normalizeJobLanguage - İlan dilini küçük harfe çevirir ve sitenin dilleri arasında olduğunu doğrular
+func normalizeJobLanguage(c *gin.Context, input *types.JobInput) bool {
+	input.Language = strings.ToLower(strings.TrimSpace(input.Language))
+	if input.Language == "" {
+		return true
+	}
+
+	site := configs.GetSiteConfig()
+	if !site.HasLanguage(input.Language) {
+		utils.SendError(c, utils.ErrorInvalidValue, fmt.Sprintf("'%s' sitenin dillerinden biri değil (%s)", input.Language, strings.Join(site.Languages, ", ")))
+		return false
+	}
+	return true
+}
@@ -0,0 +1,19 @@
+package JobAlertHandler
+
+import (
+	JobAlertRepository "github.com/okanay/backend-holding/repositories/jobalert"
+	"github.com/okanay/backend-holding/services/jobalert"
+)
+
+// handler/jobalert.go
+type Handler struct {
+	JobAlertRepository *JobAlertRepository.Repository
+	JobAlertService    *jobalert.Service
+}
+
+func NewHandler(r *JobAlertRepository.Repository, s *jobalert.Service) *Handler {
+	return &Handler{
+		JobAlertRepository: r,
+		JobAlertService:    s,
+	}
+}
@@ -0,0 +1,108 @@
+package JobAlertHandler
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/configs"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// GetSubscription imzalı yönetim linki ile abonelik bilgilerini döner
+func (h *Handler) GetSubscription(c *gin.Context) {
+	subscriptionID, ok := h.subscriptionFromToken(c)
+	if !ok {
+		return
+	}
+
+	subscription, err := h.JobAlertRepository.GetSubscriptionByID(c.Request.Context(), subscriptionID)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "İş alarmı aboneliği getirme")
+		return
+	}
+
+	if subscription.ID == uuid.Nil {
+		utils.NotFound(c, "Abonelik")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    subscription,
+	})
+}
+
+// UpdateSubscription imzalı yönetim linki ile abonelik filtrelerini günceller
+func (h *Handler) UpdateSubscription(c *gin.Context) {
+	subscriptionID, ok := h.subscriptionFromToken(c)
+	if !ok {
+		return
+	}
+
+	// İstek verilerini doğrula
+	var input types.JobAlertUpdateInput
+	if err := utils.ValidateRequest(c, &input); err != nil {
+		return
+	}
+
+	subscription, err := h.JobAlertRepository.UpdateSubscriptionFilters(c.Request.Context(), subscriptionID, input)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "İş alarmı aboneliği güncelleme")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Bildirim tercihleriniz güncellendi",
+		"data":    subscription,
+	})
+}
+
+// Unsubscribe abonelikten çıkma linkindeki opak token ile aboneliği sonlandırır (e-posta istemcilerinin tek tık isteğini de karşılar)
+// Daha önce gönderilmiş e-postalardaki imzalı yönetim linkleri de kabul edilir.
+func (h *Handler) Unsubscribe(c *gin.Context) {
+	token, ok := utils.ValidateQuery(c, "token")
+	if !ok {
+		return
+	}
+
+	var err error
+	if subscriptionID, verifyErr := utils.VerifyJobAlertToken(token, configs.JOB_ALERT_MANAGE_SUBJECT); verifyErr == nil {
+		err = h.JobAlertRepository.Unsubscribe(c.Request.Context(), subscriptionID)
+	} else {
+		err = h.JobAlertRepository.UnsubscribeByToken(c.Request.Context(), token)
+	}
+
+	if err != nil {
+		if strings.Contains(err.Error(), "bulunamadı") {
+			utils.Unauthorized(c, "Bağlantı geçersiz")
+			return
+		}
+		utils.HandleDatabaseError(c, err, "İş alarmı aboneliğinden çıkma")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Abonelikten çıktınız, artık bildirim almayacaksınız",
+	})
+}
+
+// subscriptionFromToken - Sorgudaki yönetim token'ını doğrular ve abonelik ID'sini döner
+func (h *Handler) subscriptionFromToken(c *gin.Context) (uuid.UUID, bool) {
+	token, ok := utils.ValidateQuery(c, "token")
+	if !ok {
+		return uuid.Nil, false
+	}
+
+	subscriptionID, err := utils.VerifyJobAlertToken(token, configs.JOB_ALERT_MANAGE_SUBJECT)
+	if err != nil {
+		utils.Unauthorized(c, "Bağlantı geçersiz veya süresi dolmuş")
+		return uuid.Nil, false
+	}
+
+	return subscriptionID, true
+}
@@ -0,0 +1,82 @@
+package JobAlertHandler
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/backend-holding/configs"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// Subscribe yeni bir iş alarmı aboneliği oluşturur ve onay e-postası gönderir
+func (h *Handler) Subscribe(c *gin.Context) {
+	// İstek verilerini doğrula
+	var input types.JobAlertSubscribeInput
+	if err := utils.ValidateRequest(c, &input); err != nil {
+		return
+	}
+
+	// Onay bekleyen aboneliği oluştur veya aynı e-postanın bekleyen aboneliğini güncelle
+	subscription, sendConfirmation, err := h.JobAlertRepository.CreateSubscription(c.Request.Context(), input, utils.GetTrueClientIP(c))
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "İş alarmı aboneliği oluşturma")
+		return
+	}
+
+	// Onay e-postasını gönder, bekleme süresi dolmadıysa aynı adrese tekrar gönderilmez
+	if sendConfirmation {
+		if err := h.JobAlertService.SendConfirmation(c.Request.Context(), subscription); err != nil {
+			if resetErr := h.JobAlertRepository.ResetConfirmationSent(c.Request.Context(), subscription.ID); resetErr != nil {
+				log.Printf("[JOB_ALERT] Onay gönderim zamanı sıfırlanamadı (%s): %v", subscription.ID, resetErr)
+			}
+			utils.InternalError(c, "Onay e-postası gönderilemedi")
+			return
+		}
+	}
+
+	// Yanıt, adresin daha önce kayıtlı olup olmadığını belli etmez
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"message": "Aboneliğinizi tamamlamak için e-posta adresinize gönderilen bağlantıya tıklayın",
+	})
+}
+
+// ConfirmSubscription imzalı onay linki ile aboneliği aktifleştirir
+func (h *Handler) ConfirmSubscription(c *gin.Context) {
+	// Token'ı doğrula
+	token, ok := utils.ValidateQuery(c, "token")
+	if !ok {
+		return
+	}
+
+	subscriptionID, err := utils.VerifyJobAlertToken(token, configs.JOB_ALERT_CONFIRM_SUBJECT)
+	if err != nil {
+		utils.Unauthorized(c, "Onay bağlantısı geçersiz veya süresi dolmuş")
+		return
+	}
+
+	// Aboneliği aktifleştir
+	subscription, err := h.JobAlertRepository.ConfirmSubscription(c.Request.Context(), subscriptionID)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "İş alarmı aboneliği onaylama")
+		return
+	}
+
+	// Hesap gerektirmeden yönetim için kalıcı token üret
+	manageToken, err := utils.GenerateJobAlertToken(subscription.ID, configs.JOB_ALERT_MANAGE_SUBJECT, configs.JOB_ALERT_MANAGE_DURATION)
+	if err != nil {
+		utils.InternalError(c, "Yönetim bağlantısı oluşturulamadı")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Aboneliğiniz onaylandı",
+		"data": gin.H{
+			"subscription": subscription,
+			"manageToken":  manageToken,
+		},
+	})
+}
@@ -0,0 +1,64 @@
+package PrivacyHandler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/backend-holding/services/privacy"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// ListRequests kişisel veri taleplerinin ve saklama süresi işlemlerinin kaydını listeler
+// ?email= verilirse adresin özeti ile eşleşen talepler döner (silinen adaylar için de çalışır).
+func (h *Handler) ListRequests(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	params := types.DataPrivacyRequestSearchParams{
+		RequestType: types.DataPrivacyRequestType(c.Query("type")),
+		Page:        page,
+		Limit:       limit,
+	}
+
+	if email := c.Query("email"); email != "" {
+		params.EmailHash = privacy.HashEmail(email)
+	}
+
+	requests, total, err := h.PrivacyRepository.ListRequests(c.Request.Context(), params)
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Kişisel veri talepleri listeleme")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data": gin.H{
+			"requests": requests,
+			"pagination": gin.H{
+				"currentPage": page,
+				"pageSize":    limit,
+				"totalItems":  total,
+				"totalPages":  (total + limit - 1) / limit,
+			},
+		},
+	})
+}
+
+// RunRetention saklama süresi işlemini zamanlayıcıyı beklemeden arka planda başlatır
+func (h *Handler) RunRetention(c *gin.Context) {
+	go h.PrivacyService.RunRetention(context.Background())
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"success": true,
+		"message": "Saklama süresi işlemi başlatıldı",
+	})
+}
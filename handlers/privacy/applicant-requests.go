@@ -0,0 +1,60 @@
+package PrivacyHandler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/backend-holding/configs"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// ExportMyData adayın tüm verilerini JSON dosyası olarak indirir
+func (h *Handler) ExportMyData(c *gin.Context) {
+	email, ok := utils.TrackingEmail(c)
+	if !ok {
+		return
+	}
+
+	export, err := h.PrivacyService.ExportApplicantData(c.Request.Context(), email, c.ClientIP())
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Veri dışa aktarımı")
+		return
+	}
+
+	filename := "verilerim-" + time.Now().Format("2006-01-02") + ".json"
+	c.Header("Content-Disposition", `attachment; filename="`+filename+`"`)
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    export,
+	})
+}
+
+// DeleteMyData adayın başvurularını anonimleştirir, eklerini ve diğer kişisel verilerini siler
+func (h *Handler) DeleteMyData(c *gin.Context) {
+	email, ok := utils.TrackingEmail(c)
+	if !ok {
+		return
+	}
+
+	var input types.ApplicantDeleteInput
+	if err := utils.ValidateRequest(c, &input); err != nil {
+		return
+	}
+
+	result, err := h.PrivacyService.DeleteApplicantData(c.Request.Context(), email, c.ClientIP())
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Veri silme")
+		return
+	}
+
+	// Takip oturumu silindiği için çerezi de temizle
+	c.SetCookie(configs.JOBS_TRACKING_COOKIE, "", -1, "/", "", false, true)
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Kişisel verileriniz silindi",
+		"data":    result,
+	})
+}
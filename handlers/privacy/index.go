@@ -0,0 +1,18 @@
+package PrivacyHandler
+
+import (
+	PrivacyRepository "github.com/okanay/backend-holding/repositories/privacy"
+	"github.com/okanay/backend-holding/services/privacy"
+)
+
+type Handler struct {
+	PrivacyRepository *PrivacyRepository.Repository
+	PrivacyService    *privacy.Service
+}
+
+func NewHandler(p *PrivacyRepository.Repository, ps *privacy.Service) *Handler {
+	return &Handler{
+		PrivacyRepository: p,
+		PrivacyService:    ps,
+	}
+}
@@ -0,0 +1,217 @@
+package SitemapHandler
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/backend-holding/configs"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+const (
+	sitemapContentType = "application/xml; charset=utf-8"
+	sitemapNamespace   = "http://www.sitemaps.org/schemas/sitemap/0.9"
+	xhtmlNamespace     = "http://www.w3.org/1999/xhtml"
+)
+
+// sitemapSections - Alt sitemap'lerin adları ve sıraları (/public/sitemaps/{section}-{page}.xml)
+var sitemapSections = []string{"contents", "jobs"}
+
+// GetSitemap yayındaki içerik ve ilanları sitemap olarak döner
+// Toplam adres sayısı SITEMAP_MAX_URLS'i aşarsa alt sitemap'leri listeleyen bir sitemap index döner.
+func (h *Handler) GetSitemap(c *gin.Context) {
+	cacheIdentifier := "sitemap.xml"
+	if h.Cache.TryCacheRaw(c, Group, cacheIdentifier, sitemapContentType) {
+		return
+	}
+
+	sections, err := h.buildSections(c.Request.Context())
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Sitemap oluşturma")
+		return
+	}
+
+	total := 0
+	for _, urls := range sections {
+		total += len(urls)
+	}
+
+	var document any
+	if total <= configs.SITEMAP_MAX_URLS {
+		urlSet := newURLSet()
+		for _, section := range sitemapSections {
+			urlSet.URLs = append(urlSet.URLs, sections[section]...)
+		}
+		document = urlSet
+	} else {
+		document = buildIndex(sections, configs.GetSiteConfig())
+	}
+
+	h.respond(c, cacheIdentifier, document)
+}
+
+// GetSitemapPage sitemap index'te listelenen alt sitemap'i döner (örn. contents-2.xml)
+func (h *Handler) GetSitemapPage(c *gin.Context) {
+	file := strings.TrimSuffix(c.Param("file"), ".xml")
+	section, pageStr, _ := strings.Cut(file, "-")
+	page, err := strconv.Atoi(pageStr)
+	if err != nil || page < 1 {
+		utils.NotFound(c, "Sitemap")
+		return
+	}
+
+	cacheIdentifier := fmt.Sprintf("%s-%d.xml", section, page)
+	if h.Cache.TryCacheRaw(c, Group, cacheIdentifier, sitemapContentType) {
+		return
+	}
+
+	sections, err := h.buildSections(c.Request.Context())
+	if err != nil {
+		utils.HandleDatabaseError(c, err, "Sitemap oluşturma")
+		return
+	}
+
+	urls, exists := sections[section]
+	start := (page - 1) * configs.SITEMAP_MAX_URLS
+	if !exists || start >= len(urls) {
+		utils.NotFound(c, "Sitemap")
+		return
+	}
+
+	urlSet := newURLSet()
+	urlSet.URLs = urls[start:min(start+configs.SITEMAP_MAX_URLS, len(urls))]
+
+	h.respond(c, cacheIdentifier, urlSet)
+}
+
+// respond - XML'i oluşturur, önbelleğe alır ve döner
+func (h *Handler) respond(c *gin.Context, cacheIdentifier string, document any) {
+	body, err := xml.MarshalIndent(document, "", "  ")
+	if err != nil {
+		utils.InternalError(c, "Sitemap oluşturulamadı")
+		return
+	}
+	body = append([]byte(xml.Header), body...)
+
+	h.Cache.SaveCacheRawTTL(body, Group, cacheIdentifier, configs.SITEMAP_CACHE_DURATION)
+	c.Header("X-Cache", "MISS")
+	c.Data(http.StatusOK, sitemapContentType, body)
+}
+
+// buildSections - İçerik ve ilan adreslerini bölümlerine göre hazırlar
+func (h *Handler) buildSections(ctx context.Context) (map[string][]types.SitemapURLXML, error) {
+	site := configs.GetSiteConfig()
+
+	contents, err := h.ContentRepository.ListSitemapEntries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs, err := h.JobRepository.ListSitemapEntries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	jobURLs := make([]types.SitemapURLXML, 0, len(jobs))
+	for _, job := range jobs {
+		jobURLs = append(jobURLs, types.SitemapURLXML{
+			Loc:     site.JobURL(job.Slug),
+			LastMod: lastMod(job.UpdatedAt),
+		})
+	}
+
+	return map[string][]types.SitemapURLXML{
+		"contents": mapContentURLs(contents, site),
+		"jobs":     jobURLs,
+	}, nil
+}
+
+// mapContentURLs - İçerikleri identifier gruplarına göre hreflang alternatifleriyle adreslere dönüştürür
+// Girdi identifier'a göre sıralı olmalıdır. Tek dilli içeriklerde alternatif verilmez.
+func mapContentURLs(entries []types.SitemapEntry, site configs.SiteConfig) []types.SitemapURLXML {
+	urls := make([]types.SitemapURLXML, 0, len(entries))
+
+	for start := 0; start < len(entries); {
+		end := start + 1
+		for end < len(entries) && entries[end].Identifier == entries[start].Identifier {
+			end++
+		}
+		group := entries[start:end]
+
+		var alternates []types.SitemapAlternateXML
+		if len(group) > 1 {
+			for _, entry := range group {
+				alternates = append(alternates, types.SitemapAlternateXML{
+					Rel:      "alternate",
+					Hreflang: entry.Language,
+					Href:     site.ContentURL(entry.Language, entry.Slug),
+				})
+				if entry.Language == site.DefaultLanguage() {
+					alternates = append(alternates, types.SitemapAlternateXML{
+						Rel:      "alternate",
+						Hreflang: "x-default",
+						Href:     site.ContentURL(entry.Language, entry.Slug),
+					})
+				}
+			}
+		}
+
+		for _, entry := range group {
+			urls = append(urls, types.SitemapURLXML{
+				Loc:        site.ContentURL(entry.Language, entry.Slug),
+				LastMod:    lastMod(entry.UpdatedAt),
+				Alternates: alternates,
+			})
+		}
+
+		start = end
+	}
+
+	return urls
+}
+
+// buildIndex - Her bölümü SITEMAP_MAX_URLS'lik sayfalara bölen sitemap index'i oluşturur
+func buildIndex(sections map[string][]types.SitemapURLXML, site configs.SiteConfig) types.SitemapIndexXML {
+	index := types.SitemapIndexXML{Xmlns: sitemapNamespace}
+
+	for _, section := range sitemapSections {
+		urls := sections[section]
+		for page, start := 1, 0; start < len(urls); page, start = page+1, start+configs.SITEMAP_MAX_URLS {
+			chunk := urls[start:min(start+configs.SITEMAP_MAX_URLS, len(urls))]
+
+			// Sayfanın lastmod değeri içindeki en yeni adrestir (aynı biçimde UTC olduğu için metin karşılaştırması yeterli)
+			latest := ""
+			for _, url := range chunk {
+				latest = max(latest, url.LastMod)
+			}
+
+			index.Sitemaps = append(index.Sitemaps, types.SitemapRefXML{
+				Loc:     fmt.Sprintf("%s/public/sitemaps/%s-%d.xml", site.APIBaseURL, section, page),
+				LastMod: latest,
+			})
+		}
+	}
+
+	return index
+}
+
+// newURLSet - Namespace'leri tanımlı boş urlset oluşturur
+func newURLSet() types.SitemapURLSetXML {
+	return types.SitemapURLSetXML{
+		Xmlns:      sitemapNamespace,
+		XmlnsXhtml: xhtmlNamespace,
+		URLs:       []types.SitemapURLXML{},
+	}
+}
+
+// lastMod - Tarihi sitemap'in beklediği W3C biçimine çevirir
+func lastMod(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
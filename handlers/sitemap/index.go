@@ -0,0 +1,23 @@
+package SitemapHandler
+
+import (
+	ContentRepository "github.com/okanay/backend-holding/repositories/content"
+	JobRepository "github.com/okanay/backend-holding/repositories/job"
+	"github.com/okanay/backend-holding/services/cache"
+)
+
+const Group = cache.GroupSitemap
+
+type Handler struct {
+	ContentRepository *ContentRepository.Repository
+	JobRepository     *JobRepository.Repository
+	Cache             cache.CacheService
+}
+
+func NewHandler(cr *ContentRepository.Repository, jr *JobRepository.Repository, cacheService cache.CacheService) *Handler {
+	return &Handler{
+		ContentRepository: cr,
+		JobRepository:     jr,
+		Cache:             cacheService,
+	}
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"log"
 	"os"
@@ -11,44 +12,97 @@ import (
 
 	c "github.com/okanay/backend-holding/configs"
 	db "github.com/okanay/backend-holding/database"
+	aih "github.com/okanay/backend-holding/handlers/ai"
+	ah "github.com/okanay/backend-holding/handlers/analytics"
 	ch "github.com/okanay/backend-holding/handlers/content"
+	elh "github.com/okanay/backend-holding/handlers/editlock"
+	eth "github.com/okanay/backend-holding/handlers/emailtemplate"
 	fh "github.com/okanay/backend-holding/handlers/file"
 	mh "github.com/okanay/backend-holding/handlers/globals"
 	jh "github.com/okanay/backend-holding/handlers/job"
+	jah "github.com/okanay/backend-holding/handlers/jobalert"
+	ph "github.com/okanay/backend-holding/handlers/privacy"
+	smh "github.com/okanay/backend-holding/handlers/sitemap"
 	uh "github.com/okanay/backend-holding/handlers/user"
 
 	"github.com/okanay/backend-holding/middlewares"
 	mw "github.com/okanay/backend-holding/middlewares"
 	air "github.com/okanay/backend-holding/repositories/ai"
+	aur "github.com/okanay/backend-holding/repositories/aiusage"
+	anr "github.com/okanay/backend-holding/repositories/analytics"
 	cr "github.com/okanay/backend-holding/repositories/content"
+	elr "github.com/okanay/backend-holding/repositories/editlock"
+	etr "github.com/okanay/backend-holding/repositories/emailtemplate"
 	fr "github.com/okanay/backend-holding/repositories/file"
 	jr "github.com/okanay/backend-holding/repositories/job"
+	jar "github.com/okanay/backend-holding/repositories/jobalert"
+	or "github.com/okanay/backend-holding/repositories/outbox"
+	pvr "github.com/okanay/backend-holding/repositories/preview"
+	pr "github.com/okanay/backend-holding/repositories/privacy"
 	r2r "github.com/okanay/backend-holding/repositories/r2"
 	tr "github.com/okanay/backend-holding/repositories/token"
 	ur "github.com/okanay/backend-holding/repositories/user"
 
+	"github.com/okanay/backend-holding/services/aiusage"
 	"github.com/okanay/backend-holding/services/cache"
+	"github.com/okanay/backend-holding/services/candidate"
+	"github.com/okanay/backend-holding/services/jobalert"
+	"github.com/okanay/backend-holding/services/mail"
+	"github.com/okanay/backend-holding/services/outbox"
+	"github.com/okanay/backend-holding/services/preview"
+	"github.com/okanay/backend-holding/services/privacy"
+	"github.com/okanay/backend-holding/services/publishing"
+	"github.com/okanay/backend-holding/services/scheduler"
+	"github.com/okanay/backend-holding/services/screening"
+	"github.com/okanay/backend-holding/services/writing"
+	"github.com/okanay/backend-holding/types"
 )
 
 type Repositories struct {
-	User    *ur.Repository
-	Token   *tr.Repository
-	AI      *air.Repository
-	File    *fr.Repository
-	R2      *r2r.Repository
-	Job     *jr.Repository
-	Content *cr.Repository
+	User      *ur.Repository
+	Token     *tr.Repository
+	AI        *air.Repository
+	AIUsage   *aur.Repository
+	Analytics *anr.Repository
+	File      *fr.Repository
+	R2        *r2r.Repository
+	Job       *jr.Repository
+	JobAlert  *jar.Repository
+	Privacy   *pr.Repository
+	Outbox    *or.Repository
+	Templates *etr.Repository
+	Content   *cr.Repository
+	EditLock  *elr.Repository
+	Preview   *pvr.Repository
 }
 
 type Services struct {
-	Cache cache.CacheService
+	Cache      cache.CacheService
+	Mailer     mail.Mailer
+	JobAlert   *jobalert.Service
+	Candidate  *candidate.Service
+	Publishing *publishing.Service
+	Privacy    *privacy.Service
+	Outbox     *outbox.Service
+	AIUsage    *aiusage.Service
+	Screening  *screening.Service
+	Writing    *writing.Service
+	Preview    *preview.Service
+	Scheduler  *scheduler.Scheduler
 }
 type Handlers struct {
-	Main    *mh.Handler
-	User    *uh.Handler
-	File    *fh.Handler
-	Job     *jh.Handler
-	Content *ch.Handler
+	Main      *mh.Handler
+	User      *uh.Handler
+	File      *fh.Handler
+	Job       *jh.Handler
+	JobAlert  *jah.Handler
+	Content   *ch.Handler
+	Analytics *ah.Handler
+	Privacy   *ph.Handler
+	Templates *eth.Handler
+	AI        *aih.Handler
+	EditLock  *elh.Handler
+	Sitemap   *smh.Handler
 }
 
 func main() {
@@ -74,8 +128,19 @@ func main() {
 
 	// 3. Servisleri ve Handler'ları Başlat
 	repos := initRepositories(sqlDB)
-	services := initServices()
+	services := initServices(repos)
 	handlers := initHandlers(repos, services)
+	defer services.Scheduler.Stop()
+
+	// 3.1 Zamanlanmış İşler
+	services.Scheduler.DailyAt("job-alert-daily-digest", c.JOB_ALERT_DIGEST_HOUR, 0, func(ctx context.Context) {
+		if _, err := services.JobAlert.SendDigests(ctx, ""); err != nil {
+			log.Printf("[JOB ALERT] Günlük özet gönderilemedi: %v", err)
+		}
+	})
+	services.Scheduler.Every("scheduled-publishing", c.SCHEDULED_PUBLISHING_INTERVAL, services.Publishing.RunScheduledTransitions)
+	services.Scheduler.DailyAt("applicant-data-retention", c.PRIVACY_RETENTION_HOUR, 0, services.Privacy.RunRetention)
+	services.Scheduler.Every("email-outbox", c.OUTBOX_DISPATCH_INTERVAL, services.Outbox.Dispatch)
 
 	// 4. Router ve Middleware Yapılandırması
 	router := gin.Default()
@@ -84,7 +149,14 @@ func main() {
 	router.MaxMultipartMemory = 10 << 20
 
 	// 4.0 Global Middlewares
-	router.Use(middlewares.TimeoutMiddleware())
+	// Yapay zeka çağrıları genel süreden uzun sürebilir, SSE akışları kendi zaman aşımını uygular
+	router.Use(middlewares.TimeoutMiddleware(map[string]time.Duration{
+		"/auth/applicant/:id/screening": c.AI_SCREENING_TIMEOUT + 5*time.Second,
+		"/auth/content/translate/:id":   c.AI_TRANSLATION_TIMEOUT + 5*time.Second,
+		"/auth/ai/job-description":      0,
+		"/auth/ai/rewrite":              0,
+		"/auth/ai/seo":                  0,
+	}))
 
 	// 4.1 Middlewares Initialize
 	Recaptcha := middlewares.NewRecaptchaMiddleware(os.Getenv("RECAPTCHA_SECRET_KEY"), 0.7)
@@ -95,6 +167,8 @@ func main() {
 	publicFileAPI := router.Group("/public/files")
 	internalAPI := router.Group("/internal")
 	authAPI := router.Group("/auth")
+	publicTrackingAPI := router.Group("/public/tracking")
+	trackingAPI := router.Group("/tracking")
 
 	// 4.3 Middlewares
 	publicAPI.Use(mw.RateLimiterMiddleware(60, time.Minute))
@@ -103,8 +177,30 @@ func main() {
 	authAPI.Use(mw.RateLimiterMiddleware(120, time.Minute))
 	authAPI.Use(mw.AuthMiddleware(repos.User, repos.Token))
 
+	// Alt gruplar, üst grubun o ana kadar eklenmiş middleware'lerini devralır
+	analyticsAPI := authAPI.Group("/analytics")
+	analyticsAPI.Use(mw.RequireRole(types.RoleAdmin))
+	privacyAPI := authAPI.Group("/privacy")
+	privacyAPI.Use(mw.RequireRole(types.RoleAdmin))
+	aiAPI := authAPI.Group("/ai")
+
+	// Yapay zeka çağrısı yapan uç noktalar kullanıcı bazında dakikalık sınır ve bütçeyi paylaşır
+	aiBudget := mw.AIBudgetMiddleware(services.AIUsage)
+	aiAssistantAPI := aiAPI.Group("", aiBudget)
+	templateAPI := authAPI.Group("/email-templates")
+	templateAPI.Use(mw.RequireRole(types.RoleAdmin))
+	editLockAPI := authAPI.Group("/edit-locks")
+
 	publicFileAPI.Use(mw.RateLimiterMiddleware(4, 120*time.Minute))
 
+	// Onay e-postası gönderen abonelik isteği açık e-posta aktarıcısına dönüşmesin diye reCAPTCHA ve sıkı limit
+	jobAlertSubscribeAPI := publicAPI.Group("", mw.RateLimiterMiddleware(5, 15*time.Minute), Recaptcha.Middleware())
+
+	// Takip kodlarının deneme yanılma ile bulunmasını zorlaştırmak için sıkı limit
+	publicTrackingAPI.Use(mw.RateLimiterMiddleware(10, 15*time.Minute))
+	trackingAPI.Use(mw.RateLimiterMiddleware(60, time.Minute))
+	trackingAPI.Use(mw.AuthTrackingMiddleware())
+
 	// `start with /`
 	router.GET("/", handlers.Main.Index)
 	router.NoRoute(handlers.Main.NotFound)
@@ -114,11 +210,42 @@ func main() {
 	publicAPI.POST("/register", handlers.User.Register)
 
 	publicAPI.GET("/jobs", handlers.Job.ListPublishedJobs)
+	publicAPI.GET("/jobs/search", handlers.Job.SearchJobs)
 	publicAPI.GET("/jobs/:id", handlers.Job.GetJobBySlug)
 	publicAPI.POST("/jobs/:id", handlers.Job.CreateJobApplication)
+	publicAPI.GET("/feeds/jobs/:board", handlers.Job.GetJobFeed)
+	publicAPI.GET("/feeds/contents/:format", handlers.Content.GetContentFeed)
+
+	jobAlertSubscribeAPI.POST("/job-alerts", handlers.JobAlert.Subscribe)
+	publicAPI.GET("/job-alerts/confirm", handlers.JobAlert.ConfirmSubscription)
+	publicAPI.GET("/job-alerts/manage", handlers.JobAlert.GetSubscription)
+	publicAPI.PATCH("/job-alerts/manage", handlers.JobAlert.UpdateSubscription)
+	publicAPI.GET("/job-alerts/unsubscribe", handlers.JobAlert.Unsubscribe)
+	publicAPI.POST("/job-alerts/unsubscribe", handlers.JobAlert.Unsubscribe)
+
+	// `start with /public/tracking`
+	publicTrackingAPI.POST("/code", handlers.Job.RequestTrackingCode)
+	publicTrackingAPI.POST("/verify", handlers.Job.VerifyTrackingCode)
+
+	// `start with /tracking`
+	trackingAPI.GET("/logout", handlers.Job.EndTrackingSession)
+	trackingAPI.GET("/applications", handlers.Job.GetJobApplicationsByEmail)
+	trackingAPI.GET("/interviews", handlers.Job.ListTrackingInterviews)
+	trackingAPI.GET("/interviews/:id/ics", handlers.Job.DownloadTrackingInterviewCalendar)
+	trackingAPI.POST("/interviews/:id/confirm", handlers.Job.ConfirmTrackingInterview)
+	trackingAPI.POST("/interviews/:id/reschedule", handlers.Job.RequestTrackingInterviewReschedule)
+	trackingAPI.GET("/privacy/export", handlers.Privacy.ExportMyData)
+	trackingAPI.POST("/privacy/delete", handlers.Privacy.DeleteMyData)
 
 	publicAPI.GET("/contents", handlers.Content.ListPublishedContents)
+	publicAPI.GET("/contents/search", handlers.Content.SearchContents)
 	publicAPI.GET("/contents/:lang/:slug", handlers.Content.GetContentBySlug)
+	publicAPI.GET("/content-categories", handlers.Content.GetCategoryTree)
+	publicAPI.GET("/sitemap.xml", handlers.Sitemap.GetSitemap)
+	publicAPI.GET("/sitemaps/:file", handlers.Sitemap.GetSitemapPage)
+
+	publicAPI.GET("/preview/content", handlers.Content.GetContentPreview)
+	publicAPI.GET("/preview/job", handlers.Job.GetJobPreview)
 
 	// `start with /auth`
 	authAPI.GET("/logout", handlers.User.Logout)
@@ -130,9 +257,20 @@ func main() {
 	authAPI.PATCH("/job/:id", handlers.Job.UpdateJob)
 	authAPI.DELETE("/job/:id", handlers.Job.DeleteJob)
 	authAPI.PATCH("/job/status/:id", handlers.Job.UpdateJobStatus)
+	authAPI.PATCH("/job/schedule/:id", handlers.Job.ScheduleJob)
+	authAPI.GET("/job/preview/:id", handlers.Job.ListPreviewLinks)
+	authAPI.POST("/job/preview/:id", handlers.Job.CreatePreviewLink)
+	authAPI.DELETE("/job/preview/:id/:linkId", handlers.Job.RevokePreviewLink)
 
 	authAPI.GET("/applicants", handlers.Job.ListJobApplications)
+	authAPI.POST("/applicants/bulk", handlers.Job.BulkUpdateApplications)
+	authAPI.GET("/applicant/:id", handlers.Job.GetJobApplication)
 	authAPI.PATCH("/applicant/status/:id", handlers.Job.UpdateJobApplicationStatus)
+	authAPI.POST("/applicant/:id/screening", aiBudget, handlers.Job.ScreenApplication)
+	authAPI.GET("/applicant/:id/interviews", handlers.Job.ListApplicationInterviews)
+	authAPI.POST("/applicant/:id/interviews", handlers.Job.CreateInterview)
+	authAPI.PATCH("/interview/:id", handlers.Job.RescheduleInterview)
+	authAPI.PATCH("/interview/status/:id", handlers.Job.UpdateInterviewStatus)
 
 	authAPI.GET("/contents", handlers.Content.ListContents)
 	authAPI.GET("/content/:id", handlers.Content.GetContentByID)
@@ -141,6 +279,62 @@ func main() {
 	authAPI.PATCH("/content/:id", handlers.Content.UpdateContent)
 	authAPI.DELETE("/content/:id", handlers.Content.DeleteContent)
 	authAPI.PATCH("/content/status/:id", handlers.Content.UpdateContentStatus)
+	authAPI.PATCH("/content/schedule/:id", handlers.Content.ScheduleContent)
+	authAPI.GET("/content/revisions/:id", handlers.Content.ListRevisions)
+	authAPI.GET("/content/revisions/:id/diff", handlers.Content.DiffRevisions)
+	authAPI.POST("/content/revisions/:id/restore", handlers.Content.RestoreRevision)
+	authAPI.POST("/content/translate/:id", aiBudget, handlers.Content.TranslateContent)
+	authAPI.POST("/content/variant/:id", handlers.Content.CreateLanguageVariant)
+	authAPI.GET("/content/translations", handlers.Content.ListTranslationGroups)
+	authAPI.GET("/content/translations/coverage", handlers.Content.GetTranslationCoverage)
+	authAPI.GET("/content/translations/:identifier", handlers.Content.GetTranslationGroup)
+	authAPI.GET("/content/preview/:id", handlers.Content.ListPreviewLinks)
+	authAPI.POST("/content/preview/:id", handlers.Content.CreatePreviewLink)
+	authAPI.DELETE("/content/preview/:id/:linkId", handlers.Content.RevokePreviewLink)
+	authAPI.GET("/content/reviews", handlers.Content.ListReviewQueue)
+	authAPI.GET("/content/review/:id/history", handlers.Content.ListTransitions)
+	authAPI.POST("/content/review/:id/submit", handlers.Content.SubmitForReview)
+	authAPI.POST("/content/review/:id/approve", handlers.Content.ApproveReview)
+	authAPI.POST("/content/review/:id/request-changes", handlers.Content.RequestChanges)
+	authAPI.GET("/content-categories", handlers.Content.ListCategories)
+	authAPI.POST("/content-categories", handlers.Content.CreateCategory)
+	authAPI.PATCH("/content-categories/:id", handlers.Content.UpdateCategory)
+	authAPI.DELETE("/content-categories/:id", handlers.Content.DeleteCategory)
+	authAPI.GET("/content-tags", handlers.Content.ListTags)
+	authAPI.DELETE("/content-tags/:id", handlers.Content.DeleteTag)
+
+	// `start with /auth/analytics`
+	analyticsAPI.GET("/applications-per-day", handlers.Analytics.GetApplicationsPerDay)
+	analyticsAPI.GET("/status-conversion", handlers.Analytics.GetStatusConversion)
+	analyticsAPI.GET("/time-in-stage", handlers.Analytics.GetTimeInStage)
+	analyticsAPI.GET("/time-to-hire", handlers.Analytics.GetTimeToHire)
+	analyticsAPI.GET("/sources", handlers.Analytics.GetSourceBreakdown)
+	analyticsAPI.GET("/top-categories", handlers.Analytics.GetTopCategories)
+	analyticsAPI.GET("/ai-usage", handlers.Analytics.GetAIUsage)
+
+	// `start with /auth/privacy`
+	privacyAPI.GET("/requests", handlers.Privacy.ListRequests)
+	privacyAPI.POST("/retention/run", handlers.Privacy.RunRetention)
+
+	// `start with /auth/ai`
+	aiAPI.GET("/usage", handlers.AI.GetMyUsage)
+	aiAssistantAPI.POST("/job-description", handlers.AI.DraftJobDescription)
+	aiAssistantAPI.POST("/rewrite", handlers.AI.RewriteSelection)
+	aiAssistantAPI.POST("/seo", handlers.AI.SuggestSEO)
+
+	// `start with /auth/edit-locks`
+	editLockAPI.GET("/:type/:id", handlers.EditLock.GetLock)
+	editLockAPI.POST("/:type/:id", handlers.EditLock.AcquireLock)
+	editLockAPI.DELETE("/:type/:id", handlers.EditLock.ReleaseLock)
+
+	// `start with /auth/email-templates`
+	templateAPI.GET("", handlers.Templates.ListTemplates)
+	templateAPI.POST("", handlers.Templates.CreateTemplate)
+	templateAPI.POST("/preview", handlers.Templates.PreviewDraftTemplate)
+	templateAPI.GET("/:id", handlers.Templates.GetTemplate)
+	templateAPI.GET("/:id/preview", handlers.Templates.PreviewTemplate)
+	templateAPI.PATCH("/:id", handlers.Templates.UpdateTemplate)
+	templateAPI.DELETE("/:id", handlers.Templates.DeleteTemplate)
 
 	// `start with /public/files`
 	publicFileAPI.POST("/presigned-url", handlers.File.CreatePresignedURL)
@@ -160,12 +354,20 @@ func main() {
 // Repository'lerin başlatılması
 func initRepositories(sqlDB *sql.DB) Repositories {
 	return Repositories{
-		User:    ur.NewRepository(sqlDB),
-		Token:   tr.NewRepository(sqlDB),
-		AI:      air.NewRepository(os.Getenv("OPENAI_API_KEY")),
-		File:    fr.NewRepository(sqlDB),
-		Job:     jr.NewRepository(sqlDB),
-		Content: cr.NewRepository(sqlDB),
+		User:      ur.NewRepository(sqlDB),
+		Token:     tr.NewRepository(sqlDB),
+		AI:        air.NewRepository(c.GetAIConfig()),
+		AIUsage:   aur.NewRepository(sqlDB),
+		Analytics: anr.NewRepository(sqlDB),
+		File:      fr.NewRepository(sqlDB),
+		Job:       jr.NewRepository(sqlDB),
+		JobAlert:  jar.NewRepository(sqlDB),
+		Privacy:   pr.NewRepository(sqlDB),
+		Outbox:    or.NewRepository(sqlDB),
+		Templates: etr.NewRepository(sqlDB),
+		Content:   cr.NewRepository(sqlDB),
+		EditLock:  elr.NewRepository(sqlDB),
+		Preview:   pvr.NewRepository(sqlDB),
 		R2: r2r.NewRepository(
 			os.Getenv("R2_ACCOUNT_ID"),
 			os.Getenv("R2_ACCESS_KEY_ID"),
@@ -179,23 +381,50 @@ func initRepositories(sqlDB *sql.DB) Repositories {
 }
 
 // initServices fonksiyonunu da güncelle
-func initServices() Services {
+func initServices(repos Repositories) Services {
 	// Cache oluştur
 	cacheService := cache.NewCacheService(1 * time.Hour)
 
+	// E-posta servisi oluştur
+	mailer := mail.NewMailer()
+
+	// İş alarmı servisi oluştur
+	jobAlertService := jobalert.NewService(repos.JobAlert, mailer)
+
+	// Yapay zeka kullanım servisi oluştur (sınır kontrolü ve maliyet kaydı)
+	aiUsageService := aiusage.NewService(repos.AIUsage, repos.AI.Config())
+
 	return Services{
-		Cache: cacheService, // İşaretçi dönüştürme yapmadan doğrudan atama
+		Cache:      cacheService, // İşaretçi dönüştürme yapmadan doğrudan atama
+		Mailer:     mailer,
+		JobAlert:   jobAlertService,
+		Candidate:  candidate.NewService(mailer, repos.Templates, repos.Outbox),
+		Publishing: publishing.NewService(repos.Job, repos.Content, cacheService, jobAlertService),
+		Privacy:    privacy.NewService(repos.Privacy, repos.Job, repos.JobAlert, repos.Outbox, repos.File, repos.R2, cacheService),
+		Outbox:     outbox.NewService(repos.Outbox, mailer),
+		AIUsage:    aiUsageService,
+		Screening:  screening.NewService(repos.AI, aiUsageService, repos.Job, repos.File, repos.R2),
+		Writing:    writing.NewService(repos.AI, aiUsageService),
+		Preview:    preview.NewService(repos.Preview),
+		Scheduler:  scheduler.NewScheduler(),
 	}
 }
 
 // Handler'ların başlatılması
 func initHandlers(repos Repositories, services Services) Handlers {
 	return Handlers{
-		Main:    mh.NewHandler(),
-		User:    uh.NewHandler(repos.User, repos.Token),
-		File:    fh.NewHandler(repos.File, repos.R2),
-		Job:     jh.NewHandler(repos.File, repos.R2, repos.Job, services.Cache),
-		Content: ch.NewHandler(repos.Content, services.Cache),
+		Main:      mh.NewHandler(),
+		User:      uh.NewHandler(repos.User, repos.Token),
+		File:      fh.NewHandler(repos.File, repos.R2),
+		Job:       jh.NewHandler(repos.File, repos.R2, repos.Job, services.Cache, services.JobAlert, services.Candidate, services.Privacy, services.Screening, services.Preview),
+		JobAlert:  jah.NewHandler(repos.JobAlert, services.JobAlert),
+		Content:   ch.NewHandler(repos.Content, services.Cache, services.Writing, services.Preview),
+		Analytics: ah.NewHandler(repos.Analytics, services.Cache),
+		Privacy:   ph.NewHandler(repos.Privacy, services.Privacy),
+		Templates: eth.NewHandler(repos.Templates),
+		AI:        aih.NewHandler(services.Writing, services.AIUsage),
+		EditLock:  elh.NewHandler(repos.EditLock),
+		Sitemap:   smh.NewHandler(repos.Content, repos.Job, services.Cache),
 	}
 }
 
@@ -0,0 +1,100 @@
+package middlewares
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/configs"
+	"github.com/okanay/backend-holding/services/aiusage"
+	"golang.org/x/time/rate"
+)
+
+// AIBudgetMiddleware yapay zeka uç noktalarında kullanıcı bazında sınırları uygular
+// Dakikalık istek sınırı (AI_RATE_LIMIT_REQ_PER_MINUTE) bellekte, AI_RATE_LIMIT_WINDOW içindeki
+// çağrı ve token bütçesi ai_usage tablosundan kontrol edilir. AuthMiddleware'den sonra kullanılmalıdır.
+func AIBudgetMiddleware(usage *aiusage.Service) gin.HandlerFunc {
+	limiters := make(map[uuid.UUID]*rateLimiterInfo)
+	var mu sync.Mutex
+
+	go func() {
+		ticker := time.NewTicker(configs.RATE_LIMIT_CLEANUP_DURATION)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			mu.Lock()
+			now := time.Now()
+			for userID, info := range limiters {
+				if now.Sub(info.lastUsed) > 30*time.Minute {
+					delete(limiters, userID)
+				}
+			}
+			mu.Unlock()
+		}
+	}()
+
+	return func(c *gin.Context) {
+		value, exists := c.Get("user_id")
+		userID, ok := value.(uuid.UUID)
+		if !exists || !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"success": false,
+				"error":   "unauthorized",
+				"message": "Yetkilendirme bilgisi bulunamadı",
+			})
+			c.Abort()
+			return
+		}
+
+		mu.Lock()
+		info, exists := limiters[userID]
+		if !exists {
+			info = &rateLimiterInfo{
+				limiter: rate.NewLimiter(rate.Every(time.Minute/configs.AI_RATE_LIMIT_REQ_PER_MINUTE), configs.AI_RATE_LIMIT_REQ_PER_MINUTE),
+			}
+			limiters[userID] = info
+		}
+		info.lastUsed = time.Now()
+		allowed := info.limiter.Allow()
+		mu.Unlock()
+
+		if !allowed {
+			abortAIBudget(c, "Çok fazla yapay zeka isteği gönderdiniz. Lütfen bir dakika sonra tekrar deneyin.")
+			return
+		}
+
+		budget, err := usage.Budget(c.Request.Context(), userID)
+		if err != nil {
+			// Kullanım kaydı okunamazsa istek engellenmez, servis katmanı tekrar dener
+			log.Printf("[AI BUDGET] %v", err)
+			c.Next()
+			return
+		}
+
+		c.Header("X-AI-Requests-Remaining", strconv.Itoa(budget.RemainingRequests))
+		c.Header("X-AI-Tokens-Remaining", strconv.Itoa(budget.RemainingTokens))
+
+		if budget.RemainingRequests == 0 || budget.RemainingTokens == 0 {
+			if budget.ResetsAt != nil {
+				c.Header("Retry-After", strconv.Itoa(int(time.Until(*budget.ResetsAt).Seconds())+1))
+			}
+			abortAIBudget(c, "Yapay zeka kullanım sınırına ulaştınız. Lütfen daha sonra tekrar deneyin.")
+			return
+		}
+
+		c.Next()
+	}
+}
+
+func abortAIBudget(c *gin.Context, message string) {
+	c.JSON(http.StatusTooManyRequests, gin.H{
+		"success": false,
+		"error":   "rate_limit_exceeded",
+		"message": message,
+	})
+	c.Abort()
+}
@@ -4,15 +4,25 @@ import (
 	"context"
 	"log"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/okanay/backend-holding/configs"
 )
 
-func TimeoutMiddleware() gin.HandlerFunc {
-	duration := configs.REQUEST_MAX_DURATION
-
+// TimeoutMiddleware istekleri REQUEST_MAX_DURATION ile sınırlar
+// overrides route kalıbına (c.FullPath) göre farklı süre tanımlar; 0 süre zaman aşımını kapatır (SSE gibi akışlar için).
+func TimeoutMiddleware(overrides map[string]time.Duration) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		duration := configs.REQUEST_MAX_DURATION
+		if override, ok := overrides[c.FullPath()]; ok {
+			if override == 0 {
+				c.Next()
+				return
+			}
+			duration = override
+		}
+
 		// Context oluştur ve isteğe bağla
 		ctx, cancel := context.WithTimeout(c.Request.Context(), duration)
 		defer cancel()
@@ -0,0 +1,193 @@
+package AIRepository
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/sashabaranov/go-openai"
+)
+
+// MockProvider ağ bağlantısı olmadan deterministik yanıt üreten sağlayıcı
+// Düz metin isteklerinde son kullanıcı mesajını aynen döner. JSON schema isteklerinde şemaya uyan bir nesne üretir;
+// kullanıcı mesajı JSON ise aynı adlı dizi alanları aynen kopyalanır (örn. çeviri birimleri eleman sayısını korur).
+type MockProvider struct{}
+
+// NewMockProvider yeni bir mock sağlayıcı oluşturur
+func NewMockProvider() *MockProvider {
+	return &MockProvider{}
+}
+
+func (p *MockProvider) CreateChatCompletion(ctx context.Context, request openai.ChatCompletionRequest) (openai.ChatCompletionResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return openai.ChatCompletionResponse{}, err
+	}
+
+	content := mockContent(request)
+
+	return openai.ChatCompletionResponse{
+		ID:     "mock",
+		Object: "chat.completion",
+		Model:  request.Model,
+		Choices: []openai.ChatCompletionChoice{{
+			Message:      openai.ChatCompletionMessage{Role: openai.ChatMessageRoleAssistant, Content: content},
+			FinishReason: openai.FinishReasonStop,
+		}},
+		Usage: mockUsage(request, content),
+	}, nil
+}
+
+func (p *MockProvider) CreateChatCompletionStream(ctx context.Context, request openai.ChatCompletionRequest) (ChatStream, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	content := mockContent(request)
+	stream := &mockStream{ctx: ctx}
+
+	// Kelime kelime parçalanır, böylece akışı işleyen kod gerçek sağlayıcıdaki gibi çalışır
+	for _, word := range strings.SplitAfter(content, " ") {
+		stream.chunks = append(stream.chunks, openai.ChatCompletionStreamResponse{
+			Model:   request.Model,
+			Choices: []openai.ChatCompletionStreamChoice{{Delta: openai.ChatCompletionStreamChoiceDelta{Content: word}}},
+		})
+	}
+
+	if request.StreamOptions != nil && request.StreamOptions.IncludeUsage {
+		usage := mockUsage(request, content)
+		stream.chunks = append(stream.chunks, openai.ChatCompletionStreamResponse{Model: request.Model, Usage: &usage})
+	}
+
+	return stream, nil
+}
+
+// mockStream - Önceden hazırlanmış parçaları sırayla döner
+type mockStream struct {
+	ctx    context.Context
+	chunks []openai.ChatCompletionStreamResponse
+}
+
+func (s *mockStream) Recv() (openai.ChatCompletionStreamResponse, error) {
+	if err := s.ctx.Err(); err != nil {
+		return openai.ChatCompletionStreamResponse{}, err
+	}
+	if len(s.chunks) == 0 {
+		return openai.ChatCompletionStreamResponse{}, io.EOF
+	}
+
+	chunk := s.chunks[0]
+	s.chunks = s.chunks[1:]
+	return chunk, nil
+}
+
+func (s *mockStream) Close() error {
+	s.chunks = nil
+	return nil
+}
+
+// mockContent - İsteğe göre yanıt metnini üretir
+func mockContent(request openai.ChatCompletionRequest) string {
+	var userMessage string
+	for _, message := range request.Messages {
+		if message.Role == openai.ChatMessageRoleUser {
+			userMessage = message.Content
+		}
+	}
+
+	format := request.ResponseFormat
+	if format == nil || format.JSONSchema == nil || format.JSONSchema.Schema == nil {
+		return userMessage
+	}
+
+	var schema map[string]any
+	data, err := format.JSONSchema.Schema.MarshalJSON()
+	if err != nil || json.Unmarshal(data, &schema) != nil {
+		return "{}"
+	}
+
+	var echo map[string]any
+	_ = json.Unmarshal([]byte(userMessage), &echo)
+
+	output, err := json.Marshal(mockValue(schema, echo))
+	if err != nil {
+		return "{}"
+	}
+	return string(output)
+}
+
+// mockValue - Şemaya uyan deterministik değer üretir
+func mockValue(schema map[string]any, echo map[string]any) any {
+	if values, ok := schema["enum"].([]any); ok && len(values) > 0 {
+		return values[0]
+	}
+
+	switch schemaType(schema) {
+	case "object":
+		properties, _ := schema["properties"].(map[string]any)
+		names := make([]string, 0, len(properties))
+		for name := range properties {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		object := make(map[string]any, len(properties))
+		for _, name := range names {
+			property, _ := properties[name].(map[string]any)
+			if value, ok := echo[name]; ok && schemaType(property) == "array" {
+				if _, isArray := value.([]any); isArray {
+					object[name] = value
+					continue
+				}
+			}
+			object[name] = mockValue(property, nil)
+		}
+		return object
+	case "array":
+		items, _ := schema["items"].(map[string]any)
+		return []any{mockValue(items, nil)}
+	case "integer", "number":
+		if minimum, ok := schema["minimum"].(float64); ok {
+			return minimum
+		}
+		return 0
+	case "boolean":
+		return false
+	case "null":
+		return nil
+	default:
+		return "mock"
+	}
+}
+
+// schemaType - "type" alanı dizi olabilir (örn. ["string", "null"]), ilk null olmayan tür kullanılır
+func schemaType(schema map[string]any) string {
+	switch value := schema["type"].(type) {
+	case string:
+		return value
+	case []any:
+		for _, item := range value {
+			if name, ok := item.(string); ok && name != "null" {
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+// mockUsage - Yaklaşık token sayısı (4 karakter ≈ 1 token)
+func mockUsage(request openai.ChatCompletionRequest, content string) openai.Usage {
+	prompt := 0
+	for _, message := range request.Messages {
+		prompt += utf8.RuneCountInString(message.Content)/4 + 1
+	}
+	completion := utf8.RuneCountInString(content)/4 + 1
+
+	return openai.Usage{
+		PromptTokens:     prompt,
+		CompletionTokens: completion,
+		TotalTokens:      prompt + completion,
+	}
+}
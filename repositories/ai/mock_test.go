@@ -0,0 +1,160 @@
+package AIRepository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sashabaranov/go-openai"
+)
+
+type testSchema string
+
+func (s testSchema) MarshalJSON() ([]byte, error) {
+	return []byte(s), nil
+}
+
+func TestMockProviderDeterministic(t *testing.T) {
+	tests := []struct {
+		name    string
+		request openai.ChatCompletionRequest
+		want    string
+	}{
+		{
+			name: "düz metin son kullanıcı mesajını döner",
+			request: openai.ChatCompletionRequest{
+				Model: "mock",
+				Messages: []openai.ChatCompletionMessage{
+					{Role: openai.ChatMessageRoleSystem, Content: "sistem"},
+					{Role: openai.ChatMessageRoleUser, Content: "ilk"},
+					{Role: openai.ChatMessageRoleUser, Content: "son mesaj"},
+				},
+			},
+			want: "son mesaj",
+		},
+		{
+			name: "şemaya uygun nesne üretir",
+			request: openai.ChatCompletionRequest{
+				Model:    "mock",
+				Messages: []openai.ChatCompletionMessage{{Role: openai.ChatMessageRoleUser, Content: "değerlendir"}},
+				ResponseFormat: &openai.ChatCompletionResponseFormat{
+					Type: openai.ChatCompletionResponseFormatTypeJSONSchema,
+					JSONSchema: &openai.ChatCompletionResponseFormatJSONSchema{
+						Name: "test",
+						Schema: testSchema(`{"type":"object","properties":{
+							"score":{"type":"integer","minimum":1},
+							"verdict":{"type":"string","enum":["strong","weak"]},
+							"summary":{"type":["string","null"]},
+							"flags":{"type":"array","items":{"type":"boolean"}}
+						}}`),
+					},
+				},
+			},
+			want: `{"flags":[false],"score":1,"summary":"mock","verdict":"strong"}`,
+		},
+		{
+			name: "kullanıcı mesajındaki diziler aynen kopyalanır",
+			request: openai.ChatCompletionRequest{
+				Model:    "mock",
+				Messages: []openai.ChatCompletionMessage{{Role: openai.ChatMessageRoleUser, Content: `{"units":["a","b","c"]}`}},
+				ResponseFormat: &openai.ChatCompletionResponseFormat{
+					Type: openai.ChatCompletionResponseFormatTypeJSONSchema,
+					JSONSchema: &openai.ChatCompletionResponseFormatJSONSchema{
+						Name:   "test",
+						Schema: testSchema(`{"type":"object","properties":{"units":{"type":"array","items":{"type":"string"}}}}`),
+					},
+				},
+			},
+			want: `{"units":["a","b","c"]}`,
+		},
+	}
+
+	provider := NewMockProvider()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first, err := provider.CreateChatCompletion(context.Background(), tt.request)
+			if err != nil {
+				t.Fatalf("beklenmeyen hata: %v", err)
+			}
+			second, err := provider.CreateChatCompletion(context.Background(), tt.request)
+			if err != nil {
+				t.Fatalf("beklenmeyen hata: %v", err)
+			}
+
+			if !reflect.DeepEqual(first, second) {
+				t.Errorf("aynı istek farklı yanıt üretti\n ilk: %+v\n ikinci: %+v", first, second)
+			}
+
+			got := first.Choices[0].Message.Content
+			if tt.request.ResponseFormat != nil && !json.Valid([]byte(got)) {
+				t.Fatalf("yanıt geçerli JSON değil: %s", got)
+			}
+			if got != tt.want {
+				t.Errorf("yanıt = %s, beklenen %s", got, tt.want)
+			}
+			if first.Usage.TotalTokens != first.Usage.PromptTokens+first.Usage.CompletionTokens || first.Usage.TotalTokens == 0 {
+				t.Errorf("kullanım tutarsız: %+v", first.Usage)
+			}
+		})
+	}
+}
+
+func TestMockProviderStream(t *testing.T) {
+	request := openai.ChatCompletionRequest{
+		Model:         "mock",
+		Messages:      []openai.ChatCompletionMessage{{Role: openai.ChatMessageRoleUser, Content: "bir iki üç"}},
+		StreamOptions: &openai.StreamOptions{IncludeUsage: true},
+	}
+	provider := NewMockProvider()
+
+	response, err := provider.CreateChatCompletion(context.Background(), request)
+	if err != nil {
+		t.Fatalf("beklenmeyen hata: %v", err)
+	}
+
+	stream, err := provider.CreateChatCompletionStream(context.Background(), request)
+	if err != nil {
+		t.Fatalf("beklenmeyen hata: %v", err)
+	}
+	defer stream.Close()
+
+	var output strings.Builder
+	var usage *openai.Usage
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("beklenmeyen hata: %v", err)
+		}
+		if chunk.Usage != nil {
+			usage = chunk.Usage
+		}
+		if len(chunk.Choices) > 0 {
+			output.WriteString(chunk.Choices[0].Delta.Content)
+		}
+	}
+
+	if output.String() != response.Choices[0].Message.Content {
+		t.Errorf("akış çıktısı = %q, beklenen %q", output.String(), response.Choices[0].Message.Content)
+	}
+	if usage == nil || *usage != response.Usage {
+		t.Errorf("akış kullanımı = %+v, beklenen %+v", usage, response.Usage)
+	}
+}
+
+func TestMockProviderCanceledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := NewMockProvider().CreateChatCompletion(ctx, openai.ChatCompletionRequest{Model: "mock"})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("hata = %v, beklenen context.Canceled", err)
+	}
+}
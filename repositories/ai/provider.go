@@ -0,0 +1,64 @@
+package AIRepository
+
+import (
+	"context"
+	"log"
+
+	"github.com/okanay/backend-holding/configs"
+	"github.com/sashabaranov/go-openai"
+)
+
+// Provider - Sohbet tamamlama API'si sunan yapay zeka sağlayıcısı
+// İstek ve yanıtlar OpenAI formatındadır; OpenAI uyumlu sunucular (Ollama, vLLM, Azure OpenAI) aynı istemciyle kullanılır.
+type Provider interface {
+	CreateChatCompletion(ctx context.Context, request openai.ChatCompletionRequest) (openai.ChatCompletionResponse, error)
+	CreateChatCompletionStream(ctx context.Context, request openai.ChatCompletionRequest) (ChatStream, error)
+}
+
+// ChatStream - Akış yanıtı; Recv akış bitince io.EOF döner
+type ChatStream interface {
+	Recv() (openai.ChatCompletionStreamResponse, error)
+	Close() error
+}
+
+// NewProvider yapılandırmaya göre sağlayıcıyı oluşturur
+func NewProvider(config configs.AIConfig) Provider {
+	switch config.Provider {
+	case configs.AI_PROVIDER_MOCK:
+		log.Println("[AI] Mock sağlayıcı kullanılıyor, yanıtlar yerel olarak üretilir")
+		return NewMockProvider()
+
+	case configs.AI_PROVIDER_AZURE:
+		clientConfig := openai.DefaultAzureConfig(config.APIKey, config.BaseURL)
+		clientConfig.APIVersion = config.APIVersion
+		return &openAIProvider{client: openai.NewClientWithConfig(clientConfig)}
+
+	case configs.AI_PROVIDER_COMPATIBLE:
+		if config.BaseURL == "" {
+			log.Println("[AI] AI_BASE_URL tanımlı değil, OpenAI uyumlu sağlayıcı çalışmayacak")
+		}
+		clientConfig := openai.DefaultConfig(config.APIKey)
+		clientConfig.BaseURL = config.BaseURL
+		return &openAIProvider{client: openai.NewClientWithConfig(clientConfig)}
+
+	default:
+		clientConfig := openai.DefaultConfig(config.APIKey)
+		if config.BaseURL != "" {
+			clientConfig.BaseURL = config.BaseURL
+		}
+		return &openAIProvider{client: openai.NewClientWithConfig(clientConfig)}
+	}
+}
+
+// openAIProvider - go-openai istemcisi ile çalışan sağlayıcı
+type openAIProvider struct {
+	client *openai.Client
+}
+
+func (p *openAIProvider) CreateChatCompletion(ctx context.Context, request openai.ChatCompletionRequest) (openai.ChatCompletionResponse, error) {
+	return p.client.CreateChatCompletion(ctx, request)
+}
+
+func (p *openAIProvider) CreateChatCompletionStream(ctx context.Context, request openai.ChatCompletionRequest) (ChatStream, error) {
+	return p.client.CreateChatCompletionStream(ctx, request)
+}
@@ -2,27 +2,64 @@ package AIRepository
 
 import (
 	"context"
+	"time"
 
+	"github.com/okanay/backend-holding/configs"
 	"github.com/sashabaranov/go-openai"
 )
 
 type Repository struct {
-	client *openai.Client
+	provider   Provider
+	config     configs.AIConfig
+	maxRetries int
+	backoff    func(attempt int) time.Duration
 }
 
-func NewRepository(apiKey string) *Repository {
-	client := openai.NewClient(apiKey)
+// NewRepository yapılandırmadaki sağlayıcı ile yeni bir repository oluşturur
+func NewRepository(config configs.AIConfig) *Repository {
+	return NewRepositoryWithProvider(NewProvider(config), config)
+}
+
+// NewRepositoryWithProvider verilen sağlayıcıyı kullanan repository oluşturur (örn. testlerde MockProvider)
+func NewRepositoryWithProvider(provider Provider, config configs.AIConfig) *Repository {
 	return &Repository{
-		client: client,
+		provider:   provider,
+		config:     config,
+		maxRetries: config.MaxRetries,
+		backoff:    backoff,
 	}
 }
 
-// Client returns the OpenAI client instance
-func (r *Repository) Client() *openai.Client {
-	return r.client
+// Config repository oluşturulurken okunan yapay zeka ayarlarını döner (modeller ve fiyatlar)
+func (r *Repository) Config() configs.AIConfig {
+	return r.config
+}
+
+// Price modelin fiyatını döner, fiyatı bilinmeyen modeller için sıfır fiyat döner
+func (r *Repository) Price(model string) configs.AIModelPrice {
+	price, _ := r.config.Price(model)
+	return price
 }
 
-// CreateChatCompletion encapsulates the OpenAI API call
+// CreateChatCompletion encapsulates the chat completion call, retrying transient errors
 func (r *Repository) CreateChatCompletion(ctx context.Context, request openai.ChatCompletionRequest) (openai.ChatCompletionResponse, error) {
-	return r.client.CreateChatCompletion(ctx, request)
+	var response openai.ChatCompletionResponse
+	err := r.retry(ctx, func() error {
+		var err error
+		response, err = r.provider.CreateChatCompletion(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+// CreateChatCompletionStream opens a streaming chat completion (used for SSE responses)
+// Only opening the stream is retried; errors after the first chunk are returned to the caller.
+func (r *Repository) CreateChatCompletionStream(ctx context.Context, request openai.ChatCompletionRequest) (ChatStream, error) {
+	var stream ChatStream
+	err := r.retry(ctx, func() error {
+		var err error
+		stream, err = r.provider.CreateChatCompletionStream(ctx, request)
+		return err
+	})
+	return stream, err
 }
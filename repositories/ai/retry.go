@@ -0,0 +1,66 @@
+package AIRepository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/okanay/backend-holding/configs"
+	"github.com/sashabaranov/go-openai"
+)
+
+// retry - Geçici hatalarda isteği üstel bekleme (backoff) ile tekrar dener
+func (r *Repository) retry(ctx context.Context, call func() error) error {
+	for attempt := 0; ; attempt++ {
+		err := call()
+		if err == nil || attempt >= r.maxRetries || !isRetryable(err) {
+			return err
+		}
+
+		log.Printf("[AI] İstek başarısız, tekrar denenecek (%d/%d): %v", attempt+1, r.maxRetries, err)
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(r.backoff(attempt)):
+		}
+	}
+}
+
+// backoff - Deneme sayısına göre bekleme süresi; aynı anda başarısız olan istekler dağılsın diye rastgelelik eklenir
+func backoff(attempt int) time.Duration {
+	delay := min(configs.AI_RETRY_BASE_DELAY<<attempt, configs.AI_RETRY_MAX_DELAY)
+	return delay/2 + rand.N(delay/2+1)
+}
+
+// isRetryable - Hız sınırı, sunucu hataları ve ağ hataları tekrar denenir; istek ve kota hataları denenmez
+func isRetryable(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var apiErr *openai.APIError
+	if errors.As(err, &apiErr) {
+		if fmt.Sprint(apiErr.Code) == "insufficient_quota" {
+			return false
+		}
+		return retryableStatus(apiErr.HTTPStatusCode)
+	}
+
+	var requestErr *openai.RequestError
+	if errors.As(err, &requestErr) {
+		return retryableStatus(requestErr.HTTPStatusCode)
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+func retryableStatus(status int) bool {
+	return status == http.StatusTooManyRequests || status == http.StatusRequestTimeout || status >= http.StatusInternalServerError
+}
@@ -0,0 +1,161 @@
+package AIRepository
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/okanay/backend-holding/configs"
+	"github.com/sashabaranov/go-openai"
+)
+
+// failingProvider - İlk failures çağrıda err döner, sonrasında başarılı olur
+type failingProvider struct {
+	MockProvider
+	err      error
+	failures int
+	calls    int
+}
+
+func (p *failingProvider) CreateChatCompletion(ctx context.Context, request openai.ChatCompletionRequest) (openai.ChatCompletionResponse, error) {
+	p.calls++
+	if p.calls <= p.failures {
+		return openai.ChatCompletionResponse{}, p.err
+	}
+	return p.MockProvider.CreateChatCompletion(ctx, request)
+}
+
+func TestRetry(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		failures  int
+		wantCalls int
+		wantErr   bool
+	}{
+		{
+			name:      "hata yoksa tek çağrı",
+			failures:  0,
+			wantCalls: 1,
+		},
+		{
+			name:      "hız sınırı tekrar denenir",
+			err:       &openai.APIError{HTTPStatusCode: http.StatusTooManyRequests},
+			failures:  2,
+			wantCalls: 3,
+		},
+		{
+			name:      "sunucu hatası tekrar denenir",
+			err:       &openai.RequestError{HTTPStatusCode: http.StatusBadGateway},
+			failures:  1,
+			wantCalls: 2,
+		},
+		{
+			name:      "ağ hatası tekrar denenir",
+			err:       &net.OpError{Op: "dial", Err: errors.New("connection refused")},
+			failures:  1,
+			wantCalls: 2,
+		},
+		{
+			name:      "deneme hakkı biterse son hata döner",
+			err:       &openai.APIError{HTTPStatusCode: http.StatusServiceUnavailable},
+			failures:  5,
+			wantCalls: 3,
+			wantErr:   true,
+		},
+		{
+			name:      "istek hatası denenmez",
+			err:       &openai.APIError{HTTPStatusCode: http.StatusBadRequest},
+			failures:  1,
+			wantCalls: 1,
+			wantErr:   true,
+		},
+		{
+			name:      "kota hatası denenmez",
+			err:       &openai.APIError{HTTPStatusCode: http.StatusTooManyRequests, Code: "insufficient_quota"},
+			failures:  1,
+			wantCalls: 1,
+			wantErr:   true,
+		},
+		{
+			name:      "zaman aşımı denenmez",
+			err:       context.DeadlineExceeded,
+			failures:  1,
+			wantCalls: 1,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := &failingProvider{err: tt.err, failures: tt.failures}
+			repository := NewRepositoryWithProvider(provider, configs.AIConfig{MaxRetries: 2})
+
+			var delays []int
+			repository.backoff = func(attempt int) time.Duration {
+				delays = append(delays, attempt)
+				return 0
+			}
+
+			_, err := repository.CreateChatCompletion(context.Background(), openai.ChatCompletionRequest{Model: "mock"})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("hata = %v, hata bekleniyor mu: %v", err, tt.wantErr)
+			}
+			if provider.calls != tt.wantCalls {
+				t.Errorf("çağrı sayısı = %d, beklenen %d", provider.calls, tt.wantCalls)
+			}
+
+			// Her tekrar denemeden önce bir kez beklenir, deneme sırası artarak iletilir
+			if len(delays) != tt.wantCalls-1 {
+				t.Errorf("bekleme sayısı = %d, beklenen %d", len(delays), tt.wantCalls-1)
+			}
+			for i, attempt := range delays {
+				if attempt != i {
+					t.Errorf("%d. beklemenin deneme sırası = %d", i, attempt)
+				}
+			}
+		})
+	}
+}
+
+func TestRetryStopsWhenContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	provider := &failingProvider{err: &openai.APIError{HTTPStatusCode: http.StatusInternalServerError}, failures: 5}
+	repository := NewRepositoryWithProvider(provider, configs.AIConfig{MaxRetries: 3})
+	repository.backoff = func(int) time.Duration {
+		cancel()
+		return time.Hour
+	}
+
+	_, err := repository.CreateChatCompletion(ctx, openai.ChatCompletionRequest{Model: "mock"})
+	if err == nil {
+		t.Fatal("hata bekleniyordu")
+	}
+	if provider.calls != 1 {
+		t.Errorf("çağrı sayısı = %d, beklenen 1", provider.calls)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempt int
+		ceiling time.Duration
+	}{
+		{attempt: 0, ceiling: configs.AI_RETRY_BASE_DELAY},
+		{attempt: 1, ceiling: 2 * configs.AI_RETRY_BASE_DELAY},
+		{attempt: 3, ceiling: 8 * configs.AI_RETRY_BASE_DELAY},
+		{attempt: 10, ceiling: configs.AI_RETRY_MAX_DELAY},
+	}
+
+	for _, tt := range tests {
+		for range 50 {
+			delay := backoff(tt.attempt)
+			if delay < tt.ceiling/2 || delay > tt.ceiling {
+				t.Fatalf("deneme %d: bekleme = %v, beklenen aralık [%v, %v]", tt.attempt, delay, tt.ceiling/2, tt.ceiling)
+			}
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package AIUsageRepository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// RecordUsage - Model çağrısının token ve maliyet bilgisini kaydeder
+func (r *Repository) RecordUsage(ctx context.Context, record types.AIUsageRecord) error {
+	defer utils.TimeTrack(time.Now(), "AI Usage -> Record Usage")
+
+	query := `
+		INSERT INTO ai_usage (feature, model, input_tokens, output_tokens, cost_usd, user_id, reference_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		record.Feature,
+		record.Model,
+		record.InputTokens,
+		record.OutputTokens,
+		record.CostUSD,
+		uuid.NullUUID{UUID: record.UserID, Valid: record.UserID != uuid.Nil},
+		uuid.NullUUID{UUID: record.ReferenceID, Valid: record.ReferenceID != uuid.Nil},
+	)
+	if err != nil {
+		return fmt.Errorf("yapay zeka kullanımı kaydedilemedi: %w", err)
+	}
+
+	return nil
+}
+
+// GetUserUsageSince - Kullanıcının verilen zamandan bu yana yaptığı çağrı ve harcadığı token sayısını döner
+func (r *Repository) GetUserUsageSince(ctx context.Context, userID uuid.UUID, since time.Time) (types.AIUsageSummary, error) {
+	defer utils.TimeTrack(time.Now(), "AI Usage -> Get User Usage")
+
+	var summary types.AIUsageSummary
+
+	query := `
+		SELECT COUNT(*), COALESCE(SUM(input_tokens + output_tokens), 0), MIN(created_at)
+		FROM ai_usage
+		WHERE user_id = $1 AND created_at >= $2
+	`
+
+	err := r.db.QueryRowContext(ctx, query, userID, since).Scan(&summary.Requests, &summary.Tokens, &summary.OldestAt)
+	if err != nil {
+		return summary, fmt.Errorf("yapay zeka kullanımı getirilemedi: %w", err)
+	}
+
+	return summary, nil
+}
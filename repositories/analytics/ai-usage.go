@@ -0,0 +1,115 @@
+package AnalyticsRepository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// aiUsageTotals - Tüm AI kullanım sorgularında ortak toplam sütunları
+const aiUsageTotals = `
+	COUNT(*),
+	COALESCE(SUM(u.input_tokens), 0),
+	COALESCE(SUM(u.output_tokens), 0),
+	COALESCE(SUM(u.cost_usd), 0)::FLOAT8
+`
+
+// GetAIUsageReport - Tarih aralığındaki yapay zeka harcamasını kullanıcı, özellik ve gün bazında getirir
+// Kullanıcı listesi en çok harcayandan başlar ve params.Limit ile sınırlanır.
+func (r *Repository) GetAIUsageReport(ctx context.Context, params types.AnalyticsParams) (types.AIUsageReport, error) {
+	defer utils.TimeTrack(time.Now(), "Analytics -> AI Usage Report")
+
+	report := types.AIUsageReport{
+		ByUser:    []types.AIUsageByUser{},
+		ByFeature: []types.AIUsageByFeature{},
+		PerDay:    []types.AIUsagePerDay{},
+	}
+	where := " WHERE u.created_at >= $1 AND u.created_at < $2"
+
+	// Toplamlar
+	err := r.db.QueryRowContext(ctx, `SELECT `+aiUsageTotals+` FROM ai_usage u`+where, params.From, params.To).Scan(
+		&report.Totals.Requests, &report.Totals.InputTokens, &report.Totals.OutputTokens, &report.Totals.CostUSD,
+	)
+	if err != nil {
+		return report, fmt.Errorf("yapay zeka kullanım toplamları getirilemedi: %w", err)
+	}
+
+	// Kullanıcı bazında
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT u.user_id, COALESCE(usr.username, ''), `+aiUsageTotals+`
+		FROM ai_usage u
+		LEFT JOIN users usr ON u.user_id = usr.id
+	`+where+`
+		GROUP BY u.user_id, usr.username
+		ORDER BY SUM(u.cost_usd) DESC
+		LIMIT $3
+	`, params.From, params.To, params.Limit)
+	if err != nil {
+		return report, fmt.Errorf("kullanıcı bazında yapay zeka kullanımı getirilemedi: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row types.AIUsageByUser
+		if err := rows.Scan(&row.UserID, &row.Username, &row.Requests, &row.InputTokens, &row.OutputTokens, &row.CostUSD); err != nil {
+			return report, fmt.Errorf("kullanıcı kullanım satırı okunamadı: %w", err)
+		}
+		report.ByUser = append(report.ByUser, row)
+	}
+	if err := rows.Err(); err != nil {
+		return report, fmt.Errorf("kullanıcı kullanımı okunurken hata: %w", err)
+	}
+
+	// Özellik bazında
+	rows, err = r.db.QueryContext(ctx, `
+		SELECT u.feature, `+aiUsageTotals+`
+		FROM ai_usage u
+	`+where+`
+		GROUP BY u.feature
+		ORDER BY SUM(u.cost_usd) DESC
+	`, params.From, params.To)
+	if err != nil {
+		return report, fmt.Errorf("özellik bazında yapay zeka kullanımı getirilemedi: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row types.AIUsageByFeature
+		if err := rows.Scan(&row.Feature, &row.Requests, &row.InputTokens, &row.OutputTokens, &row.CostUSD); err != nil {
+			return report, fmt.Errorf("özellik kullanım satırı okunamadı: %w", err)
+		}
+		report.ByFeature = append(report.ByFeature, row)
+	}
+	if err := rows.Err(); err != nil {
+		return report, fmt.Errorf("özellik kullanımı okunurken hata: %w", err)
+	}
+
+	// Gün ve özellik bazında
+	rows, err = r.db.QueryContext(ctx, `
+		SELECT TO_CHAR(DATE_TRUNC('day', u.created_at), 'YYYY-MM-DD') AS day, u.feature, `+aiUsageTotals+`
+		FROM ai_usage u
+	`+where+`
+		GROUP BY day, u.feature
+		ORDER BY day, u.feature
+	`, params.From, params.To)
+	if err != nil {
+		return report, fmt.Errorf("günlük yapay zeka kullanımı getirilemedi: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row types.AIUsagePerDay
+		if err := rows.Scan(&row.Day, &row.Feature, &row.Requests, &row.InputTokens, &row.OutputTokens, &row.CostUSD); err != nil {
+			return report, fmt.Errorf("günlük kullanım satırı okunamadı: %w", err)
+		}
+		report.PerDay = append(report.PerDay, row)
+	}
+	if err := rows.Err(); err != nil {
+		return report, fmt.Errorf("günlük kullanım okunurken hata: %w", err)
+	}
+
+	return report, nil
+}
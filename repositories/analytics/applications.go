@@ -0,0 +1,140 @@
+package AnalyticsRepository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// GetApplicationsPerDay - İlan bazında günlük başvuru sayılarını getirir
+func (r *Repository) GetApplicationsPerDay(ctx context.Context, params types.AnalyticsParams) ([]types.ApplicationsPerDay, error) {
+	defer utils.TimeTrack(time.Now(), "Analytics -> Applications Per Day")
+
+	whereClause, args, _ := applicationFilter(params)
+
+	query := `
+		SELECT
+			a.job_id,
+			COALESCE(d.title, ''),
+			TO_CHAR(DATE_TRUNC('day', a.created_at), 'YYYY-MM-DD') AS day,
+			COUNT(*)
+		FROM job_applications a
+		LEFT JOIN job_posting_details d ON a.job_id = d.id
+	` + whereClause + `
+		GROUP BY a.job_id, d.title, day
+		ORDER BY day, d.title
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("günlük başvuru sayıları getirilemedi: %w", err)
+	}
+	defer rows.Close()
+
+	results := []types.ApplicationsPerDay{}
+	for rows.Next() {
+		var row types.ApplicationsPerDay
+		if err := rows.Scan(&row.JobID, &row.JobTitle, &row.Day, &row.Count); err != nil {
+			return nil, fmt.Errorf("günlük başvuru satırı okunamadı: %w", err)
+		}
+		results = append(results, row)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("günlük başvuru sayıları okunurken hata: %w", err)
+	}
+
+	return results, nil
+}
+
+// GetSourceBreakdown - Başvuru kanalı bazında başvuru ve işe alım sayılarını getirir
+func (r *Repository) GetSourceBreakdown(ctx context.Context, params types.AnalyticsParams, hiredStatus string) ([]types.SourceBreakdown, error) {
+	defer utils.TimeTrack(time.Now(), "Analytics -> Source Breakdown")
+
+	whereClause, args, paramIndex := applicationFilter(params)
+	args = append(args, hiredStatus)
+
+	query := fmt.Sprintf(`
+		SELECT
+			a.source,
+			COUNT(*),
+			COUNT(*) FILTER (
+				WHERE EXISTS (
+					SELECT 1 FROM job_application_status_history h
+					WHERE h.application_id = a.id AND h.to_status = $%d
+				)
+			)
+		FROM job_applications a
+	`+whereClause+`
+		GROUP BY a.source
+		ORDER BY COUNT(*) DESC
+	`, paramIndex)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("kanal dağılımı getirilemedi: %w", err)
+	}
+	defer rows.Close()
+
+	results := []types.SourceBreakdown{}
+	for rows.Next() {
+		var row types.SourceBreakdown
+		if err := rows.Scan(&row.Source, &row.Applications, &row.Hired); err != nil {
+			return nil, fmt.Errorf("kanal satırı okunamadı: %w", err)
+		}
+		row.HireRate = rate(row.Hired, row.Applications)
+		results = append(results, row)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("kanal dağılımı okunurken hata: %w", err)
+	}
+
+	return results, nil
+}
+
+// GetTopCategories - En çok başvuru alan kategorileri getirir
+func (r *Repository) GetTopCategories(ctx context.Context, params types.AnalyticsParams) ([]types.CategoryStat, error) {
+	defer utils.TimeTrack(time.Now(), "Analytics -> Top Categories")
+
+	whereClause, args, _ := applicationFilter(params)
+
+	query := fmt.Sprintf(`
+		SELECT
+			c.category_name,
+			COALESCE(cat.display_name, c.category_name),
+			COUNT(DISTINCT a.job_id),
+			COUNT(DISTINCT a.id)
+		FROM job_applications a
+		JOIN job_posting_categories c ON c.job_id = a.job_id
+		LEFT JOIN job_categories cat ON cat.name = c.category_name
+	`+whereClause+`
+		GROUP BY c.category_name, cat.display_name
+		ORDER BY COUNT(DISTINCT a.id) DESC
+		LIMIT %d
+	`, params.Limit)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("kategori istatistikleri getirilemedi: %w", err)
+	}
+	defer rows.Close()
+
+	results := []types.CategoryStat{}
+	for rows.Next() {
+		var row types.CategoryStat
+		if err := rows.Scan(&row.Name, &row.DisplayName, &row.Jobs, &row.Applications); err != nil {
+			return nil, fmt.Errorf("kategori satırı okunamadı: %w", err)
+		}
+		results = append(results, row)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("kategori istatistikleri okunurken hata: %w", err)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,48 @@
+package AnalyticsRepository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/types"
+)
+
+type Repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// applicationFilter - Başvuru tarih aralığı, kategori ve ilan filtrelerini "a" alias'ı için WHERE cümlesine dönüştürür
+func applicationFilter(params types.AnalyticsParams) (string, []any, int) {
+	whereClause := " WHERE a.created_at >= $1 AND a.created_at < $2"
+	args := []any{params.From, params.To}
+	paramIndex := 3
+
+	// Kategori filtreleme
+	if params.Category != "" {
+		whereClause += fmt.Sprintf(" AND EXISTS (SELECT 1 FROM job_posting_categories jpc WHERE jpc.job_id = a.job_id AND jpc.category_name = $%d)", paramIndex)
+		args = append(args, params.Category)
+		paramIndex++
+	}
+
+	// İlan filtreleme
+	if params.JobID != uuid.Nil {
+		whereClause += fmt.Sprintf(" AND a.job_id = $%d", paramIndex)
+		args = append(args, params.JobID)
+		paramIndex++
+	}
+
+	return whereClause, args, paramIndex
+}
+
+// rate - Sıfıra bölmeden korunarak oran hesaplar
+func rate(part, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(part) / float64(total)
+}
@@ -0,0 +1,194 @@
+package AnalyticsRepository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// GetStatusConversion - Huni aşamalarına ulaşan başvuru sayılarını ve durumlar arası geçişleri getirir
+// Bir aşamayı atlayan başvuru (örn. received -> interview), atlanan aşamaya da ulaşmış sayılır.
+func (r *Repository) GetStatusConversion(ctx context.Context, params types.AnalyticsParams, funnel []string) (types.StatusConversion, error) {
+	defer utils.TimeTrack(time.Now(), "Analytics -> Status Conversion")
+
+	result := types.StatusConversion{
+		Funnel:      []types.StatusFunnelStep{},
+		Transitions: []types.StatusTransition{},
+	}
+
+	whereClause, args, paramIndex := applicationFilter(params)
+
+	// 1. Her başvurunun huni içinde ulaştığı en ileri aşama
+	funnelQuery := fmt.Sprintf(`
+		SELECT max_step, COUNT(*)
+		FROM (
+			SELECT a.id, MAX(ARRAY_POSITION($%d::text[], h.to_status)) AS max_step
+			FROM job_applications a
+			JOIN job_application_status_history h ON h.application_id = a.id
+	`+whereClause+`
+			GROUP BY a.id
+		) steps
+		WHERE max_step IS NOT NULL
+		GROUP BY max_step
+	`, paramIndex)
+
+	rows, err := r.db.QueryContext(ctx, funnelQuery, append(args, pq.Array(funnel))...)
+	if err != nil {
+		return result, fmt.Errorf("dönüşüm hunisi getirilemedi: %w", err)
+	}
+	defer rows.Close()
+
+	reachedAt := make([]int, len(funnel))
+	for rows.Next() {
+		var step, count int
+		if err := rows.Scan(&step, &count); err != nil {
+			return result, fmt.Errorf("huni satırı okunamadı: %w", err)
+		}
+		if step >= 1 && step <= len(funnel) {
+			reachedAt[step-1] += count
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return result, fmt.Errorf("dönüşüm hunisi okunurken hata: %w", err)
+	}
+
+	// Kümülatif: bir aşamaya ulaşan, önceki tüm aşamalara da ulaşmış sayılır
+	for i := len(reachedAt) - 2; i >= 0; i-- {
+		reachedAt[i] += reachedAt[i+1]
+	}
+
+	for i, status := range funnel {
+		step := types.StatusFunnelStep{Status: status, Reached: reachedAt[i], ConversionFromPrevious: 1}
+		if i > 0 {
+			step.ConversionFromPrevious = rate(reachedAt[i], reachedAt[i-1])
+		}
+		if len(reachedAt) > 0 {
+			step.ConversionFromStart = rate(reachedAt[i], reachedAt[0])
+		}
+		result.Funnel = append(result.Funnel, step)
+	}
+
+	// 2. Durumlar arası geçişler
+	transitionQuery := `
+		SELECT h.from_status, h.to_status, COUNT(*)
+		FROM job_application_status_history h
+		JOIN job_applications a ON a.id = h.application_id
+	` + whereClause + `
+			AND h.from_status IS NOT NULL
+		GROUP BY h.from_status, h.to_status
+		ORDER BY COUNT(*) DESC
+	`
+
+	transitionRows, err := r.db.QueryContext(ctx, transitionQuery, args...)
+	if err != nil {
+		return result, fmt.Errorf("durum geçişleri getirilemedi: %w", err)
+	}
+	defer transitionRows.Close()
+
+	for transitionRows.Next() {
+		var transition types.StatusTransition
+		if err := transitionRows.Scan(&transition.From, &transition.To, &transition.Count); err != nil {
+			return result, fmt.Errorf("geçiş satırı okunamadı: %w", err)
+		}
+		result.Transitions = append(result.Transitions, transition)
+	}
+
+	if err := transitionRows.Err(); err != nil {
+		return result, fmt.Errorf("durum geçişleri okunurken hata: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetTimeInStage - Tamamlanmış aşamalarda geçirilen ortalama ve medyan süreleri getirir
+func (r *Repository) GetTimeInStage(ctx context.Context, params types.AnalyticsParams) ([]types.StageDuration, error) {
+	defer utils.TimeTrack(time.Now(), "Analytics -> Time In Stage")
+
+	whereClause, args, _ := applicationFilter(params)
+
+	query := `
+		WITH stages AS (
+			SELECT
+				h.to_status AS status,
+				EXTRACT(EPOCH FROM (
+					LEAD(h.changed_at) OVER (PARTITION BY h.application_id ORDER BY h.changed_at) - h.changed_at
+				)) / 3600 AS hours
+			FROM job_application_status_history h
+			JOIN job_applications a ON a.id = h.application_id
+	` + whereClause + `
+		)
+		SELECT
+			status,
+			COUNT(*),
+			AVG(hours),
+			PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY hours)
+		FROM stages
+		WHERE hours IS NOT NULL
+		GROUP BY status
+		ORDER BY AVG(hours) DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("aşama süreleri getirilemedi: %w", err)
+	}
+	defer rows.Close()
+
+	results := []types.StageDuration{}
+	for rows.Next() {
+		var row types.StageDuration
+		if err := rows.Scan(&row.Status, &row.Count, &row.AvgHours, &row.MedianHours); err != nil {
+			return nil, fmt.Errorf("aşama satırı okunamadı: %w", err)
+		}
+		results = append(results, row)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("aşama süreleri okunurken hata: %w", err)
+	}
+
+	return results, nil
+}
+
+// GetTimeToHire - Başvurudan işe alım durumuna ilk geçişe kadar geçen süre istatistiklerini getirir
+func (r *Repository) GetTimeToHire(ctx context.Context, params types.AnalyticsParams, hiredStatus string) (types.TimeToHire, error) {
+	defer utils.TimeTrack(time.Now(), "Analytics -> Time To Hire")
+
+	result := types.TimeToHire{HiredStatus: hiredStatus}
+
+	whereClause, args, paramIndex := applicationFilter(params)
+
+	query := fmt.Sprintf(`
+		SELECT
+			COUNT(*),
+			COALESCE(AVG(days), 0),
+			COALESCE(PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY days), 0),
+			COALESCE(MIN(days), 0),
+			COALESCE(MAX(days), 0)
+		FROM (
+			SELECT EXTRACT(EPOCH FROM (MIN(h.changed_at) - a.created_at)) / 86400 AS days
+			FROM job_applications a
+			JOIN job_application_status_history h ON h.application_id = a.id AND h.to_status = $%d
+	`+whereClause+`
+			GROUP BY a.id, a.created_at
+		) hires
+	`, paramIndex)
+
+	err := r.db.QueryRowContext(ctx, query, append(args, hiredStatus)...).Scan(
+		&result.Hired,
+		&result.AvgDays,
+		&result.MedianDays,
+		&result.MinDays,
+		&result.MaxDays,
+	)
+	if err != nil {
+		return result, fmt.Errorf("işe alım süresi getirilemedi: %w", err)
+	}
+
+	return result, nil
+}
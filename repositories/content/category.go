@@ -0,0 +1,304 @@
+package ContentRepository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+const categorySelect = `
+	SELECT
+		cc.id, cc.slug, cc.parent_id, cc.sort_order, cc.created_at, cc.updated_at,
+		COALESCE((
+			SELECT json_agg(json_build_object('language', t.language, 'name', t.name, 'description', t.description) ORDER BY t.language)
+			FROM content_category_translations t
+			WHERE t.category_id = cc.id
+		), '[]')
+	FROM content_categories cc
+`
+
+// ListCategories - Tüm kategorileri çevirileriyle birlikte düz liste olarak getirir
+func (r *Repository) ListCategories(ctx context.Context) ([]types.ContentCategory, error) {
+	defer utils.TimeTrack(time.Now(), "Repository -> ListCategories")
+
+	rows, err := r.db.QueryContext(ctx, categorySelect+" ORDER BY cc.sort_order, cc.slug")
+	if err != nil {
+		return nil, fmt.Errorf("kategoriler getirilemedi: %w", err)
+	}
+	defer rows.Close()
+
+	categories := []types.ContentCategory{}
+	for rows.Next() {
+		category, err := scanCategory(rows)
+		if err != nil {
+			return nil, err
+		}
+		categories = append(categories, category)
+	}
+
+	return categories, rows.Err()
+}
+
+// GetCategory - ID'ye göre kategori getirir
+func (r *Repository) GetCategory(ctx context.Context, id uuid.UUID) (types.ContentCategory, error) {
+	defer utils.TimeTrack(time.Now(), "Repository -> GetCategory")
+
+	category, err := scanCategory(r.db.QueryRowContext(ctx, categorySelect+" WHERE cc.id = $1", id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return category, fmt.Errorf("kategori bulunamadı (ID: %s)", id)
+		}
+		return category, err
+	}
+
+	return category, nil
+}
+
+// ResolveCategory - Kategoriyi slug'ı veya herhangi bir dildeki adıyla bulur
+// "News", "news" ve "Haberler" aynı kategoriye çözümlenir.
+func (r *Repository) ResolveCategory(ctx context.Context, value string) (types.ContentCategory, error) {
+	defer utils.TimeTrack(time.Now(), "Repository -> ResolveCategory")
+
+	query := categorySelect + `
+		WHERE cc.slug = $1
+		   OR EXISTS (
+				SELECT 1 FROM content_category_translations t
+				WHERE t.category_id = cc.id AND LOWER(t.name) = LOWER($2)
+		   )
+		ORDER BY (cc.slug = $1) DESC
+		LIMIT 1
+	`
+
+	value = strings.TrimSpace(value)
+	category, err := scanCategory(r.db.QueryRowContext(ctx, query, utils.Slugify(value), value))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return category, fmt.Errorf("'%s' kategorisi bulunamadı", value)
+		}
+		return category, err
+	}
+
+	return category, nil
+}
+
+// CreateCategory - Yeni kategori ve çevirilerini oluşturur
+func (r *Repository) CreateCategory(ctx context.Context, input types.ContentCategoryInput) (types.ContentCategory, error) {
+	defer utils.TimeTrack(time.Now(), "Repository -> CreateCategory")
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return types.ContentCategory{}, fmt.Errorf("transaction başlatılamadı: %w", err)
+	}
+	defer tx.Rollback()
+
+	slug := categorySlug(input)
+	if slug == "" {
+		return types.ContentCategory{}, fmt.Errorf("kategori için geçerli bir slug üretilemedi")
+	}
+
+	var id uuid.UUID
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO content_categories (slug, parent_id, sort_order)
+		VALUES ($1, $2, $3)
+		RETURNING id
+	`, slug, input.ParentID, input.SortOrder).Scan(&id)
+	if err != nil {
+		return types.ContentCategory{}, categoryError(err, slug)
+	}
+
+	if err := replaceCategoryTranslationsTx(ctx, tx, id, input.Translations); err != nil {
+		return types.ContentCategory{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return types.ContentCategory{}, fmt.Errorf("transaction commit hatası: %w", err)
+	}
+
+	return r.GetCategory(ctx, id)
+}
+
+// UpdateCategory - Kategoriyi ve çevirilerini günceller
+// Slug değişirse bağlı içeriklerin category alanı da yeni slug'a taşınır.
+func (r *Repository) UpdateCategory(ctx context.Context, id uuid.UUID, input types.ContentCategoryInput) (types.ContentCategory, error) {
+	defer utils.TimeTrack(time.Now(), "Repository -> UpdateCategory")
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return types.ContentCategory{}, fmt.Errorf("transaction başlatılamadı: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Yeni üst kategori bu kategorinin altında olmamalı
+	if input.ParentID != nil {
+		var cycle bool
+		err = tx.QueryRowContext(ctx, `
+			WITH RECURSIVE tree AS (
+				SELECT id FROM content_categories WHERE id = $1
+				UNION ALL
+				SELECT cc.id FROM content_categories cc INNER JOIN tree ON cc.parent_id = tree.id
+			)
+			SELECT EXISTS (SELECT 1 FROM tree WHERE id = $2)
+		`, id, *input.ParentID).Scan(&cycle)
+		if err != nil {
+			return types.ContentCategory{}, fmt.Errorf("kategori hiyerarşisi kontrol edilemedi: %w", err)
+		}
+		if cycle {
+			return types.ContentCategory{}, fmt.Errorf("kategori kendi alt kategorisinin altına taşınamaz")
+		}
+	}
+
+	slug := categorySlug(input)
+	if slug == "" {
+		return types.ContentCategory{}, fmt.Errorf("kategori için geçerli bir slug üretilemedi")
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE content_categories
+		SET slug = $1, parent_id = $2, sort_order = $3, updated_at = NOW()
+		WHERE id = $4
+	`, slug, input.ParentID, input.SortOrder, id)
+	if err != nil {
+		return types.ContentCategory{}, categoryError(err, slug)
+	}
+
+	affected, _ := result.RowsAffected()
+	if affected == 0 {
+		return types.ContentCategory{}, fmt.Errorf("kategori bulunamadı (ID: %s)", id)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE contents SET category = $1 WHERE category_id = $2 AND category <> $1",
+		slug, id)
+	if err != nil {
+		return types.ContentCategory{}, fmt.Errorf("içerik kategorileri güncellenemedi: %w", err)
+	}
+
+	if err := replaceCategoryTranslationsTx(ctx, tx, id, input.Translations); err != nil {
+		return types.ContentCategory{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return types.ContentCategory{}, fmt.Errorf("transaction commit hatası: %w", err)
+	}
+
+	return r.GetCategory(ctx, id)
+}
+
+// DeleteCategory - Alt kategorisi ve bağlı içeriği olmayan kategoriyi siler
+func (r *Repository) DeleteCategory(ctx context.Context, id uuid.UUID) error {
+	defer utils.TimeTrack(time.Now(), "Repository -> DeleteCategory")
+
+	var children, contents int
+	err := r.db.QueryRowContext(ctx, `
+		SELECT
+			(SELECT COUNT(*) FROM content_categories WHERE parent_id = $1),
+			(SELECT COUNT(*) FROM contents WHERE category_id = $1 AND status != $2)
+	`, id, types.ContentStatusDeleted).Scan(&children, &contents)
+	if err != nil {
+		return fmt.Errorf("kategori kullanımı kontrol edilemedi: %w", err)
+	}
+
+	if children > 0 {
+		return fmt.Errorf("kategorinin %d alt kategorisi var, önce onları taşıyın veya silin", children)
+	}
+	if contents > 0 {
+		return fmt.Errorf("kategoride %d içerik var, önce içerikleri başka kategoriye taşıyın", contents)
+	}
+
+	result, err := r.db.ExecContext(ctx, "DELETE FROM content_categories WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("kategori silinemedi: %w", err)
+	}
+
+	affected, _ := result.RowsAffected()
+	if affected == 0 {
+		return fmt.Errorf("kategori bulunamadı (ID: %s)", id)
+	}
+
+	return nil
+}
+
+// replaceCategoryTranslationsTx - Kategorinin çevirilerini verilenlerle değiştirir
+func replaceCategoryTranslationsTx(ctx context.Context, tx *sql.Tx, id uuid.UUID, translations []types.ContentCategoryTranslation) error {
+	if _, err := tx.ExecContext(ctx, "DELETE FROM content_category_translations WHERE category_id = $1", id); err != nil {
+		return fmt.Errorf("kategori çevirileri temizlenemedi: %w", err)
+	}
+
+	for _, t := range translations {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO content_category_translations (category_id, language, name, description)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (category_id, language) DO UPDATE SET name = EXCLUDED.name, description = EXCLUDED.description
+		`, id, t.Language, strings.TrimSpace(t.Name), t.Description)
+		if err != nil {
+			return fmt.Errorf("kategori çevirisi kaydedilemedi: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// categorySlug - Slug verilmemişse ilk çevirinin adından üretir
+func categorySlug(input types.ContentCategoryInput) string {
+	if input.Slug != "" {
+		return utils.Slugify(input.Slug)
+	}
+	return utils.Slugify(input.Translations[0].Name)
+}
+
+// categoryError - Kategori yazma hatalarını okunur mesaja çevirir
+func categoryError(err error, slug string) error {
+	if pgErr, ok := err.(*pq.Error); ok {
+		switch pgErr.Code {
+		case "23505":
+			return fmt.Errorf("'%s' kategori slug'ı zaten kullanımda", slug)
+		case "23503":
+			return fmt.Errorf("üst kategori bulunamadı")
+		case "23514":
+			return fmt.Errorf("kategori kendi üst kategorisi olamaz")
+		}
+	}
+	return fmt.Errorf("kategori kaydedilemedi: %w", err)
+}
+
+// scanCategory - Tek satırı ContentCategory struct'ına dönüştürür
+func scanCategory(scanner interface{ Scan(dest ...any) error }) (types.ContentCategory, error) {
+	var category types.ContentCategory
+	var translations []byte
+
+	err := scanner.Scan(
+		&category.ID,
+		&category.Slug,
+		&category.ParentID,
+		&category.SortOrder,
+		&category.CreatedAt,
+		&category.UpdatedAt,
+		&translations,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return category, err
+		}
+		return category, fmt.Errorf("kategori okunamadı: %w", err)
+	}
+
+	if err := json.Unmarshal(translations, &category.Translations); err != nil {
+		return category, fmt.Errorf("kategori çevirileri çözümlenemedi: %w", err)
+	}
+
+	if len(category.Translations) > 0 {
+		category.Name = category.Translations[0].Name
+	} else {
+		category.Name = category.Slug
+	}
+
+	return category, nil
+}
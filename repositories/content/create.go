@@ -47,13 +47,16 @@ func (r *Repository) CreateContent(ctx context.Context, input types.ContentInput
 	query := `
 		INSERT INTO contents (
 			user_id, slug, identifier, language, title, description,
-			category, image_url, details_json, content_json, content_html, status
+			category, image_url, details_json, content_json, content_html, status, category_id,
+			source_language
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12,
+			(SELECT id FROM content_categories WHERE slug = $7), NULLIF($13, '')
 		) RETURNING
 			id, user_id, slug, identifier, language, title, description,
 			category, image_url, details_json, content_json, content_html,
-			status, created_at, updated_at
+			status, created_at, updated_at,
+			publish_at, unpublish_at, scheduled_by, scheduled_at, version, category_id
 	`
 
 	// Sorguyu çalıştır
@@ -70,6 +73,7 @@ func (r *Repository) CreateContent(ctx context.Context, input types.ContentInput
 		string(contentJSONBytes),
 		input.ContentHTML,
 		status,
+		input.SourceLanguage,
 	).Scan(
 		&content.ID,
 		&content.UserID,
@@ -86,6 +90,12 @@ func (r *Repository) CreateContent(ctx context.Context, input types.ContentInput
 		&content.Status,
 		&content.CreatedAt,
 		&content.UpdatedAt,
+		&content.PublishAt,
+		&content.UnpublishAt,
+		&content.ScheduledBy,
+		&content.ScheduledAt,
+		&content.Version,
+		&content.CategoryID,
 	)
 
 	if err != nil {
@@ -104,10 +114,26 @@ func (r *Repository) CreateContent(ctx context.Context, input types.ContentInput
 		return content, fmt.Errorf("içerik oluşturulamadı: %w", err)
 	}
 
+	// Etiketler
+	if len(input.Tags) > 0 {
+		if err := setContentTagsTx(ctx, tx, content.ID, input.Tags); err != nil {
+			return content, err
+		}
+	}
+
+	// İlk revizyon
+	if err := insertRevisionTx(ctx, tx, content.ID, userID, input.ChangeNote, 0); err != nil {
+		return content, err
+	}
+
 	// Transaction'ı commit et
 	if err = tx.Commit(); err != nil {
 		return content, fmt.Errorf("transaction commit hatası: %w", err)
 	}
 
+	if err := r.loadTag(ctx, &content); err != nil {
+		return content, err
+	}
+
 	return content, nil
 }
@@ -0,0 +1,100 @@
+package ContentRepository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// GetContentFacets - Listeleme filtrelerine uyan içeriklerin kategori ve etiket sayılarını getirir
+// Kategori sayıları seçili kategori filtresi hariç tutularak hesaplanır ve üst kategorilere toplanır;
+// böylece kullanıcı bir kategori seçtiğinde diğer seçenekleri de sayılarıyla görmeye devam eder.
+func (r *Repository) GetContentFacets(ctx context.Context, params types.ContentSearchParams) (types.ContentFacets, error) {
+	defer utils.TimeTrack(time.Now(), "Repository -> GetContentFacets")
+
+	facets := types.ContentFacets{
+		Categories: []types.ContentCategoryFacet{},
+		Tags:       []types.ContentTagFacet{},
+	}
+
+	// Kategori facet'leri
+	whereClauses, args := contentFilters(params, true)
+	args = append(args, params.Language)
+
+	categoryQuery := fmt.Sprintf(`
+		WITH RECURSIVE matched AS (
+			SELECT category_id FROM contents
+			WHERE %s AND category_id IS NOT NULL
+		),
+		ancestry AS (
+			SELECT m.category_id AS counted_id, cc.id, cc.parent_id
+			FROM matched m
+			INNER JOIN content_categories cc ON cc.id = m.category_id
+			UNION ALL
+			SELECT a.counted_id, cc.id, cc.parent_id
+			FROM ancestry a
+			INNER JOIN content_categories cc ON cc.id = a.parent_id
+		)
+		SELECT cc.id, cc.slug, cc.parent_id, COALESCE(n.name, cc.slug), COUNT(*)
+		FROM ancestry a
+		INNER JOIN content_categories cc ON cc.id = a.id
+		LEFT JOIN LATERAL (
+			SELECT t.name FROM content_category_translations t
+			WHERE t.category_id = cc.id
+			ORDER BY (t.language = $%d) DESC, t.language
+			LIMIT 1
+		) n ON true
+		GROUP BY cc.id, n.name
+		ORDER BY cc.sort_order, cc.slug
+	`, strings.Join(whereClauses, " AND "), len(args))
+
+	rows, err := r.db.QueryContext(ctx, categoryQuery, args...)
+	if err != nil {
+		return facets, fmt.Errorf("kategori sayıları getirilemedi: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var facet types.ContentCategoryFacet
+		if err := rows.Scan(&facet.ID, &facet.Slug, &facet.ParentID, &facet.Name, &facet.Count); err != nil {
+			return facets, fmt.Errorf("kategori sayısı okunamadı: %w", err)
+		}
+		facets.Categories = append(facets.Categories, facet)
+	}
+	if err := rows.Err(); err != nil {
+		return facets, err
+	}
+
+	// Etiket facet'leri - tüm filtreler uygulanır
+	whereClauses, args = contentFilters(params, false)
+
+	tagQuery := fmt.Sprintf(`
+		SELECT t.slug, t.name, COUNT(*) AS total
+		FROM content_tag_links l
+		INNER JOIN content_tags t ON t.id = l.tag_id
+		WHERE l.content_id IN (SELECT id FROM contents WHERE %s)
+		GROUP BY t.id
+		ORDER BY total DESC, t.name
+		LIMIT 50
+	`, strings.Join(whereClauses, " AND "))
+
+	tagRows, err := r.db.QueryContext(ctx, tagQuery, args...)
+	if err != nil {
+		return facets, fmt.Errorf("etiket sayıları getirilemedi: %w", err)
+	}
+	defer tagRows.Close()
+
+	for tagRows.Next() {
+		var facet types.ContentTagFacet
+		if err := tagRows.Scan(&facet.Slug, &facet.Name, &facet.Count); err != nil {
+			return facets, fmt.Errorf("etiket sayısı okunamadı: %w", err)
+		}
+		facets.Tags = append(facets.Tags, facet)
+	}
+
+	return facets, tagRows.Err()
+}
@@ -0,0 +1,32 @@
+package ContentRepository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// GetListState - Filtreye uyan içeriklerin sayısını ve en son güncellenme zamanını getirir
+// Feed'lerde koşullu GET (ETag / Last-Modified) için kullanılır; içerik eklenince, silinince veya düzenlenince değişir.
+func (r *Repository) GetListState(ctx context.Context, params types.ContentSearchParams) (int, time.Time, error) {
+	defer utils.TimeTrack(time.Now(), "Repository -> GetListState")
+
+	whereClauses, args := contentFilters(params, false)
+
+	var count int
+	var lastModified sql.NullTime
+	err := r.db.QueryRowContext(ctx,
+		"SELECT COUNT(*), MAX(updated_at) FROM contents WHERE "+strings.Join(whereClauses, " AND "),
+		args...,
+	).Scan(&count, &lastModified)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("içerik listesi durumu getirilemedi: %w", err)
+	}
+
+	return count, lastModified.Time, nil
+}
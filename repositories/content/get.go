@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/okanay/backend-holding/types"
 	"github.com/okanay/backend-holding/utils"
 )
@@ -22,7 +23,8 @@ func (r *Repository) GetContentByID(ctx context.Context, id uuid.UUID) (types.Co
 		SELECT
 			id, user_id, slug, identifier, language, title, description,
 			category, image_url, details_json, content_json, content_html,
-			status, created_at, updated_at
+			status, created_at, updated_at,
+			publish_at, unpublish_at, scheduled_by, scheduled_at, version, category_id
 		FROM contents
 		WHERE id = $1 AND status != $2
 		LIMIT 1
@@ -44,6 +46,12 @@ func (r *Repository) GetContentByID(ctx context.Context, id uuid.UUID) (types.Co
 		&content.Status,
 		&content.CreatedAt,
 		&content.UpdatedAt,
+		&content.PublishAt,
+		&content.UnpublishAt,
+		&content.ScheduledBy,
+		&content.ScheduledAt,
+		&content.Version,
+		&content.CategoryID,
 	)
 
 	if err != nil {
@@ -53,9 +61,32 @@ func (r *Repository) GetContentByID(ctx context.Context, id uuid.UUID) (types.Co
 		return content, fmt.Errorf("içerik getirilemedi: %w", err)
 	}
 
+	if err := r.loadTag(ctx, &content); err != nil {
+		return content, err
+	}
+
 	return content, nil
 }
 
+// GetContentVersion - İçeriğin güncel sürüm numarasını getirir (ETag kontrolü için)
+func (r *Repository) GetContentVersion(ctx context.Context, id uuid.UUID) (int, error) {
+	defer utils.TimeTrack(time.Now(), "Repository -> GetContentVersion")
+
+	var version int
+	err := r.db.QueryRowContext(ctx,
+		"SELECT version FROM contents WHERE id = $1 AND status != $2",
+		id, types.ContentStatusDeleted).Scan(&version)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, fmt.Errorf("içerik bulunamadı (ID: %s)", id)
+		}
+		return 0, fmt.Errorf("içerik sürümü getirilemedi: %w", err)
+	}
+
+	return version, nil
+}
+
 // GetContentBySlug - Slug'a göre tüm dillerdeki içerikleri getirir
 func (r *Repository) GetContentBySlug(ctx context.Context, slug string) ([]types.Content, error) {
 	defer utils.TimeTrack(time.Now(), "Repository -> GetContentBySlug")
@@ -82,7 +113,13 @@ func (r *Repository) GetContentBySlug(ctx context.Context, slug string) ([]types
 			c.content_html,
 			c.status,
 			c.created_at,
-			c.updated_at
+			c.updated_at,
+			c.publish_at,
+			c.unpublish_at,
+			c.scheduled_by,
+			c.scheduled_at,
+			c.version,
+			c.category_id
 		FROM contents c
 		INNER JOIN target_content tc ON c.identifier = tc.identifier
 		WHERE c.status = $2
@@ -100,6 +137,10 @@ func (r *Repository) GetContentBySlug(ctx context.Context, slug string) ([]types
 		return nil, err
 	}
 
+	if err := r.loadTags(ctx, contents); err != nil {
+		return nil, err
+	}
+
 	return contents, nil
 }
 
@@ -112,60 +153,14 @@ func (r *Repository) ListContents(ctx context.Context, params types.ContentSearc
 		SELECT
 			id, user_id, slug, identifier, language, title, description,
 			category, image_url, details_json, content_json, content_html,
-			status, created_at, updated_at
+			status, created_at, updated_at,
+			publish_at, unpublish_at, scheduled_by, scheduled_at, version, category_id
 		FROM contents
 	`
 	countQuery := `SELECT COUNT(*) FROM contents`
 
 	// Where clauses
-	var whereClauses []string
-	var args []any
-	paramIndex := 1
-
-	// Status filtresi
-	if params.Status != "" {
-		whereClauses = append(whereClauses, fmt.Sprintf("status = $%d", paramIndex))
-		args = append(args, params.Status)
-		paramIndex++
-	} else {
-		whereClauses = append(whereClauses, fmt.Sprintf("status != $%d", paramIndex))
-		args = append(args, types.ContentStatusDeleted)
-		paramIndex++
-	}
-
-	// Diğer filtreler
-	if params.Language != "" {
-		whereClauses = append(whereClauses, fmt.Sprintf("language = $%d", paramIndex))
-		args = append(args, params.Language)
-		paramIndex++
-	}
-
-	if params.Identifier != "" {
-		whereClauses = append(whereClauses, fmt.Sprintf("identifier = $%d", paramIndex))
-		args = append(args, params.Identifier)
-		paramIndex++
-	}
-
-	if params.Category != "" {
-		whereClauses = append(whereClauses, fmt.Sprintf("category = $%d", paramIndex))
-		args = append(args, params.Category)
-		paramIndex++
-	}
-
-	if params.UserID != "" {
-		whereClauses = append(whereClauses, fmt.Sprintf("user_id = $%d", paramIndex))
-		args = append(args, params.UserID)
-		paramIndex++
-	}
-
-	// Arama
-	if params.Query != "" {
-		searchQuery := "%" + strings.ToLower(params.Query) + "%"
-		whereClauses = append(whereClauses,
-			fmt.Sprintf("(LOWER(title) LIKE $%d OR LOWER(description) LIKE $%d)", paramIndex, paramIndex+1))
-		args = append(args, searchQuery, searchQuery)
-		paramIndex += 2
-	}
+	whereClauses, args := contentFilters(params, false)
 
 	// WHERE clause birleştir
 	whereClause := ""
@@ -179,6 +174,7 @@ func (r *Repository) ListContents(ctx context.Context, params types.ContentSearc
 		allowedSorts := map[string]bool{
 			"created_at": true, "updated_at": true, "title": true,
 			"status": true, "language": true, "category": true,
+			"publish_at": true, "unpublish_at": true,
 		}
 		if allowedSorts[params.SortBy] {
 			orderBy = params.SortBy
@@ -190,6 +186,14 @@ func (r *Repository) ListContents(ctx context.Context, params types.ContentSearc
 		sortOrder = "ASC"
 	}
 
+	// Alaka sıralaması - arama sorgusu ana sorguya ayrı parametrelerle eklenir, sayım sorgusu etkilenmez
+	listArgs := args
+	if params.SortBy == "relevance" && params.Query != "" {
+		search := contentTSQuery(params.Language, params.Query, len(args)+1)
+		orderBy = fmt.Sprintf("ts_rank_cd(search_vector, %s) DESC, created_at", search.Query)
+		listArgs = append(append([]any{}, args...), search.Args...)
+	}
+
 	// Sayfalama
 	limit := 10
 	if params.Limit > 0 && params.Limit <= 100 {
@@ -217,7 +221,7 @@ func (r *Repository) ListContents(ctx context.Context, params types.ContentSearc
 	fullQuery := fmt.Sprintf("%s%s ORDER BY %s %s LIMIT %d OFFSET %d",
 		baseQuery, whereClause, orderBy, sortOrder, limit, offset)
 
-	rows, err := r.db.QueryContext(ctx, fullQuery, args...)
+	rows, err := r.db.QueryContext(ctx, fullQuery, listArgs...)
 	if err != nil {
 		return nil, 0, fmt.Errorf("listeleme hatası: %w", err)
 	}
@@ -228,9 +232,94 @@ func (r *Repository) ListContents(ctx context.Context, params types.ContentSearc
 		return nil, 0, err
 	}
 
+	if err := r.loadTags(ctx, contents); err != nil {
+		return nil, 0, err
+	}
+
 	return contents, total, nil
 }
 
+// contentFilters - Listeleme ve facet sorgularında ortak WHERE koşullarını üretir
+// skipCategory kategori facet'lerinde seçili kategorinin kardeşlerinin de sayılabilmesi içindir.
+func contentFilters(params types.ContentSearchParams, skipCategory bool) ([]string, []any) {
+	var whereClauses []string
+	var args []any
+	paramIndex := 1
+
+	// Status filtresi
+	if params.Status != "" {
+		whereClauses = append(whereClauses, fmt.Sprintf("status = $%d", paramIndex))
+		args = append(args, params.Status)
+		paramIndex++
+	} else {
+		whereClauses = append(whereClauses, fmt.Sprintf("status != $%d", paramIndex))
+		args = append(args, types.ContentStatusDeleted)
+		paramIndex++
+	}
+
+	// Diğer filtreler
+	if params.Language != "" {
+		whereClauses = append(whereClauses, fmt.Sprintf("language = $%d", paramIndex))
+		args = append(args, params.Language)
+		paramIndex++
+	}
+
+	if params.Identifier != "" {
+		whereClauses = append(whereClauses, fmt.Sprintf("identifier = $%d", paramIndex))
+		args = append(args, params.Identifier)
+		paramIndex++
+	}
+
+	// Kategori filtresi alt kategorileri de kapsar
+	if params.Category != "" && !skipCategory {
+		whereClauses = append(whereClauses, fmt.Sprintf(`category_id IN (
+			WITH RECURSIVE tree AS (
+				SELECT id FROM content_categories WHERE slug = $%d
+				UNION ALL
+				SELECT cc.id FROM content_categories cc INNER JOIN tree ON cc.parent_id = tree.id
+			)
+			SELECT id FROM tree
+		)`, paramIndex))
+		args = append(args, params.Category)
+		paramIndex++
+	}
+
+	// Etiket filtresi - içerik verilen etiketlerin hepsine sahip olmalı
+	if len(params.Tags) > 0 {
+		whereClauses = append(whereClauses, fmt.Sprintf(`id IN (
+			SELECT l.content_id
+			FROM content_tag_links l
+			INNER JOIN content_tags t ON t.id = l.tag_id
+			WHERE t.slug = ANY($%d)
+			GROUP BY l.content_id
+			HAVING COUNT(DISTINCT t.id) = $%d
+		)`, paramIndex, paramIndex+1))
+		args = append(args, pq.Array(params.Tags), len(params.Tags))
+		paramIndex += 2
+	}
+
+	if params.UserID != "" {
+		whereClauses = append(whereClauses, fmt.Sprintf("user_id = $%d", paramIndex))
+		args = append(args, params.UserID)
+		paramIndex++
+	}
+
+	// Zamanlanmış içerikler
+	if params.Scheduled {
+		whereClauses = append(whereClauses, "(publish_at IS NOT NULL OR unpublish_at IS NOT NULL)")
+	}
+
+	// Tam metin arama
+	if params.Query != "" {
+		search := contentTSQuery(params.Language, params.Query, paramIndex)
+		whereClauses = append(whereClauses, search.Match)
+		args = append(args, search.Args...)
+		paramIndex += len(search.Args)
+	}
+
+	return whereClauses, args
+}
+
 // scanContent - Tek bir satırı Content struct'ına dönüştürür
 func scanContent(scanner interface{ Scan(dest ...any) error }) (types.Content, error) {
 	var content types.Content
@@ -251,6 +340,12 @@ func scanContent(scanner interface{ Scan(dest ...any) error }) (types.Content, e
 		&content.Status,
 		&content.CreatedAt,
 		&content.UpdatedAt,
+		&content.PublishAt,
+		&content.UnpublishAt,
+		&content.ScheduledBy,
+		&content.ScheduledAt,
+		&content.Version,
+		&content.CategoryID,
 	)
 
 	return content, err
@@ -0,0 +1,205 @@
+package ContentRepository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// setTransitionContext - Aynı transaction'daki durum geçişinin kaydına işlemi yapanı, aksiyonu ve yorumu ekler
+// Değerler log_content_status_transition trigger'ı tarafından okunur ve transaction bitince sıfırlanır.
+func setTransitionContext(ctx context.Context, tx *sql.Tx, actorID uuid.UUID, action types.ContentTransitionAction, comment string) error {
+	actor := ""
+	if actorID != uuid.Nil {
+		actor = actorID.String()
+	}
+
+	_, err := tx.ExecContext(ctx, `
+		SELECT set_config('app.transition_actor', $1, true),
+			set_config('app.transition_action', $2, true),
+			set_config('app.transition_comment', $3, true)
+	`, actor, string(action), comment)
+	if err != nil {
+		return fmt.Errorf("durum geçişi bilgisi ayarlanamadı: %w", err)
+	}
+
+	return nil
+}
+
+// transitionContent - İçeriği from durumundan to durumuna geçirir ve geçişi kaydeder
+// ownerID verilirse sadece içeriğin sahibi geçişi yapabilir.
+func (r *Repository) transitionContent(ctx context.Context, contentID uuid.UUID, from, to types.ContentStatus, action types.ContentTransitionAction, actorID uuid.UUID, comment string, ownerID *uuid.UUID) (types.Content, error) {
+	var content types.Content
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return content, fmt.Errorf("transaction başlatılamadı: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := setTransitionContext(ctx, tx, actorID, action, comment); err != nil {
+		return content, err
+	}
+
+	// Taslağa dönen içeriğin zamanlanmış yayını iptal edilir, değişen metin onaysız yayına çıkmasın
+	query := `
+		UPDATE contents
+		SET status = $1,
+			publish_at = CASE WHEN $5 THEN NULL ELSE publish_at END,
+			updated_at = NOW()
+		WHERE id = $2 AND status = $3 AND ($4::uuid IS NULL OR user_id = $4)
+		RETURNING
+			id, user_id, slug, identifier, language, title, description,
+			category, image_url, details_json, content_json, content_html,
+			status, created_at, updated_at,
+			publish_at, unpublish_at, scheduled_by, scheduled_at, version, category_id
+	`
+
+	content, err = scanContent(tx.QueryRowContext(ctx, query, to, contentID, from, ownerID, to == types.ContentStatusDraft))
+	if err != nil {
+		if err != sql.ErrNoRows {
+			return content, fmt.Errorf("içerik durumu güncellenemedi: %w", err)
+		}
+
+		// Geçişin neden yapılamadığını ayırt et
+		var current types.ContentStatus
+		var owner *uuid.UUID
+		err = tx.QueryRowContext(ctx,
+			"SELECT status, user_id FROM contents WHERE id = $1 AND status != $2",
+			contentID, types.ContentStatusDeleted).Scan(&current, &owner)
+		if err == sql.ErrNoRows {
+			return content, fmt.Errorf("içerik bulunamadı (ID: %s)", contentID)
+		}
+		if err != nil {
+			return content, fmt.Errorf("içerik durumu kontrol edilemedi: %w", err)
+		}
+		if current != from {
+			return content, fmt.Errorf("içerik '%s' durumunda değil (mevcut durum: %s)", from, current)
+		}
+		return content, fmt.Errorf("içerik bulunamadı veya yetkiniz yok (ID: %s)", contentID)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return content, fmt.Errorf("transaction commit hatası: %w", err)
+	}
+
+	return content, nil
+}
+
+// SubmitForReview - Taslak içeriği incelemeye gönderir (sadece içeriğin sahibi)
+func (r *Repository) SubmitForReview(ctx context.Context, contentID, userID uuid.UUID, comment string) (types.Content, error) {
+	defer utils.TimeTrack(time.Now(), "Repository -> SubmitForReview")
+
+	return r.transitionContent(ctx, contentID, types.ContentStatusDraft, types.ContentStatusInReview, types.ContentActionSubmit, userID, comment, &userID)
+}
+
+// ApproveReview - İncelemedeki içeriği onaylayıp yayınlar
+func (r *Repository) ApproveReview(ctx context.Context, contentID, reviewerID uuid.UUID, comment string) (types.Content, error) {
+	defer utils.TimeTrack(time.Now(), "Repository -> ApproveReview")
+
+	return r.transitionContent(ctx, contentID, types.ContentStatusInReview, types.ContentStatusPublished, types.ContentActionApprove, reviewerID, comment, nil)
+}
+
+// RequestChanges - İncelemedeki içeriği yorumla birlikte taslağa geri gönderir, zamanlanmış yayını iptal edilir
+func (r *Repository) RequestChanges(ctx context.Context, contentID, reviewerID uuid.UUID, comment string) (types.Content, error) {
+	defer utils.TimeTrack(time.Now(), "Repository -> RequestChanges")
+
+	return r.transitionContent(ctx, contentID, types.ContentStatusInReview, types.ContentStatusDraft, types.ContentActionRequestChanges, reviewerID, comment, nil)
+}
+
+// ListReviewQueue - İnceleme bekleyen içerikleri en eski gönderimden başlayarak listeler
+func (r *Repository) ListReviewQueue(ctx context.Context) ([]types.ContentReviewItem, error) {
+	defer utils.TimeTrack(time.Now(), "Repository -> ListReviewQueue")
+
+	query := `
+		SELECT
+			c.id, c.title, c.slug, c.language, c.category,
+			t.actor_id, COALESCE(u.username, ''), COALESCE(t.comment, ''),
+			COALESCE(t.created_at, c.updated_at) AS submitted_at
+		FROM contents c
+		LEFT JOIN LATERAL (
+			SELECT actor_id, comment, created_at
+			FROM content_status_transitions
+			WHERE content_id = c.id AND to_status = 'in_review'
+			ORDER BY created_at DESC
+			LIMIT 1
+		) t ON true
+		LEFT JOIN users u ON u.id = t.actor_id
+		WHERE c.status = $1
+		ORDER BY submitted_at ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, types.ContentStatusInReview)
+	if err != nil {
+		return nil, fmt.Errorf("inceleme kuyruğu getirilemedi: %w", err)
+	}
+	defer rows.Close()
+
+	items := []types.ContentReviewItem{}
+	for rows.Next() {
+		var item types.ContentReviewItem
+		if err := rows.Scan(
+			&item.ContentID,
+			&item.Title,
+			&item.Slug,
+			&item.Language,
+			&item.Category,
+			&item.SubmittedBy,
+			&item.SubmitterName,
+			&item.Comment,
+			&item.SubmittedAt,
+		); err != nil {
+			return nil, fmt.Errorf("satır okuma hatası: %w", err)
+		}
+		items = append(items, item)
+	}
+
+	return items, rows.Err()
+}
+
+// ListTransitions - İçeriğin durum geçişlerini yeniden eskiye listeler
+func (r *Repository) ListTransitions(ctx context.Context, contentID uuid.UUID) ([]types.ContentTransition, error) {
+	defer utils.TimeTrack(time.Now(), "Repository -> ListTransitions")
+
+	query := `
+		SELECT
+			t.id, t.content_id, t.from_status, t.to_status, t.action,
+			t.actor_id, COALESCE(u.username, ''), t.comment, t.created_at
+		FROM content_status_transitions t
+		LEFT JOIN users u ON u.id = t.actor_id
+		WHERE t.content_id = $1
+		ORDER BY t.created_at DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, contentID)
+	if err != nil {
+		return nil, fmt.Errorf("durum geçmişi getirilemedi: %w", err)
+	}
+	defer rows.Close()
+
+	transitions := []types.ContentTransition{}
+	for rows.Next() {
+		var transition types.ContentTransition
+		if err := rows.Scan(
+			&transition.ID,
+			&transition.ContentID,
+			&transition.FromStatus,
+			&transition.ToStatus,
+			&transition.Action,
+			&transition.ActorID,
+			&transition.ActorName,
+			&transition.Comment,
+			&transition.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("satır okuma hatası: %w", err)
+		}
+		transitions = append(transitions, transition)
+	}
+
+	return transitions, rows.Err()
+}
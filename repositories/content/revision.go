@@ -0,0 +1,276 @@
+package ContentRepository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// ErrRevisionCategoryDeleted geri yüklenen revizyonun kategorisi artık mevcut olmadığında döner
+var ErrRevisionCategoryDeleted = errors.New("revizyondaki kategori silinmiş")
+
+// insertRevisionTx - İçeriğin güncel halini etiketleriyle birlikte yeni revizyon olarak kaydeder
+// Etiketler aynı transaction içinde değiştirildiyse çağrı etiketlerden sonra yapılmalıdır.
+// contents satırı aynı transaction içinde güncellendiği (kilitlendiği) için revizyon numaraları çakışmaz.
+func insertRevisionTx(ctx context.Context, tx *sql.Tx, contentID, authorID uuid.UUID, changeNote string, restoredFrom int) error {
+	query := `
+		INSERT INTO content_revisions (
+			content_id, revision, slug, identifier, language, title, description,
+			category, image_url, details_json, content_json, content_html, status,
+			tags, author_id, change_note, restored_from
+		)
+		SELECT
+			c.id,
+			(SELECT COALESCE(MAX(revision), 0) + 1 FROM content_revisions WHERE content_id = c.id),
+			c.slug, c.identifier, c.language, c.title, c.description,
+			c.category, c.image_url, c.details_json, c.content_json, c.content_html, c.status,
+			ARRAY(
+				SELECT t.name
+				FROM content_tag_links l
+				INNER JOIN content_tags t ON t.id = l.tag_id
+				WHERE l.content_id = c.id
+				ORDER BY t.name
+			),
+			$2, $3, $4
+		FROM contents c
+		WHERE c.id = $1
+	`
+
+	_, err := tx.ExecContext(ctx, query,
+		contentID,
+		uuid.NullUUID{UUID: authorID, Valid: authorID != uuid.Nil},
+		changeNote,
+		sql.NullInt64{Int64: int64(restoredFrom), Valid: restoredFrom > 0},
+	)
+	if err != nil {
+		return fmt.Errorf("içerik revizyonu kaydedilemedi: %w", err)
+	}
+
+	return nil
+}
+
+// ListRevisions - İçeriğin revizyonlarını yeniden eskiye listeler (anlık görüntüler olmadan)
+func (r *Repository) ListRevisions(ctx context.Context, contentID uuid.UUID) ([]types.ContentRevision, error) {
+	defer utils.TimeTrack(time.Now(), "Repository -> ListRevisions")
+
+	query := `
+		SELECT
+			r.id, r.content_id, r.revision, r.title, r.status,
+			r.author_id, COALESCE(u.username, ''), r.change_note, r.restored_from, r.created_at
+		FROM content_revisions r
+		LEFT JOIN users u ON r.author_id = u.id
+		WHERE r.content_id = $1
+		ORDER BY r.revision DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, contentID)
+	if err != nil {
+		return nil, fmt.Errorf("revizyonlar getirilemedi: %w", err)
+	}
+	defer rows.Close()
+
+	revisions := []types.ContentRevision{}
+	for rows.Next() {
+		var revision types.ContentRevision
+		err := rows.Scan(
+			&revision.ID,
+			&revision.ContentID,
+			&revision.Revision,
+			&revision.Title,
+			&revision.Status,
+			&revision.AuthorID,
+			&revision.AuthorName,
+			&revision.ChangeNote,
+			&revision.RestoredFrom,
+			&revision.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("revizyon satırı okunamadı: %w", err)
+		}
+		revisions = append(revisions, revision)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("revizyonlar okunurken hata: %w", err)
+	}
+
+	return revisions, nil
+}
+
+// GetRevision - Revizyonu tam anlık görüntüsü ile getirir
+// Revizyon bulunamazsa ID'si uuid.Nil olan boş kayıt döner. Etiketler kaydedilmeden önceki revizyonlarda Snapshot.Tags nil'dir.
+func (r *Repository) GetRevision(ctx context.Context, contentID uuid.UUID, number int) (types.ContentRevision, error) {
+	defer utils.TimeTrack(time.Now(), "Repository -> GetRevision")
+
+	var revision types.ContentRevision
+	var snapshot types.Content
+	var tags pq.StringArray
+
+	query := `
+		SELECT
+			r.id, r.content_id, r.revision, r.title, r.status,
+			r.author_id, COALESCE(u.username, ''), r.change_note, r.restored_from, r.created_at,
+			r.slug, r.identifier, r.language, r.description, r.category, r.image_url,
+			r.details_json, r.content_json, r.content_html, r.tags
+		FROM content_revisions r
+		LEFT JOIN users u ON r.author_id = u.id
+		WHERE r.content_id = $1 AND r.revision = $2
+	`
+
+	err := r.db.QueryRowContext(ctx, query, contentID, number).Scan(
+		&revision.ID,
+		&revision.ContentID,
+		&revision.Revision,
+		&revision.Title,
+		&revision.Status,
+		&revision.AuthorID,
+		&revision.AuthorName,
+		&revision.ChangeNote,
+		&revision.RestoredFrom,
+		&revision.CreatedAt,
+		&snapshot.Slug,
+		&snapshot.Identifier,
+		&snapshot.Language,
+		&snapshot.Description,
+		&snapshot.Category,
+		&snapshot.ImageURL,
+		&snapshot.DetailsJSON,
+		&snapshot.ContentJSON,
+		&snapshot.ContentHTML,
+		&tags,
+	)
+	if err == sql.ErrNoRows {
+		return types.ContentRevision{}, nil
+	}
+	if err != nil {
+		return revision, fmt.Errorf("revizyon getirilemedi: %w", err)
+	}
+
+	snapshot.ID = revision.ContentID
+	snapshot.Title = revision.Title
+	snapshot.Status = types.ContentStatus(revision.Status)
+	if tags != nil {
+		snapshot.Tags = make([]types.ContentTag, len(tags))
+		for i, name := range tags {
+			snapshot.Tags[i] = types.ContentTag{Slug: utils.Slugify(name), Name: name}
+		}
+	}
+	revision.Snapshot = &snapshot
+
+	return revision, nil
+}
+
+// RestoreRevision - Revizyonun başlık, slug, açıklama, kategori, etiket ve gövde alanlarını içeriğe geri yükler ve yeni revizyon oluşturur
+// snapshot, handler tarafından temizlenmiş ve kategorisi slug'a çözümlenmiş revizyon anlık görüntüsüdür. Durum, dil ve identifier değişmez;
+// sürüm ve yayın yetkisi kontrolleri UpdateContent ile aynıdır. Kategori silinmişse ErrRevisionCategoryDeleted döner,
+// snapshot.Tags nil ise (etiketsiz kaydedilmiş eski revizyon) içeriğin güncel etiketleri korunur.
+func (r *Repository) RestoreRevision(ctx context.Context, contentID uuid.UUID, number int, snapshot types.Content, changeNote string, userID uuid.UUID, expectedVersion int, canPublish bool) (types.Content, error) {
+	defer utils.TimeTrack(time.Now(), "Repository -> RestoreRevision")
+
+	var content types.Content
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return content, fmt.Errorf("transaction başlatılamadı: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Kategori geri yükleme sırasında silinmesin diye kilitlenir
+	var categoryID uuid.NullUUID
+	if snapshot.Category != "" {
+		err := tx.QueryRowContext(ctx, "SELECT id FROM content_categories WHERE slug = $1 FOR SHARE", snapshot.Category).Scan(&categoryID)
+		if err == sql.ErrNoRows {
+			return content, ErrRevisionCategoryDeleted
+		}
+		if err != nil {
+			return content, fmt.Errorf("revizyon kategorisi kontrol edilemedi: %w", err)
+		}
+	}
+
+	query := `
+		UPDATE contents
+		SET
+			slug = $1,
+			title = $2,
+			description = $3,
+			category = $4,
+			category_id = $15,
+			image_url = $5,
+			details_json = $6,
+			content_json = $7,
+			content_html = $8,
+			publish_at = CASE WHEN $13 THEN publish_at ELSE NULL END,
+			updated_at = NOW()
+		WHERE id = $9 AND user_id = $10 AND status != $11
+			AND ($12 = 0 OR version = $12) AND ($13 OR status != $14)
+		RETURNING
+			id, user_id, slug, identifier, language, title, description,
+			category, image_url, details_json, content_json, content_html,
+			status, created_at, updated_at,
+			publish_at, unpublish_at, scheduled_by, scheduled_at, version, category_id
+	`
+
+	content, err = scanContent(tx.QueryRowContext(ctx, query,
+		snapshot.Slug,
+		snapshot.Title,
+		snapshot.Description,
+		snapshot.Category,
+		snapshot.ImageURL,
+		snapshot.DetailsJSON,
+		snapshot.ContentJSON,
+		snapshot.ContentHTML,
+		contentID,
+		userID,
+		types.ContentStatusDeleted,
+		expectedVersion,
+		canPublish,
+		types.ContentStatusPublished,
+		categoryID,
+	))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			if blocked := updateBlockedError(ctx, tx, contentID, userID, expectedVersion, canPublish); blocked != nil {
+				return content, blocked
+			}
+			return content, fmt.Errorf("içerik bulunamadı, yetkiniz yok veya silinmiş (ID: %s)", contentID)
+		}
+		if pgErr, ok := err.(*pq.Error); ok && pgErr.Code == "23505" && pgErr.Constraint == "uq_slug_language" {
+			return content, fmt.Errorf("revizyondaki URL bu dilde başka bir içerik tarafından kullanılıyor")
+		}
+		return content, fmt.Errorf("revizyon geri yüklenemedi: %w", err)
+	}
+
+	if snapshot.Tags != nil {
+		names := make([]string, len(snapshot.Tags))
+		for i, tag := range snapshot.Tags {
+			names[i] = tag.Name
+		}
+		if err := setContentTagsTx(ctx, tx, contentID, names); err != nil {
+			return content, err
+		}
+	}
+
+	if changeNote == "" {
+		changeNote = fmt.Sprintf("Revizyon %d geri yüklendi", number)
+	}
+	if err := insertRevisionTx(ctx, tx, contentID, userID, changeNote, number); err != nil {
+		return content, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return content, fmt.Errorf("transaction commit hatası: %w", err)
+	}
+
+	if err := r.loadTag(ctx, &content); err != nil {
+		return content, err
+	}
+
+	return content, nil
+}
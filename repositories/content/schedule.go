@@ -0,0 +1,118 @@
+package ContentRepository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// ScheduleContent - İçeriğin zamanlanmış yayın/kapatma zamanlarını ve zamanlamayı yapan kullanıcıyı kaydeder
+// Yayın zamanı sadece taslak veya kapalı içeriklere ayarlanabilir; incelemedeki içerik önce onaylanmalıdır.
+func (r *Repository) ScheduleContent(ctx context.Context, contentID uuid.UUID, input types.ScheduleInput, userID uuid.UUID) error {
+	defer utils.TimeTrack(time.Now(), "Repository -> ScheduleContent")
+
+	// Her iki alan da boşsa zamanlama tamamen kaldırılır
+	query := `
+		UPDATE contents
+		SET publish_at = $1,
+			unpublish_at = $2,
+			scheduled_by = CASE WHEN $1::timestamptz IS NULL AND $2::timestamptz IS NULL THEN NULL ELSE $3::uuid END,
+			scheduled_at = CASE WHEN $1::timestamptz IS NULL AND $2::timestamptz IS NULL THEN NULL ELSE NOW() END,
+			updated_at = NOW()
+		WHERE id = $4 AND status != 'deleted'
+			AND ($1::timestamptz IS NULL OR status IN ('draft', 'closed'))
+	`
+
+	result, err := r.db.ExecContext(ctx, query, input.PublishAt, input.UnpublishAt, userID, contentID)
+	if err != nil {
+		return fmt.Errorf("içerik zamanlaması kaydedilemedi: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("etkilenen satır sayısı alınamadı: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		var current types.ContentStatus
+		err := r.db.QueryRowContext(ctx,
+			"SELECT status FROM contents WHERE id = $1 AND status != 'deleted'", contentID).Scan(&current)
+		if err != nil {
+			return fmt.Errorf("zamanlanacak içerik bulunamadı")
+		}
+		return fmt.Errorf("içerik taslak veya kapalı durumunda değil (mevcut durum: %s), yayın zamanlanamaz", current)
+	}
+
+	return nil
+}
+
+// ApplyScheduledTransitions - Zamanı gelen içerikleri yayınlar veya kapatır
+func (r *Repository) ApplyScheduledTransitions(ctx context.Context) (types.ScheduleTransitionResult, error) {
+	defer utils.TimeTrack(time.Now(), "Repository -> ApplyScheduledTransitions")
+
+	var result types.ScheduleTransitionResult
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return result, fmt.Errorf("transaction başlatılamadı: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Geçişler işlemi yapan kullanıcı olmadan zamanlayıcı aksiyonu ile kaydedilir
+	if err := setTransitionContext(ctx, tx, uuid.Nil, types.ContentActionSchedule, ""); err != nil {
+		return result, err
+	}
+
+	// 1. Yayın zamanı gelen taslak veya kapalı içerikleri yayınla
+	rows, err := tx.QueryContext(ctx, `
+		UPDATE contents
+		SET status = 'published', publish_at = NULL, updated_at = NOW()
+		WHERE publish_at <= NOW() AND status IN ('draft', 'closed')
+		RETURNING id
+	`)
+	if err != nil {
+		return result, fmt.Errorf("zamanlanmış içerikler yayınlanamadı: %w", err)
+	}
+
+	result.Published, err = utils.ScanUUIDs(rows)
+	if err != nil {
+		return result, fmt.Errorf("yayınlanan içerikler okunamadı: %w", err)
+	}
+
+	// 2. Kapatma zamanı gelen yayındaki içerikleri kapat
+	rows, err = tx.QueryContext(ctx, `
+		UPDATE contents
+		SET status = 'closed', unpublish_at = NULL, updated_at = NOW()
+		WHERE unpublish_at <= NOW() AND status = 'published'
+		RETURNING id
+	`)
+	if err != nil {
+		return result, fmt.Errorf("zamanlanmış içerikler kapatılamadı: %w", err)
+	}
+
+	result.Unpublished, err = utils.ScanUUIDs(rows)
+	if err != nil {
+		return result, fmt.Errorf("kapatılan içerikler okunamadı: %w", err)
+	}
+
+	// 3. Elle durum değiştirildiği için artık uygulanamayan geçmiş zamanlamaları temizle
+	_, err = tx.ExecContext(ctx, `
+		UPDATE contents
+		SET publish_at = CASE WHEN publish_at <= NOW() THEN NULL ELSE publish_at END,
+			unpublish_at = CASE WHEN unpublish_at <= NOW() THEN NULL ELSE unpublish_at END
+		WHERE publish_at <= NOW() OR unpublish_at <= NOW()
+	`)
+	if err != nil {
+		return result, fmt.Errorf("geçmiş zamanlamalar temizlenemedi: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return result, fmt.Errorf("transaction tamamlanamadı: %w", err)
+	}
+
+	return result, nil
+}
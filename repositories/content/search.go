@@ -0,0 +1,87 @@
+package ContentRepository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/okanay/backend-holding/configs"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// contentTSQuery - İçerik araması için tsquery parçalarını üretir
+func contentTSQuery(language, query string, paramIndex int) utils.TSQuery {
+	return utils.BuildTSQuery("search_vector", "language", language, query, configs.GetSiteConfig().Languages, paramIndex)
+}
+
+// SearchContents - Yayınlanmış içeriklerde alaka sırasına göre tam metin arama yapar
+func (r *Repository) SearchContents(ctx context.Context, params types.SearchParams) ([]types.SearchResult, int, error) {
+	defer utils.TimeTrack(time.Now(), "Repository -> SearchContents")
+
+	whereClauses, args := contentFilters(types.ContentSearchParams{
+		Status:   types.ContentStatusPublished,
+		Language: params.Language,
+		Category: params.Category,
+		Query:    params.Query,
+	}, false)
+	whereClause := strings.Join(whereClauses, " AND ")
+
+	var total int
+	err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM contents WHERE "+whereClause, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("arama sayımı yapılamadı: %w", err)
+	}
+
+	if total == 0 {
+		return []types.SearchResult{}, 0, nil
+	}
+
+	search := contentTSQuery(params.Language, params.Query, len(args)+1)
+	args = append(args, search.Args...)
+	args = append(args, utils.SearchHeadlineOptions)
+
+	query := fmt.Sprintf(`
+		SELECT
+			id, slug, language, title, category, image_url,
+			CASE WHEN publish_at IS NOT NULL AND publish_at < NOW() THEN publish_at ELSE created_at END,
+			ts_rank_cd(search_vector, %[2]s) AS rank,
+			ts_headline(%[1]s,
+				COALESCE(description, '') || ' ' || regexp_replace(content_html, '<[^>]*>', ' ', 'g'),
+				%[2]s, $%[3]d)
+		FROM contents
+		WHERE %[4]s
+		ORDER BY rank DESC, created_at DESC
+		LIMIT %[5]d OFFSET %[6]d
+	`, search.Config, search.Query, len(args), whereClause, params.Limit, (params.Page-1)*params.Limit)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("arama yapılamadı: %w", err)
+	}
+	defer rows.Close()
+
+	results := []types.SearchResult{}
+	for rows.Next() {
+		result := types.SearchResult{Type: types.SearchResultContent}
+		var headline string
+		if err := rows.Scan(
+			&result.ID,
+			&result.Slug,
+			&result.Language,
+			&result.Title,
+			&result.Category,
+			&result.ImageURL,
+			&result.PublishedAt,
+			&result.Rank,
+			&headline,
+		); err != nil {
+			return nil, 0, fmt.Errorf("arama sonucu okunamadı: %w", err)
+		}
+		result.Snippet = utils.HighlightSnippet(headline)
+		results = append(results, result)
+	}
+
+	return results, total, rows.Err()
+}
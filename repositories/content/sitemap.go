@@ -0,0 +1,37 @@
+package ContentRepository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// ListSitemapEntries - Yayınlanmış tüm içerikleri identifier gruplarına göre sıralı getirir
+func (r *Repository) ListSitemapEntries(ctx context.Context) ([]types.SitemapEntry, error) {
+	defer utils.TimeTrack(time.Now(), "Repository -> ListSitemapEntries")
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT identifier, language, slug, updated_at
+		FROM contents
+		WHERE status = $1
+		ORDER BY identifier, language
+	`, types.ContentStatusPublished)
+	if err != nil {
+		return nil, fmt.Errorf("sitemap içerikleri getirilemedi: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []types.SitemapEntry
+	for rows.Next() {
+		var entry types.SitemapEntry
+		if err := rows.Scan(&entry.Identifier, &entry.Language, &entry.Slug, &entry.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("sitemap içeriği okunamadı: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
@@ -0,0 +1,158 @@
+package ContentRepository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// ListTags - Tüm etiketleri silinmemiş içerik sayılarıyla birlikte listeler
+func (r *Repository) ListTags(ctx context.Context) ([]types.ContentTag, error) {
+	defer utils.TimeTrack(time.Now(), "Repository -> ListTags")
+
+	query := `
+		SELECT t.id, t.slug, t.name, COUNT(c.id)
+		FROM content_tags t
+		LEFT JOIN content_tag_links l ON l.tag_id = t.id
+		LEFT JOIN contents c ON c.id = l.content_id AND c.status != $1
+		GROUP BY t.id
+		ORDER BY t.name
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, types.ContentStatusDeleted)
+	if err != nil {
+		return nil, fmt.Errorf("etiketler getirilemedi: %w", err)
+	}
+	defer rows.Close()
+
+	tags := []types.ContentTag{}
+	for rows.Next() {
+		var tag types.ContentTag
+		if err := rows.Scan(&tag.ID, &tag.Slug, &tag.Name, &tag.ContentCount); err != nil {
+			return nil, fmt.Errorf("etiket okunamadı: %w", err)
+		}
+		tags = append(tags, tag)
+	}
+
+	return tags, rows.Err()
+}
+
+// DeleteTag - Etiketi ve tüm içerik bağlantılarını siler
+func (r *Repository) DeleteTag(ctx context.Context, id uuid.UUID) error {
+	defer utils.TimeTrack(time.Now(), "Repository -> DeleteTag")
+
+	result, err := r.db.ExecContext(ctx, "DELETE FROM content_tags WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("etiket silinemedi: %w", err)
+	}
+
+	affected, _ := result.RowsAffected()
+	if affected == 0 {
+		return fmt.Errorf("etiket bulunamadı (ID: %s)", id)
+	}
+
+	return nil
+}
+
+// setContentTagsTx - İçeriğin etiketlerini verilen adlarla değiştirir, olmayan etiketler oluşturulur
+// Aynı slug'a düşen adlar ("Go", "go") tek etikette birleşir.
+func setContentTagsTx(ctx context.Context, tx *sql.Tx, contentID uuid.UUID, names []string) error {
+	var tagIDs []uuid.UUID
+	seen := make(map[string]bool)
+
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		slug := utils.Slugify(name)
+		if slug == "" || seen[slug] {
+			continue
+		}
+		seen[slug] = true
+
+		var id uuid.UUID
+		err := tx.QueryRowContext(ctx, `
+			INSERT INTO content_tags (slug, name) VALUES ($1, $2)
+			ON CONFLICT (slug) DO UPDATE SET slug = EXCLUDED.slug
+			RETURNING id
+		`, slug, name).Scan(&id)
+		if err != nil {
+			return fmt.Errorf("etiket kaydedilemedi: %w", err)
+		}
+		tagIDs = append(tagIDs, id)
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM content_tag_links WHERE content_id = $1", contentID); err != nil {
+		return fmt.Errorf("etiket bağlantıları temizlenemedi: %w", err)
+	}
+
+	if len(tagIDs) == 0 {
+		return nil
+	}
+
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO content_tag_links (content_id, tag_id)
+		SELECT $1::uuid, UNNEST($2::uuid[])
+		ON CONFLICT DO NOTHING
+	`, contentID, pq.Array(tagIDs))
+	if err != nil {
+		return fmt.Errorf("etiket bağlantıları kaydedilemedi: %w", err)
+	}
+
+	return nil
+}
+
+// loadTags - Listelenen içeriklerin etiketlerini tek sorguda doldurur
+func (r *Repository) loadTags(ctx context.Context, contents []types.Content) error {
+	if len(contents) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, len(contents))
+	index := make(map[uuid.UUID]int, len(contents))
+	for i := range contents {
+		ids[i] = contents[i].ID
+		index[contents[i].ID] = i
+		contents[i].Tags = []types.ContentTag{}
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT l.content_id, t.id, t.slug, t.name
+		FROM content_tag_links l
+		INNER JOIN content_tags t ON t.id = l.tag_id
+		WHERE l.content_id = ANY($1)
+		ORDER BY t.name
+	`, pq.Array(ids))
+	if err != nil {
+		return fmt.Errorf("içerik etiketleri getirilemedi: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var contentID uuid.UUID
+		var tag types.ContentTag
+		if err := rows.Scan(&contentID, &tag.ID, &tag.Slug, &tag.Name); err != nil {
+			return fmt.Errorf("içerik etiketi okunamadı: %w", err)
+		}
+		if i, ok := index[contentID]; ok {
+			contents[i].Tags = append(contents[i].Tags, tag)
+		}
+	}
+
+	return rows.Err()
+}
+
+// loadTag - Tek içeriğin etiketlerini doldurur
+func (r *Repository) loadTag(ctx context.Context, content *types.Content) error {
+	contents := []types.Content{*content}
+	if err := r.loadTags(ctx, contents); err != nil {
+		return err
+	}
+	content.Tags = contents[0].Tags
+	return nil
+}
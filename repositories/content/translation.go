@@ -0,0 +1,342 @@
+package ContentRepository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// translationVariants - Silinmemiş içerikleri kaynak dildeki kardeşleriyle eşleştiren ortak CTE
+// Kaynak dil içeriğin source_language alanıdır, boşsa $1 (sitenin varsayılan dili) kullanılır.
+// Varsayılan dildeki içeriğin kendisi kaynak olduğundan karşılaştırılmaz. $2 silinmiş durumudur.
+const translationVariants = `
+	WITH variants AS (
+		SELECT
+			c.id, c.identifier, c.language, c.slug, c.title, c.status, c.category,
+			c.content_updated_at, c.updated_at,
+			src.language AS source_language,
+			src.content_updated_at AS source_updated_at,
+			COALESCE(src.content_updated_at > c.content_updated_at, false) AS outdated
+		FROM contents c
+		LEFT JOIN LATERAL (
+			SELECT s.language, s.content_updated_at
+			FROM contents s
+			WHERE s.identifier = c.identifier
+				AND s.id <> c.id
+				AND s.status != $2
+				AND s.language = COALESCE(c.source_language, $1)
+			LIMIT 1
+		) src ON true
+		WHERE c.status != $2
+	)
+`
+
+// LanguageVersionExists - Aynı identifier ile verilen dilde içerik olup olmadığını kontrol eder
+// Silinmiş (soft delete) kayıtlar da uq_identifier_language kısıtına takıldığı için sayılır.
+func (r *Repository) LanguageVersionExists(ctx context.Context, identifier, language string) (bool, error) {
+	defer utils.TimeTrack(time.Now(), "Repository -> LanguageVersionExists")
+
+	var exists bool
+	err := r.db.QueryRowContext(ctx,
+		`SELECT EXISTS (SELECT 1 FROM contents WHERE identifier = $1 AND language = $2)`,
+		identifier, language,
+	).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("dil versiyonu kontrol edilemedi: %w", err)
+	}
+
+	return exists, nil
+}
+
+// SlugExists - Slug'ın verilen dilde kullanımda olup olmadığını kontrol eder
+func (r *Repository) SlugExists(ctx context.Context, slug, language string) (bool, error) {
+	defer utils.TimeTrack(time.Now(), "Repository -> SlugExists")
+
+	var exists bool
+	err := r.db.QueryRowContext(ctx,
+		`SELECT EXISTS (SELECT 1 FROM contents WHERE slug = $1 AND language = $2)`,
+		slug, language,
+	).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("slug kontrol edilemedi: %w", err)
+	}
+
+	return exists, nil
+}
+
+// ListTranslationGroups - Çeviri gruplarını site dillerine göre eksik ve güncel olmayan çevirileriyle listeler
+// languages sitenin yayın dilleridir, ilki varsayılan dil kabul edilir.
+func (r *Repository) ListTranslationGroups(ctx context.Context, params types.ContentTranslationParams, languages []string) ([]types.ContentTranslationGroup, int, error) {
+	defer utils.TimeTrack(time.Now(), "Repository -> ListTranslationGroups")
+
+	args := []any{languages[0], types.ContentStatusDeleted}
+	var whereClauses []string
+
+	if params.Missing != "" {
+		args = append(args, params.Missing)
+		whereClauses = append(whereClauses, fmt.Sprintf("NOT ($%d = ANY(g.languages))", len(args)))
+	}
+
+	if params.Outdated {
+		whereClauses = append(whereClauses, "g.has_outdated")
+	}
+
+	if params.Category != "" {
+		args = append(args, params.Category)
+		whereClauses = append(whereClauses, fmt.Sprintf("g.identifier IN (SELECT identifier FROM variants WHERE category = $%d)", len(args)))
+	}
+
+	if params.Query != "" {
+		args = append(args, "%"+strings.ToLower(params.Query)+"%")
+		whereClauses = append(whereClauses, fmt.Sprintf(
+			"g.identifier IN (SELECT identifier FROM variants WHERE LOWER(title) LIKE $%d OR LOWER(identifier) LIKE $%d)", len(args), len(args)))
+	}
+
+	whereClause := ""
+	if len(whereClauses) > 0 {
+		whereClause = " WHERE " + strings.Join(whereClauses, " AND ")
+	}
+
+	limit := 20
+	if params.Limit > 0 && params.Limit <= 100 {
+		limit = params.Limit
+	}
+
+	page := 1
+	if params.Page > 0 {
+		page = params.Page
+	}
+
+	query := translationVariants + fmt.Sprintf(`,
+		groups AS (
+			SELECT
+				identifier,
+				array_agg(language) AS languages,
+				bool_or(outdated) AS has_outdated,
+				MAX(updated_at) AS last_updated
+			FROM variants
+			GROUP BY identifier
+		)
+		SELECT g.identifier, COUNT(*) OVER ()
+		FROM groups g
+		%s
+		ORDER BY g.last_updated DESC, g.identifier
+		LIMIT %d OFFSET %d
+	`, whereClause, limit, (page-1)*limit)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("çeviri grupları getirilemedi: %w", err)
+	}
+	defer rows.Close()
+
+	var identifiers []string
+	total := 0
+	for rows.Next() {
+		var identifier string
+		if err := rows.Scan(&identifier, &total); err != nil {
+			return nil, 0, fmt.Errorf("çeviri grubu okunamadı: %w", err)
+		}
+		identifiers = append(identifiers, identifier)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	if len(identifiers) == 0 {
+		return []types.ContentTranslationGroup{}, total, nil
+	}
+
+	groups, err := r.translationGroups(ctx, identifiers, languages)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return groups, total, nil
+}
+
+// GetTranslationGroup - Tek bir identifier'ın çeviri grubunu getirir
+func (r *Repository) GetTranslationGroup(ctx context.Context, identifier string, languages []string) (types.ContentTranslationGroup, error) {
+	defer utils.TimeTrack(time.Now(), "Repository -> GetTranslationGroup")
+
+	groups, err := r.translationGroups(ctx, []string{identifier}, languages)
+	if err != nil {
+		return types.ContentTranslationGroup{}, err
+	}
+
+	if len(groups) == 0 {
+		return types.ContentTranslationGroup{}, fmt.Errorf("çeviri grubu bulunamadı (identifier: %s)", identifier)
+	}
+
+	return groups[0], nil
+}
+
+// GetTranslationCoverage - Site dillerine göre çeviri kapsamı özetini hesaplar
+func (r *Repository) GetTranslationCoverage(ctx context.Context, languages []string) (types.ContentTranslationCoverage, error) {
+	defer utils.TimeTrack(time.Now(), "Repository -> GetTranslationCoverage")
+
+	coverage := types.ContentTranslationCoverage{
+		Languages:  languages,
+		ByLanguage: []types.ContentLanguageCoverage{},
+	}
+
+	query := translationVariants + `
+		SELECT
+			(SELECT COUNT(DISTINCT identifier) FROM variants),
+			(SELECT COUNT(*) FROM (
+				SELECT identifier FROM variants
+				WHERE language = ANY($3)
+				GROUP BY identifier
+				HAVING COUNT(DISTINCT language) = $4
+			) complete)
+	`
+
+	err := r.db.QueryRowContext(ctx, query,
+		languages[0], types.ContentStatusDeleted, pq.Array(languages), len(languages),
+	).Scan(&coverage.TotalGroups, &coverage.CompleteGroups)
+	if err != nil {
+		return coverage, fmt.Errorf("çeviri kapsamı getirilemedi: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, translationVariants+`
+		SELECT language, COUNT(DISTINCT identifier), COUNT(*) FILTER (WHERE outdated)
+		FROM variants
+		WHERE language = ANY($3)
+		GROUP BY language
+	`, languages[0], types.ContentStatusDeleted, pq.Array(languages))
+	if err != nil {
+		return coverage, fmt.Errorf("dil bazında çeviri kapsamı getirilemedi: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string][2]int)
+	for rows.Next() {
+		var language string
+		var present, outdated int
+		if err := rows.Scan(&language, &present, &outdated); err != nil {
+			return coverage, fmt.Errorf("çeviri kapsamı okunamadı: %w", err)
+		}
+		counts[language] = [2]int{present, outdated}
+	}
+	if err := rows.Err(); err != nil {
+		return coverage, err
+	}
+
+	present := 0
+	for _, language := range languages {
+		row := types.ContentLanguageCoverage{
+			Language: language,
+			Present:  counts[language][0],
+			Outdated: counts[language][1],
+		}
+		row.Missing = coverage.TotalGroups - row.Present
+		row.Coverage = ratio(row.Present, coverage.TotalGroups)
+		present += row.Present
+		coverage.ByLanguage = append(coverage.ByLanguage, row)
+	}
+
+	coverage.Coverage = ratio(present, coverage.TotalGroups*len(languages))
+
+	return coverage, nil
+}
+
+// translationGroups - Verilen identifier'ların versiyonlarını getirir ve gruplara dönüştürür
+func (r *Repository) translationGroups(ctx context.Context, identifiers []string, languages []string) ([]types.ContentTranslationGroup, error) {
+	rows, err := r.db.QueryContext(ctx, translationVariants+`
+		SELECT
+			id, identifier, language, slug, title, status, category,
+			COALESCE(source_language, ''), content_updated_at, source_updated_at, outdated
+		FROM variants
+		WHERE identifier = ANY($3)
+		ORDER BY language
+	`, languages[0], types.ContentStatusDeleted, pq.Array(identifiers))
+	if err != nil {
+		return nil, fmt.Errorf("çeviri versiyonları getirilemedi: %w", err)
+	}
+	defer rows.Close()
+
+	byIdentifier := make(map[string]*types.ContentTranslationGroup)
+	for rows.Next() {
+		var variant types.ContentTranslationVariant
+		var identifier, category string
+		err := rows.Scan(
+			&variant.ID,
+			&identifier,
+			&variant.Language,
+			&variant.Slug,
+			&variant.Title,
+			&variant.Status,
+			&category,
+			&variant.SourceLanguage,
+			&variant.ContentUpdatedAt,
+			&variant.SourceUpdatedAt,
+			&variant.Outdated,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("çeviri versiyonu okunamadı: %w", err)
+		}
+
+		group, ok := byIdentifier[identifier]
+		if !ok {
+			group = &types.ContentTranslationGroup{
+				Identifier: identifier,
+				Variants:   []types.ContentTranslationVariant{},
+				Languages:  []string{},
+				Missing:    []string{},
+				Outdated:   []string{},
+			}
+			byIdentifier[identifier] = group
+		}
+
+		// Grup kategorisi varsayılan dildeki versiyondan alınır
+		if group.Category == "" || variant.Language == languages[0] {
+			group.Category = category
+		}
+
+		group.Variants = append(group.Variants, variant)
+		group.Languages = append(group.Languages, variant.Language)
+		if variant.Outdated {
+			group.Outdated = append(group.Outdated, variant.Language)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// İstenen sırayı koru ve eksik dilleri hesapla
+	groups := make([]types.ContentTranslationGroup, 0, len(byIdentifier))
+	for _, identifier := range identifiers {
+		group, ok := byIdentifier[identifier]
+		if !ok {
+			continue
+		}
+
+		present := 0
+		for _, language := range languages {
+			if slices.Contains(group.Languages, language) {
+				present++
+			} else {
+				group.Missing = append(group.Missing, language)
+			}
+		}
+		group.Coverage = ratio(present, len(languages))
+
+		groups = append(groups, *group)
+	}
+
+	return groups, nil
+}
+
+// ratio - Sıfıra bölmeden korunarak oran hesaplar
+func ratio(part, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(part) / float64(total)
+}
@@ -3,6 +3,7 @@ package ContentRepository
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -13,8 +14,13 @@ import (
 	"github.com/okanay/backend-holding/utils"
 )
 
+// ErrPublishedContentLocked yayındaki içeriği yayınlama yetkisi olmayan bir kullanıcı düzenlemeye çalıştığında döner
+var ErrPublishedContentLocked = errors.New("yayındaki içerik sadece yayınlama yetkisi olan kullanıcılar tarafından düzenlenebilir")
+
 // UpdateContent - İçeriği günceller (PATCH mantığı - sadece gönderilen alanları günceller)
-func (r *Repository) UpdateContent(ctx context.Context, contentID uuid.UUID, input types.ContentInput, userID uuid.UUID) (types.Content, error) {
+// expectedVersion 0 değilse içerik yalnızca bu sürümdeyken güncellenir, aksi halde VersionConflictError döner.
+// canPublish false ise yayındaki içerik güncellenmez ve ErrPublishedContentLocked döner; diğer içeriklerin zamanlanmış yayını iptal edilir.
+func (r *Repository) UpdateContent(ctx context.Context, contentID uuid.UUID, input types.ContentInput, userID uuid.UUID, expectedVersion int, canPublish bool) (types.Content, error) {
 	defer utils.TimeTrack(time.Now(), "Repository -> UpdateContent")
 
 	var content types.Content
@@ -32,7 +38,8 @@ func (r *Repository) UpdateContent(ctx context.Context, contentID uuid.UUID, inp
 
 	// Her alan için kontrol ve ekleme
 	if input.Category != "" {
-		setClauses = append(setClauses, fmt.Sprintf("category = $%d", paramIndex))
+		setClauses = append(setClauses, fmt.Sprintf(
+			"category = $%d, category_id = (SELECT id FROM content_categories WHERE slug = $%d)", paramIndex, paramIndex))
 		args = append(args, input.Category)
 		paramIndex++
 	}
@@ -98,28 +105,41 @@ func (r *Repository) UpdateContent(ctx context.Context, contentID uuid.UUID, inp
 	}
 
 	// Güncellenecek alan yoksa mevcut veriyi dön
-	if len(setClauses) == 0 {
+	if len(setClauses) == 0 && input.Tags == nil {
 		return r.GetContentByID(ctx, contentID)
 	}
 
+	// Onaylayıcı olmayan kullanıcının değişikliği onaylı zamanlamayla yayına çıkmasın
+	if !canPublish {
+		setClauses = append(setClauses, "publish_at = NULL")
+	}
+
 	// updated_at ekle
 	setClauses = append(setClauses, fmt.Sprintf("updated_at = $%d", paramIndex))
 	args = append(args, time.Now())
 	paramIndex++
 
+	// Durum değişikliği geçiş kaydında güncelleyen kullanıcı ile görünsün
+	if input.Status != "" {
+		if err := setTransitionContext(ctx, tx, userID, types.ContentActionStatusChange, input.ChangeNote); err != nil {
+			return content, err
+		}
+	}
+
 	// WHERE parametreleri
-	args = append(args, contentID, userID, types.ContentStatusDeleted)
+	args = append(args, contentID, userID, types.ContentStatusDeleted, expectedVersion, canPublish, types.ContentStatusPublished)
 
 	// Sorguyu oluştur ve çalıştır
 	query := fmt.Sprintf(`
 		UPDATE contents
 		SET %s
-		WHERE id = $%d AND user_id = $%d AND status != $%d
+		WHERE id = $%d AND user_id = $%d AND status != $%d AND ($%d = 0 OR version = $%d) AND ($%d OR status != $%d)
 		RETURNING
 			id, user_id, slug, identifier, language, title, description,
 			category, image_url, details_json, content_json, content_html,
-			status, created_at, updated_at
-	`, strings.Join(setClauses, ", "), paramIndex, paramIndex+1, paramIndex+2)
+			status, created_at, updated_at,
+			publish_at, unpublish_at, scheduled_by, scheduled_at, version, category_id
+	`, strings.Join(setClauses, ", "), paramIndex, paramIndex+1, paramIndex+2, paramIndex+3, paramIndex+3, paramIndex+4, paramIndex+5)
 
 	err = tx.QueryRowContext(ctx, query, args...).Scan(
 		&content.ID,
@@ -137,10 +157,19 @@ func (r *Repository) UpdateContent(ctx context.Context, contentID uuid.UUID, inp
 		&content.Status,
 		&content.CreatedAt,
 		&content.UpdatedAt,
+		&content.PublishAt,
+		&content.UnpublishAt,
+		&content.ScheduledBy,
+		&content.ScheduledAt,
+		&content.Version,
+		&content.CategoryID,
 	)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
+			if blocked := updateBlockedError(ctx, tx, contentID, userID, expectedVersion, canPublish); blocked != nil {
+				return content, blocked
+			}
 			return content, fmt.Errorf("içerik bulunamadı, yetkiniz yok veya silinmiş (ID: %s)", contentID)
 		}
 
@@ -157,15 +186,53 @@ func (r *Repository) UpdateContent(ctx context.Context, contentID uuid.UUID, inp
 		return content, fmt.Errorf("güncelleme hatası: %w", err)
 	}
 
+	// Etiketler gönderildiyse tamamen değiştirilir (boş liste tüm etiketleri kaldırır)
+	if input.Tags != nil {
+		if err := setContentTagsTx(ctx, tx, content.ID, input.Tags); err != nil {
+			return content, err
+		}
+	}
+
+	// Güncel hali yeni revizyon olarak kaydet
+	if err := insertRevisionTx(ctx, tx, content.ID, userID, input.ChangeNote, 0); err != nil {
+		return content, err
+	}
+
 	if err = tx.Commit(); err != nil {
 		return content, fmt.Errorf("transaction commit hatası: %w", err)
 	}
 
+	if err := r.loadTag(ctx, &content); err != nil {
+		return content, err
+	}
+
 	return content, nil
 }
 
-// UpdateContentStatus - Sadece içerik durumunu günceller
-func (r *Repository) UpdateContentStatus(ctx context.Context, contentID uuid.UUID, newStatus types.ContentStatus) error {
+// updateBlockedError - Güncelleme satır döndürmediğinde sebebini bulur
+// Satır varsa güncellemeyi engelleyen koşul sürüm farkı veya yayın yetkisidir; satır yoksa nil döner.
+func updateBlockedError(ctx context.Context, tx *sql.Tx, contentID, userID uuid.UUID, expectedVersion int, canPublish bool) error {
+	var current int
+	var status types.ContentStatus
+	err := tx.QueryRowContext(ctx,
+		"SELECT version, status FROM contents WHERE id = $1 AND user_id = $2 AND status != $3",
+		contentID, userID, types.ContentStatusDeleted).Scan(&current, &status)
+	if err != nil {
+		return nil
+	}
+
+	if expectedVersion != 0 && current != expectedVersion {
+		return &types.VersionConflictError{CurrentVersion: current}
+	}
+	if !canPublish && status == types.ContentStatusPublished {
+		return ErrPublishedContentLocked
+	}
+
+	return nil
+}
+
+// UpdateContentStatus - Sadece içerik durumunu günceller, geçiş actorID ile kaydedilir
+func (r *Repository) UpdateContentStatus(ctx context.Context, contentID uuid.UUID, newStatus types.ContentStatus, actorID uuid.UUID) error {
 	defer utils.TimeTrack(time.Now(), "Repository -> UpdateContentStatus")
 
 	// Deleted status için soft delete kullan
@@ -173,13 +240,23 @@ func (r *Repository) UpdateContentStatus(ctx context.Context, contentID uuid.UUI
 		return r.SoftDeleteContent(ctx, contentID)
 	}
 
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("transaction başlatılamadı: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := setTransitionContext(ctx, tx, actorID, types.ContentActionStatusChange, ""); err != nil {
+		return err
+	}
+
 	query := `
 		UPDATE contents
 		SET status = $1, updated_at = NOW()
 		WHERE id = $2 AND status != $1 AND status != $3
 	`
 
-	result, err := r.db.ExecContext(ctx, query, newStatus, contentID, types.ContentStatusDeleted)
+	result, err := tx.ExecContext(ctx, query, newStatus, contentID, types.ContentStatusDeleted)
 	if err != nil {
 		return fmt.Errorf("status güncelleme hatası: %w", err)
 	}
@@ -192,7 +269,7 @@ func (r *Repository) UpdateContentStatus(ctx context.Context, contentID uuid.UUI
 	if rowsAffected == 0 {
 		// İçerik var mı kontrol et
 		var exists bool
-		err = r.db.QueryRowContext(ctx,
+		err = tx.QueryRowContext(ctx,
 			"SELECT EXISTS(SELECT 1 FROM contents WHERE id = $1)",
 			contentID).Scan(&exists)
 
@@ -207,5 +284,9 @@ func (r *Repository) UpdateContentStatus(ctx context.Context, contentID uuid.UUI
 		return fmt.Errorf("içerik durumu zaten '%s' veya silinmiş", newStatus)
 	}
 
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("transaction commit hatası: %w", err)
+	}
+
 	return nil
 }
@@ -0,0 +1,113 @@
+package EmailTemplateRepository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+const templateColumns = `
+	id, event, status, language, subject, text_body, html_body, is_active, updated_by, created_at, updated_at
+`
+
+// scanner - sql.Row ve sql.Rows için ortak arayüz
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanTemplate(row scanner) (types.EmailTemplate, error) {
+	var template types.EmailTemplate
+	var updatedBy uuid.NullUUID
+
+	err := row.Scan(
+		&template.ID,
+		&template.Event,
+		&template.Status,
+		&template.Language,
+		&template.Subject,
+		&template.TextBody,
+		&template.HTMLBody,
+		&template.IsActive,
+		&updatedBy,
+		&template.CreatedAt,
+		&template.UpdatedAt,
+	)
+	if updatedBy.Valid {
+		template.UpdatedBy = &updatedBy.UUID
+	}
+
+	return template, err
+}
+
+// ListTemplates - Şablonları olay ve dile göre filtreleyerek listeler
+func (r *Repository) ListTemplates(ctx context.Context, event string, language string) ([]types.EmailTemplate, error) {
+	defer utils.TimeTrack(time.Now(), "Email Template -> List Templates")
+
+	query := `SELECT ` + templateColumns + ` FROM email_templates
+		WHERE ($1 = '' OR event = $1) AND ($2 = '' OR language = $2)
+		ORDER BY event, status, language`
+
+	rows, err := r.db.QueryContext(ctx, query, event, language)
+	if err != nil {
+		return nil, fmt.Errorf("e-posta şablonları getirilemedi: %w", err)
+	}
+	defer rows.Close()
+
+	templates := []types.EmailTemplate{}
+	for rows.Next() {
+		template, err := scanTemplate(rows)
+		if err != nil {
+			return nil, fmt.Errorf("e-posta şablonu okunamadı: %w", err)
+		}
+		templates = append(templates, template)
+	}
+
+	return templates, rows.Err()
+}
+
+// GetTemplateByID - Şablonu ID ile getirir, bulunamazsa ID'si uuid.Nil olan şablon döner
+func (r *Repository) GetTemplateByID(ctx context.Context, id uuid.UUID) (types.EmailTemplate, error) {
+	defer utils.TimeTrack(time.Now(), "Email Template -> Get Template By ID")
+
+	query := `SELECT ` + templateColumns + ` FROM email_templates WHERE id = $1`
+
+	template, err := scanTemplate(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return types.EmailTemplate{}, nil
+		}
+		return template, fmt.Errorf("e-posta şablonu getirilemedi: %w", err)
+	}
+
+	return template, nil
+}
+
+// FindTemplate - Olay için gönderimde kullanılacak aktif şablonu bulur
+// Öncelik sırası: duruma özel şablon, olayın varsayılan şablonu; her biri için önce adayın dili, sonra varsayılan dil.
+// Uygun şablon yoksa ID'si uuid.Nil olan şablon döner ve e-posta gönderilmez.
+func (r *Repository) FindTemplate(ctx context.Context, event types.EmailTemplateEvent, status string, language string, fallbackLanguage string) (types.EmailTemplate, error) {
+	defer utils.TimeTrack(time.Now(), "Email Template -> Find Template")
+
+	query := `SELECT ` + templateColumns + ` FROM email_templates
+		WHERE event = $1
+			AND is_active = TRUE
+			AND (status = $2 OR status = '')
+			AND language IN ($3, $4)
+		ORDER BY (status = $2) DESC, (language = $3) DESC
+		LIMIT 1`
+
+	template, err := scanTemplate(r.db.QueryRowContext(ctx, query, event, status, language, fallbackLanguage))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return types.EmailTemplate{}, nil
+		}
+		return template, fmt.Errorf("e-posta şablonu getirilemedi: %w", err)
+	}
+
+	return template, nil
+}
@@ -0,0 +1,87 @@
+package EmailTemplateRepository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// CreateTemplate - Yeni şablon oluşturur
+func (r *Repository) CreateTemplate(ctx context.Context, input types.EmailTemplateInput, userID uuid.UUID) (types.EmailTemplate, error) {
+	defer utils.TimeTrack(time.Now(), "Email Template -> Create Template")
+
+	query := `
+		INSERT INTO email_templates (event, status, language, subject, text_body, html_body, is_active, updated_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING ` + templateColumns
+
+	template, err := scanTemplate(r.db.QueryRowContext(ctx, query,
+		input.Event,
+		input.Status,
+		input.Language,
+		input.Subject,
+		input.TextBody,
+		input.HTMLBody,
+		input.IsActive == nil || *input.IsActive,
+		userID,
+	))
+	if err != nil {
+		return template, fmt.Errorf("e-posta şablonu oluşturulamadı: %w", err)
+	}
+
+	return template, nil
+}
+
+// UpdateTemplate - Şablonu günceller, bulunamazsa ID'si uuid.Nil olan şablon döner
+func (r *Repository) UpdateTemplate(ctx context.Context, id uuid.UUID, input types.EmailTemplateInput, userID uuid.UUID) (types.EmailTemplate, error) {
+	defer utils.TimeTrack(time.Now(), "Email Template -> Update Template")
+
+	query := `
+		UPDATE email_templates
+		SET event = $1, status = $2, language = $3, subject = $4, text_body = $5, html_body = $6,
+			is_active = $7, updated_by = $8, updated_at = NOW()
+		WHERE id = $9
+		RETURNING ` + templateColumns
+
+	template, err := scanTemplate(r.db.QueryRowContext(ctx, query,
+		input.Event,
+		input.Status,
+		input.Language,
+		input.Subject,
+		input.TextBody,
+		input.HTMLBody,
+		input.IsActive == nil || *input.IsActive,
+		userID,
+		id,
+	))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return types.EmailTemplate{}, nil
+		}
+		return template, fmt.Errorf("e-posta şablonu güncellenemedi: %w", err)
+	}
+
+	return template, nil
+}
+
+// DeleteTemplate - Şablonu siler, silinen kayıt yoksa false döner
+func (r *Repository) DeleteTemplate(ctx context.Context, id uuid.UUID) (bool, error) {
+	defer utils.TimeTrack(time.Now(), "Email Template -> Delete Template")
+
+	result, err := r.db.ExecContext(ctx, `DELETE FROM email_templates WHERE id = $1`, id)
+	if err != nil {
+		return false, fmt.Errorf("e-posta şablonu silinemedi: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("silme sonucu okunamadı: %w", err)
+	}
+
+	return affected > 0, nil
+}
@@ -0,0 +1,53 @@
+package FileRepository
+
+import (
+	"context"
+
+	"github.com/lib/pq"
+	"github.com/okanay/backend-holding/types"
+)
+
+// GetFilesByURLs verilen URL'lere ait silinmemiş dosyaları getirir (başvuru eklerini bulmak için)
+func (r *Repository) GetFilesByURLs(ctx context.Context, urls []string) ([]types.File, error) {
+	if len(urls) == 0 {
+		return []types.File{}, nil
+	}
+
+	query := `
+		SELECT id, url, filename, file_type, COALESCE(file_category, ''), size_in_bytes, status, created_at, updated_at
+		FROM files
+		WHERE url = ANY($1) AND status != 'deleted'
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(urls))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	files := []types.File{}
+	for rows.Next() {
+		var file types.File
+		err := rows.Scan(
+			&file.ID,
+			&file.URL,
+			&file.Filename,
+			&file.FileType,
+			&file.FileCategory,
+			&file.SizeInBytes,
+			&file.Status,
+			&file.CreatedAt,
+			&file.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
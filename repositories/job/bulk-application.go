@@ -0,0 +1,201 @@
+package JobRepository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/okanay/backend-holding/configs"
+	OutboxRepository "github.com/okanay/backend-holding/repositories/outbox"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// BulkUpdateApplications seçilen başvurulara tek transaction içinde toplu işlem uygular
+// Seçim ID listesi veya filtre ile yapılır; scope uuid.Nil değilse yalnızca kullanıcının sahip veya
+// işe alım uzmanı olduğu ilanların başvuruları etkilenir. compose verilirse bildirim e-postaları
+// aynı transaction içinde kuyruğa yazılır, böylece işlem geri alınırsa e-posta da gönderilmez.
+func (r *Repository) BulkUpdateApplications(ctx context.Context, input types.JobApplicationBulkInput, scope uuid.UUID, userID uuid.UUID, compose func([]types.JobApplicationRecipient) []types.OutboxMessage) (types.JobApplicationBulkResult, error) {
+	defer utils.TimeTrack(time.Now(), "Job -> Bulk Update Applications")
+
+	result := types.JobApplicationBulkResult{DryRun: input.DryRun, IDs: []uuid.UUID{}}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return result, fmt.Errorf("transaction başlatılamadı: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Durum geçmişi trigger'ı için değişikliği yapan kullanıcıyı ayarla (sadece bu transaction için)
+	_, err = tx.ExecContext(ctx, "SELECT set_config('app.current_user_id', $1, true)", userID.String())
+	if err != nil {
+		return result, fmt.Errorf("kullanıcı bilgisi ayarlanamadı: %w", err)
+	}
+
+	// Deneme çalıştırmasında sınır uygulanmaz, seçime uyan tüm başvurular sayılır
+	if input.DryRun {
+		whereClause, args, _ := bulkTargetFilter(input, scope)
+		err := tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM job_applications a"+whereClause, args...).Scan(&result.Matched)
+		if err != nil {
+			return result, fmt.Errorf("başvurular sayılamadı: %w", err)
+		}
+		result.OverLimit = result.Matched > configs.BULK_APPLICATION_MAX
+		return result, nil
+	}
+
+	targets, err := selectBulkTargets(ctx, tx, input, scope)
+	if err != nil {
+		return result, err
+	}
+
+	// Üst sınırı aşan seçimde hiçbir değişiklik yapılmaz, handler OverLimit değerine bakarak hata döner
+	result.Matched = len(targets)
+	result.OverLimit = len(targets) > configs.BULK_APPLICATION_MAX
+	if len(targets) == 0 || result.OverLimit {
+		return result, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(targets))
+	for _, target := range targets {
+		ids = append(ids, target.ID)
+	}
+
+	var rows *sql.Rows
+	switch input.Action {
+	case types.JobApplicationBulkStatus:
+		rows, err = tx.QueryContext(ctx, `
+			UPDATE job_applications
+			SET status = $1, updated_at = NOW()
+			WHERE id = ANY($2::uuid[]) AND status <> $1
+			RETURNING id, ''
+		`, input.Status, pq.Array(ids))
+	case types.JobApplicationBulkTag:
+		rows, err = tx.QueryContext(ctx, `
+			UPDATE job_applications
+			SET tags = array_append(tags, $1), updated_at = NOW()
+			WHERE id = ANY($2::uuid[]) AND NOT ($1 = ANY(tags))
+			RETURNING id, ''
+		`, input.Tag, pq.Array(ids))
+	case types.JobApplicationBulkUntag:
+		rows, err = tx.QueryContext(ctx, `
+			UPDATE job_applications
+			SET tags = array_remove(tags, $1), updated_at = NOW()
+			WHERE id = ANY($2::uuid[]) AND $1 = ANY(tags)
+			RETURNING id, ''
+		`, input.Tag, pq.Array(ids))
+	case types.JobApplicationBulkDelete:
+		rows, err = tx.QueryContext(ctx, `
+			DELETE FROM job_applications
+			WHERE id = ANY($1::uuid[])
+			RETURNING id, form_json
+		`, pq.Array(ids))
+	default:
+		return result, fmt.Errorf("geçersiz toplu işlem: %s", input.Action)
+	}
+	if err != nil {
+		return result, fmt.Errorf("toplu işlem uygulanamadı: %w", err)
+	}
+
+	affected := make(map[uuid.UUID]bool, len(targets))
+	for rows.Next() {
+		var id uuid.UUID
+		var formJSON string
+		if err := rows.Scan(&id, &formJSON); err != nil {
+			rows.Close()
+			return result, fmt.Errorf("toplu işlem sonucu okunamadı: %w", err)
+		}
+		affected[id] = true
+		result.IDs = append(result.IDs, id)
+		if formJSON != "" {
+			result.DeletedForms = append(result.DeletedForms, formJSON)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return result, fmt.Errorf("toplu işlem sonucu okunamadı: %w", err)
+	}
+	result.Affected = len(result.IDs)
+
+	// Sadece gerçekten değişen başvuruların adaylarına bildirim gönderilir
+	if compose != nil {
+		notified := make([]types.JobApplicationRecipient, 0, len(affected))
+		for _, target := range targets {
+			if affected[target.ID] {
+				notified = append(notified, target)
+			}
+		}
+
+		messages := compose(notified)
+		if err := OutboxRepository.EnqueueTx(ctx, tx, messages); err != nil {
+			return result, err
+		}
+		result.Queued = len(messages)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return result, fmt.Errorf("transaction tamamlanamadı: %w", err)
+	}
+
+	return result, nil
+}
+
+// bulkTargetFilter - Toplu işlem seçiminin WHERE koşulunu, parametrelerini ve sıradaki parametre numarasını üretir
+func bulkTargetFilter(input types.JobApplicationBulkInput, scope uuid.UUID) (string, []any, int) {
+	var whereClause string
+	var args []any
+	var paramIndex int
+
+	if input.Filter != nil {
+		filter := *input.Filter
+		filter.ScopeUser = uuid.Nil // Kapsam aşağıda rol kontrolüyle birlikte eklenir
+		whereClause, args, paramIndex = applicationFilter(filter)
+	} else {
+		whereClause = " WHERE a.id = ANY($1::uuid[])"
+		args = []any{pq.Array(input.IDs)}
+		paramIndex = 2
+	}
+
+	// Mülakatçılar toplu işlem yapamaz
+	if scope != uuid.Nil {
+		whereClause += fmt.Sprintf(" AND EXISTS (SELECT 1 FROM job_hiring_team t WHERE t.job_id = a.job_id AND t.user_id = $%d AND t.role IN ('owner', 'recruiter'))", paramIndex)
+		args = append(args, scope)
+		paramIndex++
+	}
+
+	return whereClause, args, paramIndex
+}
+
+// selectBulkTargets - Toplu işlemin uygulanacağı başvuruları kilitleyerek seçer
+func selectBulkTargets(ctx context.Context, tx *sql.Tx, input types.JobApplicationBulkInput, scope uuid.UUID) ([]types.JobApplicationRecipient, error) {
+	whereClause, args, paramIndex := bulkTargetFilter(input, scope)
+
+	query := `
+		SELECT a.id, a.email, a.full_name, COALESCE(d.title, ''), a.language
+		FROM job_applications a
+		LEFT JOIN job_posting_details d ON a.job_id = d.id
+	` + whereClause + fmt.Sprintf(" ORDER BY a.created_at LIMIT $%d FOR UPDATE OF a", paramIndex)
+	args = append(args, configs.BULK_APPLICATION_MAX+1) // Sınırın aşıldığını anlamak için bir fazlası
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("başvurular seçilemedi: %w", err)
+	}
+	defer rows.Close()
+
+	targets := []types.JobApplicationRecipient{}
+	for rows.Next() {
+		var target types.JobApplicationRecipient
+		if err := rows.Scan(&target.ID, &target.Email, &target.FullName, &target.JobTitle, &target.Language); err != nil {
+			return nil, fmt.Errorf("başvuru okunamadı: %w", err)
+		}
+		targets = append(targets, target)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("başvurular okunamadı: %w", err)
+	}
+
+	return targets, nil
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/okanay/backend-holding/types"
 	"github.com/okanay/backend-holding/utils"
 )
@@ -21,10 +22,12 @@ func (r *Repository) CreateJobApplication(ctx context.Context, jobID uuid.UUID,
 			email,
 			phone,
 			form_type,
-			form_json
+			form_json,
+			source,
+			language
 		)
 		VALUES (
-			$1, $2, $3, $4, $5, $6
+			$1, $2, $3, $4, $5, $6, $7, $8
 		)
 		RETURNING
 			id,
@@ -35,6 +38,9 @@ func (r *Repository) CreateJobApplication(ctx context.Context, jobID uuid.UUID,
 			form_type,
 			form_json,
 			status,
+			source,
+			tags,
+			language,
 			created_at,
 			updated_at
 	`
@@ -48,6 +54,8 @@ func (r *Repository) CreateJobApplication(ctx context.Context, jobID uuid.UUID,
 		input.Phone,
 		input.FormType,
 		input.FormJSON,
+		input.Source,
+		input.Language,
 	).Scan(
 		&application.ID,
 		&application.JobID,
@@ -57,6 +65,9 @@ func (r *Repository) CreateJobApplication(ctx context.Context, jobID uuid.UUID,
 		&application.FormType,
 		&application.FormJSON,
 		&application.Status,
+		&application.Source,
+		pq.Array(&application.Tags),
+		&application.Language,
 		&application.CreatedAt,
 		&application.UpdatedAt,
 	)
@@ -25,7 +25,7 @@ func (r *Repository) CreateJob(ctx context.Context, input types.JobInput, userID
 	query := `
 		INSERT INTO job_postings (user_id, slug, status, deadline)
 		VALUES ($1, $2, $3, $4)
-		RETURNING id, user_id, slug, status, deadline, created_at, updated_at
+		RETURNING id, user_id, slug, status, deadline, created_at, updated_at, version
 	`
 
 	err = tx.QueryRowContext(
@@ -43,6 +43,7 @@ func (r *Repository) CreateJob(ctx context.Context, input types.JobInput, userID
 		&job.Deadline,
 		&job.CreatedAt,
 		&job.UpdatedAt,
+		&job.Version,
 	)
 
 	if err != nil {
@@ -55,11 +56,11 @@ func (r *Repository) CreateJob(ctx context.Context, input types.JobInput, userID
 	detailsQuery := `
 		INSERT INTO job_posting_details (
 			id, title, description, image, location, work_mode, employment_type,
-			experience_level, html, json, form_type, applicants
+			experience_level, html, json, form_type, applicants, language
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, 0)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, 0, $12)
 		RETURNING id, title, description, image, location, work_mode, employment_type,
-			experience_level, html, json, form_type, applicants
+			experience_level, html, json, form_type, applicants, language
 	`
 
 	var details types.JobDetails
@@ -77,6 +78,7 @@ func (r *Repository) CreateJob(ctx context.Context, input types.JobInput, userID
 		input.HTML,
 		input.JSON,
 		input.FormType,
+		input.Language,
 	).Scan(
 		&details.ID,
 		&details.Title,
@@ -90,6 +92,7 @@ func (r *Repository) CreateJob(ctx context.Context, input types.JobInput, userID
 		&details.JSON,
 		&details.FormType,
 		&details.Applicants,
+		&details.Language,
 	)
 
 	if err != nil {
@@ -117,6 +120,18 @@ func (r *Repository) CreateJob(ctx context.Context, input types.JobInput, userID
 		}
 	}
 
+	// İlanı oluşturan kullanıcı her zaman ekibin sahibidir
+	team := []types.JobTeamMemberInput{{UserID: userID, Role: types.JobTeamRoleOwner}}
+	for _, member := range input.HiringTeam {
+		if member.UserID != userID {
+			team = append(team, member)
+		}
+	}
+
+	if err = replaceHiringTeam(ctx, tx, job.ID, team, userID); err != nil {
+		return job, err
+	}
+
 	if err = tx.Commit(); err != nil {
 		return job, fmt.Errorf("işlem tamamlanamadı: %w", err)
 	}
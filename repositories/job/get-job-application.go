@@ -3,42 +3,18 @@ package JobRepository
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/okanay/backend-holding/types"
 	"github.com/okanay/backend-holding/utils"
 )
 
-func (r *Repository) ListJobsApplications(ctx context.Context, params types.JobApplicationSearchParams) ([]types.JobApplication, int, error) {
-	defer utils.TimeTrack(time.Now(), "Job -> Get Job Applications")
-
-	baseQuery := `
-		SELECT
-			a.id,
-			a.job_id,
-			a.full_name,
-			a.email,
-			a.phone,
-			a.form_type,
-			a.form_json,
-			a.status,
-			a.created_at,
-			a.updated_at,
-			d.title AS job_title
-		FROM job_applications a
-		LEFT JOIN job_postings p ON a.job_id = p.id
-		LEFT JOIN job_posting_details d ON p.id = d.id
-	`
-
-	countQuery := `
-		SELECT COUNT(*)
-		FROM job_applications a
-		LEFT JOIN job_postings p ON a.job_id = p.id
-		LEFT JOIN job_posting_details d ON p.id = d.id
-	`
-
+// applicationFilter - Başvuru arama parametrelerini "a" alias'ı için WHERE cümlesine dönüştürür (liste ve toplu işlemler ortak kullanır)
+func applicationFilter(params types.JobApplicationSearchParams) (string, []any, int) {
 	whereClause := " WHERE 1=1"
 	args := []any{}
 	paramIndex := 1
@@ -49,6 +25,19 @@ func (r *Repository) ListJobsApplications(ctx context.Context, params types.JobA
 		paramIndex++
 	}
 
+	// Yönetici olmayan kullanıcılar yalnızca ekibinde oldukları ilanların başvurularını görür
+	if params.ScopeUser != uuid.Nil {
+		whereClause += fmt.Sprintf(" AND EXISTS (SELECT 1 FROM job_hiring_team t WHERE t.job_id = a.job_id AND t.user_id = $%d)", paramIndex)
+		args = append(args, params.ScopeUser)
+		paramIndex++
+	}
+
+	if params.Tag != "" {
+		whereClause += fmt.Sprintf(" AND $%d = ANY(a.tags)", paramIndex)
+		args = append(args, params.Tag)
+		paramIndex++
+	}
+
 	if params.Status != "" {
 		whereClause += fmt.Sprintf(" AND a.status = $%d", paramIndex)
 		args = append(args, params.Status)
@@ -79,6 +68,42 @@ func (r *Repository) ListJobsApplications(ctx context.Context, params types.JobA
 		paramIndex++
 	}
 
+	return whereClause, args, paramIndex
+}
+
+func (r *Repository) ListJobsApplications(ctx context.Context, params types.JobApplicationSearchParams) ([]types.JobApplication, int, error) {
+	defer utils.TimeTrack(time.Now(), "Job -> Get Job Applications")
+
+	baseQuery := `
+		SELECT
+			a.id,
+			a.job_id,
+			a.full_name,
+			a.email,
+			a.phone,
+			a.form_type,
+			a.form_json,
+			a.status,
+			a.source,
+			a.tags,
+			a.language,
+			a.created_at,
+			a.updated_at,
+			d.title AS job_title
+		FROM job_applications a
+		LEFT JOIN job_postings p ON a.job_id = p.id
+		LEFT JOIN job_posting_details d ON p.id = d.id
+	`
+
+	countQuery := `
+		SELECT COUNT(*)
+		FROM job_applications a
+		LEFT JOIN job_postings p ON a.job_id = p.id
+		LEFT JOIN job_posting_details d ON p.id = d.id
+	`
+
+	whereClause, args, _ := applicationFilter(params)
+
 	orderClause := fmt.Sprintf(" ORDER BY a.%s %s", params.SortBy, params.SortOrder)
 	limitOffset := fmt.Sprintf(" LIMIT %d OFFSET %d", params.Limit, (params.Page-1)*params.Limit)
 
@@ -108,6 +133,9 @@ func (r *Repository) ListJobsApplications(ctx context.Context, params types.JobA
 			&app.FormType,
 			&app.FormJSON,
 			&app.Status,
+			&app.Source,
+			pq.Array(&app.Tags),
+			&app.Language,
 			&app.CreatedAt,
 			&app.UpdatedAt,
 			&jobTitle,
@@ -130,6 +158,7 @@ func (r *Repository) GetJobApplicationByID(ctx context.Context, applicationID uu
 
 	var app types.JobApplication
 	var jobTitle sql.NullString
+	var screening []byte
 
 	query := `
 		SELECT
@@ -141,9 +170,13 @@ func (r *Repository) GetJobApplicationByID(ctx context.Context, applicationID uu
 			a.form_type,
 			a.form_json,
 			a.status,
+			a.source,
+			a.tags,
+			a.language,
 			a.created_at,
 			a.updated_at,
-			d.title AS job_title
+			d.title AS job_title,
+			a.ai_screening
 		FROM job_applications a
 		LEFT JOIN job_postings p ON a.job_id = p.id
 		LEFT JOIN job_posting_details d ON p.id = d.id
@@ -159,9 +192,13 @@ func (r *Repository) GetJobApplicationByID(ctx context.Context, applicationID uu
 		&app.FormType,
 		&app.FormJSON,
 		&app.Status,
+		&app.Source,
+		pq.Array(&app.Tags),
+		&app.Language,
 		&app.CreatedAt,
 		&app.UpdatedAt,
 		&jobTitle,
+		&screening,
 	)
 
 	if err != nil {
@@ -171,6 +208,12 @@ func (r *Repository) GetJobApplicationByID(ctx context.Context, applicationID uu
 		return app, fmt.Errorf("başvuru getirilemedi: %w", err)
 	}
 
+	if len(screening) > 0 {
+		if err := json.Unmarshal(screening, &app.AIScreening); err != nil {
+			return app, fmt.Errorf("değerlendirme sonucu okunamadı: %w", err)
+		}
+	}
+
 	return app, nil
 }
 
@@ -187,6 +230,9 @@ func (r *Repository) GetJobApplicationsByEmail(ctx context.Context, email string
 			a.form_type,
 			a.form_json,
 			a.status,
+			a.source,
+			a.tags,
+			a.language,
 			a.created_at,
 			a.updated_at,
 			d.title AS job_title
@@ -217,6 +263,9 @@ func (r *Repository) GetJobApplicationsByEmail(ctx context.Context, email string
 			&app.FormType,
 			&app.FormJSON,
 			&app.Status,
+			&app.Source,
+			pq.Array(&app.Tags),
+			&app.Language,
 			&app.CreatedAt,
 			&app.UpdatedAt,
 			&jobTitle,
@@ -233,3 +282,34 @@ func (r *Repository) GetJobApplicationsByEmail(ctx context.Context, email string
 
 	return applications, nil
 }
+
+// GetApplicationRecipient başvuru sahibine e-posta göndermek için gereken bilgileri getirir
+func (r *Repository) GetApplicationRecipient(ctx context.Context, applicationID uuid.UUID) (types.JobApplicationRecipient, error) {
+	defer utils.TimeTrack(time.Now(), "Job -> Get Application Recipient")
+
+	var recipient types.JobApplicationRecipient
+
+	query := `
+		SELECT a.id, a.email, a.full_name, COALESCE(d.title, ''), a.language
+		FROM job_applications a
+		LEFT JOIN job_posting_details d ON a.job_id = d.id
+		WHERE a.id = $1
+	`
+
+	err := r.db.QueryRowContext(ctx, query, applicationID).Scan(
+		&recipient.ID,
+		&recipient.Email,
+		&recipient.FullName,
+		&recipient.JobTitle,
+		&recipient.Language,
+	)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return recipient, nil
+		}
+		return recipient, fmt.Errorf("başvuru sahibi bilgisi getirilemedi: %w", err)
+	}
+
+	return recipient, nil
+}
@@ -22,6 +22,11 @@ const jobBaseQuery = `
 								p.deadline,
 								p.created_at,
 								p.updated_at,
+								p.publish_at,
+								p.unpublish_at,
+								p.scheduled_by,
+								p.scheduled_at,
+								p.version,
 								d.title,
 								d.description,
 								d.image,
@@ -33,6 +38,7 @@ const jobBaseQuery = `
 								d.json,
 								d.form_type,
 								d.applicants,
+								d.language,
 								-- Kategorileri dizi olarak al
 								(
 												SELECT COALESCE(json_agg(
@@ -63,6 +69,11 @@ func scanJob(row *sql.Row) (types.JobView, error) {
 		&job.Deadline,
 		&job.CreatedAt,
 		&job.UpdatedAt,
+		&job.PublishAt,
+		&job.UnpublishAt,
+		&job.ScheduledBy,
+		&job.ScheduledAt,
+		&job.Version,
 		&details.Title,
 		&details.Description,
 		&details.Image,
@@ -74,6 +85,7 @@ func scanJob(row *sql.Row) (types.JobView, error) {
 		&details.JSON,
 		&details.FormType,
 		&details.Applicants,
+		&details.Language,
 		&categoriesJSON,
 	)
 
@@ -113,6 +125,11 @@ func scanJobs(rows *sql.Rows) ([]types.JobView, error) {
 			&job.Deadline,
 			&job.CreatedAt,
 			&job.UpdatedAt,
+			&job.PublishAt,
+			&job.UnpublishAt,
+			&job.ScheduledBy,
+			&job.ScheduledAt,
+			&job.Version,
 			&details.Title,
 			&details.Description,
 			&details.Image,
@@ -124,6 +141,7 @@ func scanJobs(rows *sql.Rows) ([]types.JobView, error) {
 			&details.JSON,
 			&details.FormType,
 			&details.Applicants,
+			&details.Language,
 			&categoriesJSON,
 		)
 		if err != nil {
@@ -212,17 +230,36 @@ func (r *Repository) ListJobs(ctx context.Context, params types.JobSearchParams)
 		paramIndex++
 	}
 
-	// Arama sorgusu (başlık ve açıklamada)
+	// Dil filtreleme
+	if params.Language != "" {
+		whereClause += fmt.Sprintf(" AND d.language = $%d", paramIndex)
+		args = append(args, params.Language)
+		paramIndex++
+	}
+
+	// Zamanlanmış ilanlar
+	if params.Scheduled {
+		whereClause += " AND (p.publish_at IS NOT NULL OR p.unpublish_at IS NOT NULL)"
+	}
+
+	// Tam metin arama (başlık, açıklama, lokasyon ve ilan metninde)
+	var tsQuery string
 	if params.Query != "" {
-		whereClause += fmt.Sprintf(" AND (d.title ILIKE $%d OR d.description ILIKE $%d)", paramIndex, paramIndex+1)
-		searchTerm := "%" + params.Query + "%"
-		args = append(args, searchTerm, searchTerm)
-		paramIndex += 2
+		search := jobTSQuery(params.Language, params.Query, paramIndex)
+		tsQuery = search.Query
+		whereClause += " AND " + search.Match
+		args = append(args, search.Args...)
+		paramIndex += len(search.Args)
 	}
 
 	// Sıralama
 	var orderBy string
 	switch params.SortBy {
+	case "relevance":
+		orderBy = "p.created_at"
+		if tsQuery != "" {
+			orderBy = fmt.Sprintf("ts_rank_cd(d.search_vector, %s) DESC, p.created_at", tsQuery)
+		}
 	case "title":
 		orderBy = "d.title"
 	case "deadline":
@@ -231,6 +268,10 @@ func (r *Repository) ListJobs(ctx context.Context, params types.JobSearchParams)
 		orderBy = "p.created_at"
 	case "updatedAt", "updated_at":
 		orderBy = "p.updated_at"
+	case "publishAt", "publish_at":
+		orderBy = "p.publish_at"
+	case "unpublishAt", "unpublish_at":
+		orderBy = "p.unpublish_at"
 	default:
 		orderBy = "p.created_at"
 	}
@@ -285,3 +326,22 @@ func (r *Repository) GetJobByID(ctx context.Context, id uuid.UUID) (types.JobVie
 	row := r.db.QueryRowContext(ctx, query, id)
 	return scanJob(row)
 }
+
+// GetJobVersion - İş ilanının güncel sürüm numarasını getirir (ETag kontrolü için), ilan yoksa 0 döner
+func (r *Repository) GetJobVersion(ctx context.Context, id uuid.UUID) (int, error) {
+	defer utils.TimeTrack(time.Now(), "Job -> Get Job Version")
+
+	var version int
+	err := r.db.QueryRowContext(ctx,
+		"SELECT version FROM job_postings WHERE id = $1 AND status != 'deleted'",
+		id).Scan(&version)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("iş ilanı sürümü getirilemedi: %w", err)
+	}
+
+	return version, nil
+}
@@ -0,0 +1,98 @@
+package JobRepository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// replaceHiringTeam - İlanın işe alım ekibini verilen üyelerle değiştirir (transaction içinde çağrılır)
+func replaceHiringTeam(ctx context.Context, tx *sql.Tx, jobID uuid.UUID, members []types.JobTeamMemberInput, addedBy uuid.UUID) error {
+	_, err := tx.ExecContext(ctx, "DELETE FROM job_hiring_team WHERE job_id = $1", jobID)
+	if err != nil {
+		return fmt.Errorf("işe alım ekibi temizlenemedi: %w", err)
+	}
+
+	for _, member := range members {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO job_hiring_team (job_id, user_id, role, added_by)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (job_id, user_id) DO UPDATE SET role = EXCLUDED.role
+		`, jobID, member.UserID, member.Role, addedBy)
+		if err != nil {
+			if pgErr, ok := err.(*pq.Error); ok && pgErr.Code == "23503" {
+				return fmt.Errorf("işe alım ekibindeki kullanıcı bulunamadı (ID: %s)", member.UserID)
+			}
+			return fmt.Errorf("işe alım ekibi üyesi eklenemedi: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// GetHiringTeam - İlanın işe alım ekibini kullanıcı bilgileriyle getirir
+func (r *Repository) GetHiringTeam(ctx context.Context, jobID uuid.UUID) ([]types.JobTeamMember, error) {
+	defer utils.TimeTrack(time.Now(), "Job -> Get Hiring Team")
+
+	query := `
+		SELECT t.user_id, u.username, u.email, t.role, t.added_by, t.created_at
+		FROM job_hiring_team t
+		JOIN users u ON u.id = t.user_id
+		WHERE t.job_id = $1
+		ORDER BY t.role, u.username
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, jobID)
+	if err != nil {
+		return nil, fmt.Errorf("işe alım ekibi getirilemedi: %w", err)
+	}
+	defer rows.Close()
+
+	members := []types.JobTeamMember{}
+	for rows.Next() {
+		var member types.JobTeamMember
+		if err := rows.Scan(
+			&member.UserID,
+			&member.Username,
+			&member.Email,
+			&member.Role,
+			&member.AddedBy,
+			&member.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("işe alım ekibi üyesi okunamadı: %w", err)
+		}
+		members = append(members, member)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("işe alım ekibi okunurken hata: %w", err)
+	}
+
+	return members, nil
+}
+
+// GetTeamRole - Kullanıcının ilandaki ekip rolünü döner, ekipte değilse boş döner
+func (r *Repository) GetTeamRole(ctx context.Context, jobID uuid.UUID, userID uuid.UUID) (types.JobTeamRole, error) {
+	defer utils.TimeTrack(time.Now(), "Job -> Get Team Role")
+
+	var role types.JobTeamRole
+	err := r.db.QueryRowContext(ctx,
+		"SELECT role FROM job_hiring_team WHERE job_id = $1 AND user_id = $2",
+		jobID, userID,
+	).Scan(&role)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", nil
+		}
+		return "", fmt.Errorf("ekip rolü getirilemedi: %w", err)
+	}
+
+	return role, nil
+}
@@ -0,0 +1,328 @@
+package JobRepository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/okanay/backend-holding/configs"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// interviewBaseQuery - Mülakatları aday ve ilan bilgileriyle birlikte getiren temel sorgu
+const interviewBaseQuery = `
+	SELECT
+		i.id, i.application_id, i.starts_at, i.ends_at, i.location, i.video_url, i.notes,
+		i.status, i.sequence, i.candidate_note, i.candidate_responded_at, i.created_by,
+		i.created_at, i.updated_at,
+		a.job_id, COALESCE(d.title, ''), a.full_name, a.email
+	FROM job_interviews i
+	JOIN job_applications a ON a.id = i.application_id
+	LEFT JOIN job_posting_details d ON d.id = a.job_id
+`
+
+// scanInterview - Tek satırı JobInterviewView'a dönüştürür
+func scanInterview(scanner interface{ Scan(dest ...any) error }) (types.JobInterviewView, error) {
+	var interview types.JobInterviewView
+	err := scanner.Scan(
+		&interview.ID,
+		&interview.ApplicationID,
+		&interview.StartsAt,
+		&interview.EndsAt,
+		&interview.Location,
+		&interview.VideoURL,
+		&interview.Notes,
+		&interview.Status,
+		&interview.Sequence,
+		&interview.CandidateNote,
+		&interview.CandidateRespondedAt,
+		&interview.CreatedBy,
+		&interview.CreatedAt,
+		&interview.UpdatedAt,
+		&interview.JobID,
+		&interview.JobTitle,
+		&interview.CandidateName,
+		&interview.CandidateEmail,
+	)
+	return interview, err
+}
+
+// queryInterviews - Verilen koşulla mülakatları ve mülakatçılarını getirir
+func (r *Repository) queryInterviews(ctx context.Context, where string, args ...any) ([]types.JobInterviewView, error) {
+	rows, err := r.db.QueryContext(ctx, interviewBaseQuery+where+" ORDER BY i.starts_at", args...)
+	if err != nil {
+		return nil, fmt.Errorf("mülakatlar getirilemedi: %w", err)
+	}
+	defer rows.Close()
+
+	interviews := []types.JobInterviewView{}
+	for rows.Next() {
+		interview, err := scanInterview(rows)
+		if err != nil {
+			return nil, fmt.Errorf("mülakat okunamadı: %w", err)
+		}
+		interviews = append(interviews, interview)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("mülakatlar okunurken hata: %w", err)
+	}
+
+	if err := r.attachInterviewers(ctx, interviews); err != nil {
+		return nil, err
+	}
+
+	return interviews, nil
+}
+
+// attachInterviewers - Mülakatların mülakatçılarını tek sorguda doldurur
+func (r *Repository) attachInterviewers(ctx context.Context, interviews []types.JobInterviewView) error {
+	if len(interviews) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(interviews))
+	index := make(map[uuid.UUID]int, len(interviews))
+	for i := range interviews {
+		ids[i] = interviews[i].ID.String()
+		index[interviews[i].ID] = i
+		interviews[i].Interviewers = []types.JobInterviewer{}
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT ii.interview_id, u.id, u.username, u.email
+		FROM job_interview_interviewers ii
+		JOIN users u ON u.id = ii.user_id
+		WHERE ii.interview_id = ANY($1::uuid[])
+		ORDER BY u.username
+	`, pq.Array(ids))
+	if err != nil {
+		return fmt.Errorf("mülakatçılar getirilemedi: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var interviewID uuid.UUID
+		var interviewer types.JobInterviewer
+		if err := rows.Scan(&interviewID, &interviewer.UserID, &interviewer.Username, &interviewer.Email); err != nil {
+			return fmt.Errorf("mülakatçı okunamadı: %w", err)
+		}
+		if i, ok := index[interviewID]; ok {
+			interviews[i].Interviewers = append(interviews[i].Interviewers, interviewer)
+		}
+	}
+
+	return rows.Err()
+}
+
+// replaceInterviewers - Mülakatçıları değiştirir ve ilanın işe alım ekibinde değillerse mülakatçı olarak ekler
+func replaceInterviewers(ctx context.Context, tx *sql.Tx, interviewID uuid.UUID, interviewers []uuid.UUID, addedBy uuid.UUID) error {
+	_, err := tx.ExecContext(ctx, "DELETE FROM job_interview_interviewers WHERE interview_id = $1", interviewID)
+	if err != nil {
+		return fmt.Errorf("mülakatçılar temizlenemedi: %w", err)
+	}
+
+	for _, userID := range interviewers {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO job_interview_interviewers (interview_id, user_id)
+			VALUES ($1, $2)
+			ON CONFLICT DO NOTHING
+		`, interviewID, userID)
+		if err != nil {
+			if pgErr, ok := err.(*pq.Error); ok && pgErr.Code == "23503" {
+				return fmt.Errorf("mülakatçı kullanıcı bulunamadı (ID: %s)", userID)
+			}
+			return fmt.Errorf("mülakatçı eklenemedi: %w", err)
+		}
+
+		// Mülakatçının adayın başvurusunu görebilmesi için ekibe ekle (mevcut rolü korunur)
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO job_hiring_team (job_id, user_id, role, added_by)
+			SELECT a.job_id, $2, 'interviewer', $3
+			FROM job_interviews i
+			JOIN job_applications a ON a.id = i.application_id
+			WHERE i.id = $1
+			ON CONFLICT (job_id, user_id) DO NOTHING
+		`, interviewID, userID, addedBy)
+		if err != nil {
+			return fmt.Errorf("mülakatçı ekibe eklenemedi: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// CreateInterview - Başvuru için mülakat planlar ve başvuruyu mülakat aşamasına taşır
+func (r *Repository) CreateInterview(ctx context.Context, applicationID uuid.UUID, input types.JobInterviewInput, userID uuid.UUID) (types.JobInterviewView, error) {
+	defer utils.TimeTrack(time.Now(), "Job -> Create Interview")
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return types.JobInterviewView{}, fmt.Errorf("transaction başlatılamadı: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Durum geçmişi trigger'ı için değişikliği yapan kullanıcıyı ayarla
+	_, err = tx.ExecContext(ctx, "SELECT set_config('app.current_user_id', $1, true)", userID.String())
+	if err != nil {
+		return types.JobInterviewView{}, fmt.Errorf("kullanıcı bilgisi ayarlanamadı: %w", err)
+	}
+
+	var interviewID uuid.UUID
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO job_interviews (application_id, starts_at, ends_at, location, video_url, notes, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id
+	`, applicationID, input.StartsAt, input.EndsAt, input.Location, input.VideoURL, input.Notes, userID).Scan(&interviewID)
+	if err != nil {
+		if pgErr, ok := err.(*pq.Error); ok && pgErr.Code == "23503" {
+			return types.JobInterviewView{}, fmt.Errorf("başvuru bulunamadı (ID: %s)", applicationID)
+		}
+		return types.JobInterviewView{}, fmt.Errorf("mülakat oluşturulamadı: %w", err)
+	}
+
+	if err = replaceInterviewers(ctx, tx, interviewID, input.Interviewers, userID); err != nil {
+		return types.JobInterviewView{}, err
+	}
+
+	// Başvuruyu mülakat aşamasına taşı (zaten bu aşamadaysa geçmişe tekrar yazılmaz)
+	_, err = tx.ExecContext(ctx, `
+		UPDATE job_applications
+		SET status = $1, updated_at = NOW()
+		WHERE id = $2 AND status != $1
+	`, configs.JOB_INTERVIEW_APPLICATION_STATUS, applicationID)
+	if err != nil {
+		return types.JobInterviewView{}, fmt.Errorf("başvuru durumu güncellenemedi: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return types.JobInterviewView{}, fmt.Errorf("transaction tamamlanamadı: %w", err)
+	}
+
+	return r.GetInterviewByID(ctx, interviewID)
+}
+
+// RescheduleInterview - Mülakatın zamanını ve detaylarını günceller, takvim sırası (sequence) artırılır
+func (r *Repository) RescheduleInterview(ctx context.Context, interviewID uuid.UUID, input types.JobInterviewInput, userID uuid.UUID) (types.JobInterviewView, error) {
+	defer utils.TimeTrack(time.Now(), "Job -> Reschedule Interview")
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return types.JobInterviewView{}, fmt.Errorf("transaction başlatılamadı: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE job_interviews
+		SET starts_at = $1, ends_at = $2, location = $3, video_url = $4, notes = $5,
+			status = 'scheduled', sequence = sequence + 1, updated_at = NOW()
+		WHERE id = $6 AND status NOT IN ('cancelled', 'completed')
+	`, input.StartsAt, input.EndsAt, input.Location, input.VideoURL, input.Notes, interviewID)
+	if err != nil {
+		return types.JobInterviewView{}, fmt.Errorf("mülakat güncellenemedi: %w", err)
+	}
+
+	if rowsAffected, _ := result.RowsAffected(); rowsAffected == 0 {
+		return types.JobInterviewView{}, fmt.Errorf("mülakat bulunamadı, iptal edilmiş veya tamamlanmış (ID: %s)", interviewID)
+	}
+
+	// Mülakatçılar gönderildiyse değiştir
+	if input.Interviewers != nil {
+		if err = replaceInterviewers(ctx, tx, interviewID, input.Interviewers, userID); err != nil {
+			return types.JobInterviewView{}, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return types.JobInterviewView{}, fmt.Errorf("transaction tamamlanamadı: %w", err)
+	}
+
+	return r.GetInterviewByID(ctx, interviewID)
+}
+
+// UpdateInterviewStatus - Ekip tarafından mülakatı iptal eder veya tamamlandı olarak işaretler
+func (r *Repository) UpdateInterviewStatus(ctx context.Context, interviewID uuid.UUID, status types.JobInterviewStatus) (types.JobInterviewView, error) {
+	defer utils.TimeTrack(time.Now(), "Job -> Update Interview Status")
+
+	// İptal edilen mülakatın takvim kaydının güncellenmesi için sequence artırılır
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE job_interviews
+		SET status = $1, sequence = sequence + 1, updated_at = NOW()
+		WHERE id = $2 AND status NOT IN ('cancelled', 'completed')
+	`, status, interviewID)
+	if err != nil {
+		return types.JobInterviewView{}, fmt.Errorf("mülakat durumu güncellenemedi: %w", err)
+	}
+
+	if rowsAffected, _ := result.RowsAffected(); rowsAffected == 0 {
+		return types.JobInterviewView{}, fmt.Errorf("mülakat bulunamadı, iptal edilmiş veya tamamlanmış (ID: %s)", interviewID)
+	}
+
+	return r.GetInterviewByID(ctx, interviewID)
+}
+
+// GetInterviewByID - Mülakatı getirir, bulunamazsa ID'si boş döner
+func (r *Repository) GetInterviewByID(ctx context.Context, interviewID uuid.UUID) (types.JobInterviewView, error) {
+	defer utils.TimeTrack(time.Now(), "Job -> Get Interview By ID")
+
+	interviews, err := r.queryInterviews(ctx, " WHERE i.id = $1", interviewID)
+	if err != nil {
+		return types.JobInterviewView{}, err
+	}
+
+	if len(interviews) == 0 {
+		return types.JobInterviewView{}, nil
+	}
+
+	return interviews[0], nil
+}
+
+// ListInterviewsByApplication - Başvurunun tüm mülakatlarını getirir
+func (r *Repository) ListInterviewsByApplication(ctx context.Context, applicationID uuid.UUID) ([]types.JobInterviewView, error) {
+	defer utils.TimeTrack(time.Now(), "Job -> List Interviews By Application")
+
+	return r.queryInterviews(ctx, " WHERE i.application_id = $1", applicationID)
+}
+
+// ListInterviewsByEmail - Adayın (takip oturumu) tüm mülakatlarını getirir
+func (r *Repository) ListInterviewsByEmail(ctx context.Context, email string) ([]types.JobInterviewView, error) {
+	defer utils.TimeTrack(time.Now(), "Job -> List Interviews By Email")
+
+	return r.queryInterviews(ctx, " WHERE a.email = $1", email)
+}
+
+// RespondToInterview - Adayın mülakatı onaylaması veya yeni zaman istemesi
+// Sadece adayın kendi başvurusuna ait, henüz başlamamış ve yanıtlanabilir durumdaki mülakatlar güncellenir.
+func (r *Repository) RespondToInterview(ctx context.Context, interviewID uuid.UUID, email string, status types.JobInterviewStatus, note string) (types.JobInterviewView, error) {
+	defer utils.TimeTrack(time.Now(), "Job -> Respond To Interview")
+
+	// Onay sadece planlanmış mülakata, yeniden planlama talebi onaylanmış mülakata da yapılabilir
+	allowed := []string{string(types.JobInterviewStatusScheduled)}
+	if status == types.JobInterviewStatusRescheduleRequested {
+		allowed = append(allowed, string(types.JobInterviewStatusConfirmed))
+	}
+
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE job_interviews i
+		SET status = $1, candidate_note = $2, candidate_responded_at = NOW(), updated_at = NOW()
+		FROM job_applications a
+		WHERE i.id = $3
+			AND a.id = i.application_id
+			AND a.email = $4
+			AND i.starts_at > NOW()
+			AND i.status::text = ANY($5)
+	`, status, note, interviewID, email, pq.Array(allowed))
+	if err != nil {
+		return types.JobInterviewView{}, fmt.Errorf("mülakat yanıtı kaydedilemedi: %w", err)
+	}
+
+	if rowsAffected, _ := result.RowsAffected(); rowsAffected == 0 {
+		return types.JobInterviewView{}, fmt.Errorf("mülakat bulunamadı veya bu işlem için uygun durumda değil (ID: %s)", interviewID)
+	}
+
+	return r.GetInterviewByID(ctx, interviewID)
+}
@@ -0,0 +1,105 @@
+package JobRepository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// ScheduleJob - İlanın zamanlanmış yayın/kapatma zamanlarını ve zamanlamayı yapan kullanıcıyı kaydeder
+func (r *Repository) ScheduleJob(ctx context.Context, jobID uuid.UUID, input types.ScheduleInput, userID uuid.UUID) error {
+	defer utils.TimeTrack(time.Now(), "Job -> Schedule Job")
+
+	// Her iki alan da boşsa zamanlama tamamen kaldırılır
+	query := `
+		UPDATE job_postings
+		SET publish_at = $1,
+			unpublish_at = $2,
+			scheduled_by = CASE WHEN $1::timestamptz IS NULL AND $2::timestamptz IS NULL THEN NULL ELSE $3::uuid END,
+			scheduled_at = CASE WHEN $1::timestamptz IS NULL AND $2::timestamptz IS NULL THEN NULL ELSE NOW() END,
+			updated_at = NOW()
+		WHERE id = $4 AND status != 'deleted'
+	`
+
+	result, err := r.db.ExecContext(ctx, query, input.PublishAt, input.UnpublishAt, userID, jobID)
+	if err != nil {
+		return fmt.Errorf("iş ilanı zamanlaması kaydedilemedi: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("etkilenen satır sayısı alınamadı: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("zamanlanacak iş ilanı bulunamadı")
+	}
+
+	return nil
+}
+
+// ApplyScheduledTransitions - Zamanı gelen ilanları yayınlar veya kapatır
+func (r *Repository) ApplyScheduledTransitions(ctx context.Context) (types.ScheduleTransitionResult, error) {
+	defer utils.TimeTrack(time.Now(), "Job -> Apply Scheduled Transitions")
+
+	var result types.ScheduleTransitionResult
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return result, fmt.Errorf("transaction başlatılamadı: %w", err)
+	}
+	defer tx.Rollback()
+
+	// 1. Yayın zamanı gelen taslak veya kapalı ilanları yayınla
+	rows, err := tx.QueryContext(ctx, `
+		UPDATE job_postings
+		SET status = 'published', publish_at = NULL, updated_at = NOW()
+		WHERE publish_at <= NOW() AND status IN ('draft', 'closed')
+		RETURNING id
+	`)
+	if err != nil {
+		return result, fmt.Errorf("zamanlanmış ilanlar yayınlanamadı: %w", err)
+	}
+
+	result.Published, err = utils.ScanUUIDs(rows)
+	if err != nil {
+		return result, fmt.Errorf("yayınlanan ilanlar okunamadı: %w", err)
+	}
+
+	// 2. Kapatma zamanı gelen yayındaki ilanları kapat
+	rows, err = tx.QueryContext(ctx, `
+		UPDATE job_postings
+		SET status = 'closed', unpublish_at = NULL, updated_at = NOW()
+		WHERE unpublish_at <= NOW() AND status = 'published'
+		RETURNING id
+	`)
+	if err != nil {
+		return result, fmt.Errorf("zamanlanmış ilanlar kapatılamadı: %w", err)
+	}
+
+	result.Unpublished, err = utils.ScanUUIDs(rows)
+	if err != nil {
+		return result, fmt.Errorf("kapatılan ilanlar okunamadı: %w", err)
+	}
+
+	// 3. Elle durum değiştirildiği için artık uygulanamayan geçmiş zamanlamaları temizle
+	_, err = tx.ExecContext(ctx, `
+		UPDATE job_postings
+		SET publish_at = CASE WHEN publish_at <= NOW() THEN NULL ELSE publish_at END,
+			unpublish_at = CASE WHEN unpublish_at <= NOW() THEN NULL ELSE unpublish_at END
+		WHERE publish_at <= NOW() OR unpublish_at <= NOW()
+	`)
+	if err != nil {
+		return result, fmt.Errorf("geçmiş zamanlamalar temizlenemedi: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return result, fmt.Errorf("transaction tamamlanamadı: %w", err)
+	}
+
+	return result, nil
+}
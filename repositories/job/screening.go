@@ -0,0 +1,34 @@
+package JobRepository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// SaveApplicationScreening yapay zeka değerlendirme sonucunu başvuru üzerine yazar (önceki sonuç değiştirilir)
+func (r *Repository) SaveApplicationScreening(ctx context.Context, applicationID uuid.UUID, screening types.ApplicationScreening) error {
+	defer utils.TimeTrack(time.Now(), "Job -> Save Application Screening")
+
+	data, err := json.Marshal(screening)
+	if err != nil {
+		return fmt.Errorf("değerlendirme sonucu hazırlanamadı: %w", err)
+	}
+
+	query := `
+		UPDATE job_applications
+		SET ai_screening = $1, ai_screened_at = $2
+		WHERE id = $3
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, data, screening.ScreenedAt, applicationID); err != nil {
+		return fmt.Errorf("değerlendirme sonucu kaydedilemedi: %w", err)
+	}
+
+	return nil
+}
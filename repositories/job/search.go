@@ -0,0 +1,90 @@
+package JobRepository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/okanay/backend-holding/configs"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// jobTSQuery - İlan araması için tsquery parçalarını üretir
+func jobTSQuery(language, query string, paramIndex int) utils.TSQuery {
+	return utils.BuildTSQuery("d.search_vector", "d.language", language, query, configs.GetSiteConfig().Languages, paramIndex)
+}
+
+// SearchJobs - Yayındaki iş ilanlarında alaka sırasına göre tam metin arama yapar
+func (r *Repository) SearchJobs(ctx context.Context, params types.SearchParams) ([]types.SearchResult, int, error) {
+	defer utils.TimeTrack(time.Now(), "Job -> Search Jobs")
+
+	search := jobTSQuery(params.Language, params.Query, 1)
+	whereClause := "p.status = 'published' AND " + search.Match
+	args := search.Args
+
+	if params.Language != "" {
+		args = append(args, params.Language)
+		whereClause += fmt.Sprintf(" AND d.language = $%d", len(args))
+	}
+
+	var total int
+	err := r.db.QueryRowContext(ctx, `
+		SELECT COUNT(*)
+		FROM job_postings p
+		INNER JOIN job_posting_details d ON d.id = p.id
+		WHERE `+whereClause, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("ilan arama sayımı yapılamadı: %w", err)
+	}
+
+	if total == 0 {
+		return []types.SearchResult{}, 0, nil
+	}
+
+	args = append(args, utils.SearchHeadlineOptions)
+
+	query := fmt.Sprintf(`
+		SELECT
+			p.id, p.slug, d.language, d.title, COALESCE(d.location, ''), NULLIF(d.image, ''),
+			COALESCE(p.published_at, p.created_at),
+			ts_rank_cd(d.search_vector, %[2]s) AS rank,
+			ts_headline(%[1]s,
+				COALESCE(d.description, '') || ' ' || regexp_replace(d.html, '<[^>]*>', ' ', 'g'),
+				%[2]s, $%[3]d)
+		FROM job_postings p
+		INNER JOIN job_posting_details d ON d.id = p.id
+		WHERE %[4]s
+		ORDER BY rank DESC, p.created_at DESC
+		LIMIT %[5]d OFFSET %[6]d
+	`, search.Config, search.Query, len(args), whereClause, params.Limit, (params.Page-1)*params.Limit)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("ilan araması yapılamadı: %w", err)
+	}
+	defer rows.Close()
+
+	results := []types.SearchResult{}
+	for rows.Next() {
+		result := types.SearchResult{Type: types.SearchResultJob}
+		var headline string
+		if err := rows.Scan(
+			&result.ID,
+			&result.Slug,
+			&result.Language,
+			&result.Title,
+			&result.Category,
+			&result.ImageURL,
+			&result.PublishedAt,
+			&result.Rank,
+			&headline,
+		); err != nil {
+			return nil, 0, fmt.Errorf("ilan arama sonucu okunamadı: %w", err)
+		}
+		result.Snippet = utils.HighlightSnippet(headline)
+		results = append(results, result)
+	}
+
+	return results, total, rows.Err()
+}
@@ -0,0 +1,37 @@
+package JobRepository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// ListSitemapEntries - Yayında olan ve son başvuru tarihi geçmemiş ilanları getirir
+func (r *Repository) ListSitemapEntries(ctx context.Context) ([]types.SitemapEntry, error) {
+	defer utils.TimeTrack(time.Now(), "Job -> List Sitemap Entries")
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT slug, updated_at
+		FROM job_postings
+		WHERE status = 'published' AND (deadline IS NULL OR deadline > NOW())
+		ORDER BY created_at DESC
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("sitemap ilanları getirilemedi: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []types.SitemapEntry
+	for rows.Next() {
+		var entry types.SitemapEntry
+		if err := rows.Scan(&entry.Slug, &entry.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("sitemap ilanı okunamadı: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
@@ -0,0 +1,81 @@
+package JobRepository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// HasApplications - E-posta adresine ait başvuru olup olmadığını kontrol eder
+func (r *Repository) HasApplications(ctx context.Context, email string) (bool, error) {
+	defer utils.TimeTrack(time.Now(), "Job -> Has Applications")
+
+	var exists bool
+	err := r.db.QueryRowContext(ctx,
+		"SELECT EXISTS(SELECT 1 FROM job_applications WHERE email = $1)",
+		email,
+	).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("başvuru kontrolü yapılamadı: %w", err)
+	}
+
+	return exists, nil
+}
+
+// CreateTrackingCode - Takip kodu kaydeder, kod başka bir kayıtla çakışırsa false döner
+func (r *Repository) CreateTrackingCode(ctx context.Context, email string, code string, expiresAt time.Time) (bool, error) {
+	defer utils.TimeTrack(time.Now(), "Job -> Create Tracking Code")
+
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO jobs_tracking_codes (email, tracking_code, expires_at)
+		VALUES ($1, $2, $3)
+	`, email, code, expiresAt)
+	if err != nil {
+		if pgErr, ok := err.(*pq.Error); ok && pgErr.Code == "23505" {
+			return false, nil
+		}
+		return false, fmt.Errorf("takip kodu kaydedilemedi: %w", err)
+	}
+
+	return true, nil
+}
+
+// UseTrackingCode - Geçerli takip kodunu kullanıldı olarak işaretler, kod geçersizse false döner
+func (r *Repository) UseTrackingCode(ctx context.Context, email string, code string) (bool, error) {
+	defer utils.TimeTrack(time.Now(), "Job -> Use Tracking Code")
+
+	var id string
+	err := r.db.QueryRowContext(ctx, `
+		UPDATE jobs_tracking_codes
+		SET is_used = TRUE, updated_at = NOW()
+		WHERE email = $1 AND tracking_code = $2 AND is_used = FALSE AND expires_at > NOW()
+		RETURNING id
+	`, email, code).Scan(&id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, fmt.Errorf("takip kodu doğrulanamadı: %w", err)
+	}
+
+	return true, nil
+}
+
+// CreateTrackingSession - Doğrulanan takip oturumunu kaydeder
+func (r *Repository) CreateTrackingSession(ctx context.Context, email, sessionToken, ipAddress, userAgent string, expiresAt time.Time) error {
+	defer utils.TimeTrack(time.Now(), "Job -> Create Tracking Session")
+
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO jobs_tracking_sessions (email, session_token, ip_address, user_agent, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`, email, sessionToken, ipAddress, userAgent, expiresAt)
+	if err != nil {
+		return fmt.Errorf("takip oturumu kaydedilemedi: %w", err)
+	}
+
+	return nil
+}
@@ -9,19 +9,36 @@ import (
 	"github.com/okanay/backend-holding/utils"
 )
 
-func (r *Repository) UpdateJobApplicationStatus(ctx context.Context, applicationID uuid.UUID, status string) error {
+// UpdateJobApplicationStatus başvuru durumunu günceller, değişikliği yapan kullanıcı durum geçmişine yazılır
+func (r *Repository) UpdateJobApplicationStatus(ctx context.Context, applicationID uuid.UUID, status string, userID uuid.UUID) error {
 	defer utils.TimeTrack(time.Now(), "Job -> Update Job Application Status")
 
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("transaction başlatılamadı: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Durum geçmişi trigger'ı için değişikliği yapan kullanıcıyı ayarla (sadece bu transaction için)
+	_, err = tx.ExecContext(ctx, "SELECT set_config('app.current_user_id', $1, true)", userID.String())
+	if err != nil {
+		return fmt.Errorf("kullanıcı bilgisi ayarlanamadı: %w", err)
+	}
+
 	query := `
 		UPDATE job_applications
 		SET status = $1, updated_at = NOW()
 		WHERE id = $2
 	`
 
-	_, err := r.db.ExecContext(ctx, query, status, applicationID)
+	_, err = tx.ExecContext(ctx, query, status, applicationID)
 	if err != nil {
 		return fmt.Errorf("başvuru durumu güncellenemedi: %w", err)
 	}
 
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("transaction tamamlanamadı: %w", err)
+	}
+
 	return nil
 }
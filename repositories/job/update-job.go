@@ -13,7 +13,9 @@ import (
 	"github.com/okanay/backend-holding/utils"
 )
 
-func (r *Repository) UpdateJob(ctx context.Context, jobID uuid.UUID, input types.JobInput, userID uuid.UUID) (types.Job, error) {
+// UpdateJob - İş ilanını günceller
+// expectedVersion 0 değilse ilan yalnızca bu sürümdeyken güncellenir, aksi halde VersionConflictError döner.
+func (r *Repository) UpdateJob(ctx context.Context, jobID uuid.UUID, input types.JobInput, userID uuid.UUID, expectedVersion int) (types.Job, error) {
 	defer utils.TimeTrack(time.Now(), "Job -> Update Job")
 	var job types.Job
 
@@ -27,8 +29,13 @@ func (r *Repository) UpdateJob(ctx context.Context, jobID uuid.UUID, input types
 	query := `
 		UPDATE job_postings
 		SET slug = $1, status = $2, deadline = $3, updated_at = NOW()
-		WHERE id = $4 AND user_id = $5 AND status != 'deleted'
-		RETURNING id, user_id, slug, status, deadline, created_at, updated_at
+		WHERE id = $4 AND status != 'deleted'
+			AND (user_id = $5 OR EXISTS (
+				SELECT 1 FROM job_hiring_team t
+				WHERE t.job_id = job_postings.id AND t.user_id = $5 AND t.role = 'owner'
+			))
+			AND ($6 = 0 OR version = $6)
+		RETURNING id, user_id, slug, status, deadline, created_at, updated_at, version
 	`
 
 	err = tx.QueryRowContext(
@@ -39,6 +46,7 @@ func (r *Repository) UpdateJob(ctx context.Context, jobID uuid.UUID, input types
 		input.Deadline,
 		jobID,
 		userID,
+		expectedVersion,
 	).Scan(
 		&job.ID,
 		&job.UserID,
@@ -47,10 +55,24 @@ func (r *Repository) UpdateJob(ctx context.Context, jobID uuid.UUID, input types
 		&job.Deadline,
 		&job.CreatedAt,
 		&job.UpdatedAt,
+		&job.Version,
 	)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
+			// Yetkili olunan ilan varsa güncellemeyi engelleyen tek koşul sürüm farkıdır
+			var current int
+			err = tx.QueryRowContext(ctx, `
+				SELECT version FROM job_postings
+				WHERE id = $1 AND status != 'deleted'
+					AND (user_id = $2 OR EXISTS (
+						SELECT 1 FROM job_hiring_team t
+						WHERE t.job_id = job_postings.id AND t.user_id = $2 AND t.role = 'owner'
+					))
+			`, jobID, userID).Scan(&current)
+			if err == nil && expectedVersion != 0 && current != expectedVersion {
+				return job, &types.VersionConflictError{CurrentVersion: current}
+			}
 			return job, fmt.Errorf("güncellenecek iş ilanı bulunamadı veya yetkiniz yok")
 		}
 		if pgErr, ok := err.(*pq.Error); ok && pgErr.Code == "23505" && pgErr.Constraint == "job_postings_slug_key" {
@@ -63,10 +85,11 @@ func (r *Repository) UpdateJob(ctx context.Context, jobID uuid.UUID, input types
 	detailsQuery := `
 		UPDATE job_posting_details
 		SET title = $1, description = $2, image = $3, location = $4, work_mode = $5, employment_type = $6,
-			experience_level = $7, html = $8, json = $9, form_type = $10
+			experience_level = $7, html = $8, json = $9, form_type = $10,
+			language = COALESCE(NULLIF($12, ''), language)
 		WHERE id = $11
 		RETURNING id, title, description, image, location, work_mode, employment_type,
-			experience_level, html, json, form_type, applicants
+			experience_level, html, json, form_type, applicants, language
 	`
 
 	var details types.JobDetails
@@ -84,6 +107,7 @@ func (r *Repository) UpdateJob(ctx context.Context, jobID uuid.UUID, input types
 		input.JSON,
 		input.FormType,
 		jobID,
+		input.Language,
 	).Scan(
 		&details.ID,
 		&details.Title,
@@ -97,6 +121,7 @@ func (r *Repository) UpdateJob(ctx context.Context, jobID uuid.UUID, input types
 		&details.JSON,
 		&details.FormType,
 		&details.Applicants,
+		&details.Language,
 	)
 
 	if err != nil {
@@ -133,6 +158,13 @@ func (r *Repository) UpdateJob(ctx context.Context, jobID uuid.UUID, input types
 		}
 	}
 
+	// İşe alım ekibi gönderildiyse mevcut ekibin yerine geçer
+	if input.HiringTeam != nil {
+		if err = replaceHiringTeam(ctx, tx, jobID, input.HiringTeam, userID); err != nil {
+			return job, err
+		}
+	}
+
 	if err = tx.Commit(); err != nil {
 		return job, fmt.Errorf("işlem tamamlanamadı: %w", err)
 	}
@@ -0,0 +1,83 @@
+package JobAlertRepository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/okanay/backend-holding/configs"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// CreateSubscription - Onay bekleyen (pending) iş alarmı aboneliği oluşturur
+// E-posta için zaten onay bekleyen bir abonelik varsa yenisi açılmaz, mevcut satırın filtreleri güncellenir.
+// Dönen bool, onay e-postasının gönderilmesi gerekip gerekmediğini belirtir; bekleme süresi dolmadan tekrar gönderilmez.
+func (r *Repository) CreateSubscription(ctx context.Context, input types.JobAlertSubscribeInput, ipAddress string) (types.JobAlertSubscription, bool, error) {
+	defer utils.TimeTrack(time.Now(), "JobAlert -> Create Subscription")
+
+	frequency := types.JobAlertFrequencyDaily
+	if input.Frequency != "" {
+		frequency = input.Frequency
+	}
+
+	categories := input.Categories
+	if categories == nil {
+		categories = []string{}
+	}
+
+	query := `
+		INSERT INTO job_alert_subscriptions (email, categories, location, work_mode, frequency, ip_address, confirmation_sent_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW())
+		ON CONFLICT (email) WHERE status = 'pending' DO UPDATE
+		SET categories = EXCLUDED.categories,
+			location = EXCLUDED.location,
+			work_mode = EXCLUDED.work_mode,
+			frequency = EXCLUDED.frequency,
+			ip_address = EXCLUDED.ip_address,
+			confirmation_sent_at = CASE
+				WHEN job_alert_subscriptions.confirmation_sent_at IS NULL
+					OR job_alert_subscriptions.confirmation_sent_at < NOW() - make_interval(secs => $7)
+				THEN NOW()
+				ELSE job_alert_subscriptions.confirmation_sent_at
+			END,
+			updated_at = NOW()
+		RETURNING ` + subscriptionColumns + `, confirmation_sent_at = NOW()`
+
+	row := r.db.QueryRowContext(
+		ctx,
+		query,
+		strings.ToLower(strings.TrimSpace(input.Email)),
+		pq.Array(categories),
+		strings.TrimSpace(input.Location),
+		strings.TrimSpace(input.WorkMode),
+		frequency,
+		ipAddress,
+		configs.JOB_ALERT_CONFIRM_COOLDOWN.Seconds(),
+	)
+
+	var sendConfirmation bool
+	subscription, err := scanSubscription(scanFunc(func(dest ...any) error {
+		return row.Scan(append(dest, &sendConfirmation)...)
+	}))
+	if err != nil {
+		return subscription, false, fmt.Errorf("iş alarmı aboneliği oluşturulamadı: %w", err)
+	}
+
+	return subscription, sendConfirmation, nil
+}
+
+// ResetConfirmationSent - Onay e-postası gönderilemediğinde bekleme süresini sıfırlar, kullanıcı hemen tekrar deneyebilir
+func (r *Repository) ResetConfirmationSent(ctx context.Context, id uuid.UUID) error {
+	defer utils.TimeTrack(time.Now(), "JobAlert -> Reset Confirmation Sent")
+
+	_, err := r.db.ExecContext(ctx, "UPDATE job_alert_subscriptions SET confirmation_sent_at = NULL WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("onay gönderim zamanı sıfırlanamadı: %w", err)
+	}
+
+	return nil
+}
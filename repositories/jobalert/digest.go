@@ -0,0 +1,127 @@
+package JobAlertRepository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// ListPendingJobsForSubscription - Aboneliğin filtrelerine uyan ve henüz gönderilmemiş yayındaki ilanları getirir
+func (r *Repository) ListPendingJobsForSubscription(ctx context.Context, subscription types.JobAlertSubscription) ([]types.JobAlertJob, error) {
+	defer utils.TimeTrack(time.Now(), "JobAlert -> List Pending Jobs For Subscription")
+
+	query := `
+		SELECT p.id, p.slug, d.title, d.location, d.work_mode, d.employment_type, p.deadline, p.published_at
+		FROM job_postings p
+		JOIN job_posting_details d ON p.id = d.id
+		WHERE p.status = 'published'
+			AND p.published_at IS NOT NULL
+			AND p.published_at >= $1
+			AND (p.deadline IS NULL OR p.deadline > NOW())
+			AND NOT EXISTS (
+				SELECT 1 FROM job_alert_deliveries jad
+				WHERE jad.subscription_id = $2 AND jad.job_id = p.id
+			)
+	`
+
+	// Abonelik onaylanmadan önce yayınlanan ilanlar gönderilmez
+	since := subscription.CreatedAt
+	if subscription.ConfirmedAt != nil {
+		since = *subscription.ConfirmedAt
+	}
+
+	args := []any{since, subscription.ID}
+	paramIndex := 3
+
+	// Kategori filtreleme
+	if len(subscription.Categories) > 0 {
+		query += fmt.Sprintf(" AND EXISTS (SELECT 1 FROM job_posting_categories jpc WHERE jpc.job_id = p.id AND jpc.category_name = ANY($%d))", paramIndex)
+		args = append(args, pq.Array(subscription.Categories))
+		paramIndex++
+	}
+
+	// Lokasyon filtreleme
+	if subscription.Location != "" {
+		query += fmt.Sprintf(" AND d.location ILIKE $%d", paramIndex)
+		args = append(args, "%"+subscription.Location+"%")
+		paramIndex++
+	}
+
+	// Work mode filtreleme
+	if subscription.WorkMode != "" {
+		query += fmt.Sprintf(" AND d.work_mode = $%d", paramIndex)
+		args = append(args, subscription.WorkMode)
+	}
+
+	query += " ORDER BY p.published_at DESC"
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("bildirilecek ilanlar getirilemedi: %w", err)
+	}
+	defer rows.Close()
+
+	var jobs []types.JobAlertJob
+	for rows.Next() {
+		var job types.JobAlertJob
+		if err := rows.Scan(
+			&job.ID,
+			&job.Slug,
+			&job.Title,
+			&job.Location,
+			&job.WorkMode,
+			&job.EmploymentType,
+			&job.Deadline,
+			&job.PublishedAt,
+		); err != nil {
+			return nil, fmt.Errorf("ilan okunamadı: %w", err)
+		}
+		jobs = append(jobs, job)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ilanlar okunurken hata: %w", err)
+	}
+
+	return jobs, nil
+}
+
+// MarkJobsDelivered - Gönderilen ilanları kaydeder ve aboneliğin son bildirim zamanını günceller
+func (r *Repository) MarkJobsDelivered(ctx context.Context, subscriptionID uuid.UUID, jobIDs []uuid.UUID) error {
+	defer utils.TimeTrack(time.Now(), "JobAlert -> Mark Jobs Delivered")
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("transaction başlatılamadı: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, jobID := range jobIDs {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO job_alert_deliveries (subscription_id, job_id)
+			VALUES ($1, $2)
+			ON CONFLICT (subscription_id, job_id) DO NOTHING
+		`, subscriptionID, jobID)
+		if err != nil {
+			return fmt.Errorf("gönderim kaydı eklenemedi: %w", err)
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE job_alert_subscriptions SET last_notified_at = NOW() WHERE id = $1
+	`, subscriptionID)
+	if err != nil {
+		return fmt.Errorf("son bildirim zamanı güncellenemedi: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("transaction tamamlanamadı: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package JobAlertRepository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// GetSubscriptionByID - ID'ye göre aboneliği getirir, bulunamazsa boş ID ile döner
+func (r *Repository) GetSubscriptionByID(ctx context.Context, id uuid.UUID) (types.JobAlertSubscription, error) {
+	defer utils.TimeTrack(time.Now(), "JobAlert -> Get Subscription By ID")
+
+	query := `SELECT ` + subscriptionColumns + ` FROM job_alert_subscriptions WHERE id = $1`
+
+	subscription, err := scanSubscription(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return types.JobAlertSubscription{}, nil
+		}
+		return subscription, fmt.Errorf("abonelik getirilemedi: %w", err)
+	}
+
+	return subscription, nil
+}
+
+// ListActiveSubscriptions - Aktif abonelikleri getirir, frequency boşsa tüm sıklıklar döner
+func (r *Repository) ListActiveSubscriptions(ctx context.Context, frequency types.JobAlertFrequency) ([]types.JobAlertSubscription, error) {
+	defer utils.TimeTrack(time.Now(), "JobAlert -> List Active Subscriptions")
+
+	query := `SELECT ` + subscriptionColumns + ` FROM job_alert_subscriptions WHERE status = $1`
+	args := []any{types.JobAlertStatusActive}
+
+	if frequency != "" {
+		query += ` AND frequency = $2`
+		args = append(args, frequency)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("abonelikler getirilemedi: %w", err)
+	}
+	defer rows.Close()
+
+	var subscriptions []types.JobAlertSubscription
+	for rows.Next() {
+		subscription, err := scanSubscription(rows)
+		if err != nil {
+			return nil, fmt.Errorf("abonelik okunamadı: %w", err)
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("abonelikler okunurken hata: %w", err)
+	}
+
+	return subscriptions, nil
+}
+
+// ListSubscriptionsByEmail - E-posta adresine ait tüm abonelikleri getirir (kişisel veri dışa aktarımı için)
+func (r *Repository) ListSubscriptionsByEmail(ctx context.Context, email string) ([]types.JobAlertSubscription, error) {
+	defer utils.TimeTrack(time.Now(), "JobAlert -> List Subscriptions By Email")
+
+	query := `SELECT ` + subscriptionColumns + ` FROM job_alert_subscriptions WHERE email = $1 ORDER BY created_at`
+
+	rows, err := r.db.QueryContext(ctx, query, email)
+	if err != nil {
+		return nil, fmt.Errorf("abonelikler getirilemedi: %w", err)
+	}
+	defer rows.Close()
+
+	subscriptions := []types.JobAlertSubscription{}
+	for rows.Next() {
+		subscription, err := scanSubscription(rows)
+		if err != nil {
+			return nil, fmt.Errorf("abonelik okunamadı: %w", err)
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	return subscriptions, rows.Err()
+}
@@ -0,0 +1,52 @@
+package JobAlertRepository
+
+import (
+	"database/sql"
+
+	"github.com/lib/pq"
+	"github.com/okanay/backend-holding/types"
+)
+
+type Repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// subscriptionColumns - Abonelik sorgularında kullanılan ortak kolon listesi
+const subscriptionColumns = `
+	id, email, categories, location, work_mode, frequency, status,
+	confirmed_at, unsubscribed_at, last_notified_at, unsubscribe_token, created_at, updated_at
+`
+
+// scanFunc - Sorguya özel ek kolonları okuyabilmek için Scan fonksiyonunu scanner olarak sarar
+type scanFunc func(dest ...any) error
+
+func (f scanFunc) Scan(dest ...any) error {
+	return f(dest...)
+}
+
+// scanSubscription - Tek bir satırı JobAlertSubscription struct'ına dönüştürür
+func scanSubscription(scanner interface{ Scan(dest ...any) error }) (types.JobAlertSubscription, error) {
+	var subscription types.JobAlertSubscription
+
+	err := scanner.Scan(
+		&subscription.ID,
+		&subscription.Email,
+		pq.Array(&subscription.Categories),
+		&subscription.Location,
+		&subscription.WorkMode,
+		&subscription.Frequency,
+		&subscription.Status,
+		&subscription.ConfirmedAt,
+		&subscription.UnsubscribedAt,
+		&subscription.LastNotifiedAt,
+		&subscription.UnsubscribeToken,
+		&subscription.CreatedAt,
+		&subscription.UpdatedAt,
+	)
+
+	return subscription, err
+}
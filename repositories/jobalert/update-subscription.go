@@ -0,0 +1,145 @@
+package JobAlertRepository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// ConfirmSubscription - Çift onay linki ile aboneliği aktifleştirir
+func (r *Repository) ConfirmSubscription(ctx context.Context, id uuid.UUID) (types.JobAlertSubscription, error) {
+	defer utils.TimeTrack(time.Now(), "JobAlert -> Confirm Subscription")
+
+	query := `
+		UPDATE job_alert_subscriptions
+		SET status = $1,
+			confirmed_at = COALESCE(confirmed_at, NOW()),
+			unsubscribed_at = NULL,
+			updated_at = NOW()
+		WHERE id = $2
+		RETURNING ` + subscriptionColumns
+
+	subscription, err := scanSubscription(r.db.QueryRowContext(ctx, query, types.JobAlertStatusActive, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return subscription, fmt.Errorf("onaylanacak abonelik bulunamadı")
+		}
+		return subscription, fmt.Errorf("abonelik onaylanamadı: %w", err)
+	}
+
+	return subscription, nil
+}
+
+// UpdateSubscriptionFilters - Abonelik filtrelerini günceller
+func (r *Repository) UpdateSubscriptionFilters(ctx context.Context, id uuid.UUID, input types.JobAlertUpdateInput) (types.JobAlertSubscription, error) {
+	defer utils.TimeTrack(time.Now(), "JobAlert -> Update Subscription Filters")
+
+	categories := input.Categories
+	if categories == nil {
+		categories = []string{}
+	}
+
+	query := `
+		UPDATE job_alert_subscriptions
+		SET categories = $1,
+			location = $2,
+			work_mode = $3,
+			frequency = COALESCE(NULLIF($4, '')::job_alert_frequency, frequency),
+			updated_at = NOW()
+		WHERE id = $5 AND status != $6
+		RETURNING ` + subscriptionColumns
+
+	subscription, err := scanSubscription(r.db.QueryRowContext(
+		ctx,
+		query,
+		pq.Array(categories),
+		strings.TrimSpace(input.Location),
+		strings.TrimSpace(input.WorkMode),
+		string(input.Frequency),
+		id,
+		types.JobAlertStatusUnsubscribed,
+	))
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return subscription, fmt.Errorf("güncellenecek abonelik bulunamadı veya abonelikten çıkılmış")
+		}
+		return subscription, fmt.Errorf("abonelik güncellenemedi: %w", err)
+	}
+
+	return subscription, nil
+}
+
+// Unsubscribe - Aboneliği sonlandırır
+func (r *Repository) Unsubscribe(ctx context.Context, id uuid.UUID) error {
+	defer utils.TimeTrack(time.Now(), "JobAlert -> Unsubscribe")
+
+	query := `
+		UPDATE job_alert_subscriptions
+		SET status = $1, unsubscribed_at = NOW(), updated_at = NOW()
+		WHERE id = $2
+	`
+
+	result, err := r.db.ExecContext(ctx, query, types.JobAlertStatusUnsubscribed, id)
+	if err != nil {
+		return fmt.Errorf("abonelikten çıkılamadı: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("etkilenen satır sayısı alınamadı: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("abonelik bulunamadı")
+	}
+
+	return nil
+}
+
+// UnsubscribeByToken - Abonelik satırındaki opak token ile aboneliği sonlandırır
+func (r *Repository) UnsubscribeByToken(ctx context.Context, token string) error {
+	defer utils.TimeTrack(time.Now(), "JobAlert -> Unsubscribe By Token")
+
+	query := `
+		UPDATE job_alert_subscriptions
+		SET status = $1, unsubscribed_at = COALESCE(unsubscribed_at, NOW()), updated_at = NOW()
+		WHERE unsubscribe_token = $2
+	`
+
+	result, err := r.db.ExecContext(ctx, query, types.JobAlertStatusUnsubscribed, token)
+	if err != nil {
+		return fmt.Errorf("abonelikten çıkılamadı: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("etkilenen satır sayısı alınamadı: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("abonelik bulunamadı")
+	}
+
+	return nil
+}
+
+// DeleteSubscriptionsByEmail - E-posta adresine ait tüm abonelikleri ve gönderim kayıtlarını siler (kişisel veri silme talebi)
+func (r *Repository) DeleteSubscriptionsByEmail(ctx context.Context, email string) (int, error) {
+	defer utils.TimeTrack(time.Now(), "JobAlert -> Delete Subscriptions By Email")
+
+	result, err := r.db.ExecContext(ctx, "DELETE FROM job_alert_subscriptions WHERE email = $1", email)
+	if err != nil {
+		return 0, fmt.Errorf("abonelikler silinemedi: %w", err)
+	}
+
+	deleted, _ := result.RowsAffected()
+	return int(deleted), nil
+}
@@ -0,0 +1,84 @@
+package OutboxRepository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/configs"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// ClaimPending - Gönderim zamanı gelen e-postaları kilitleyerek alır
+// SKIP LOCKED sayesinde birden fazla sunucu aynı kaydı almaz, yarıda kalan gönderimler kilit süresi dolunca tekrar alınır.
+func (r *Repository) ClaimPending(ctx context.Context, limit int) ([]types.OutboxMessage, error) {
+	defer utils.TimeTrack(time.Now(), "Outbox -> Claim Pending")
+
+	rows, err := r.db.QueryContext(ctx, `
+		UPDATE email_outbox
+		SET status = 'sending', locked_at = NOW(), attempts = attempts + 1
+		WHERE id IN (
+			SELECT id FROM email_outbox
+			WHERE send_after <= NOW()
+				AND (status = 'pending' OR (status = 'sending' AND locked_at < $1))
+			ORDER BY send_after
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, to_email, subject, text_body, html_body, attempts
+	`, time.Now().Add(-configs.OUTBOX_LOCK_TIMEOUT), limit)
+	if err != nil {
+		return nil, fmt.Errorf("e-posta kuyruğu okunamadı: %w", err)
+	}
+	defer rows.Close()
+
+	messages := []types.OutboxMessage{}
+	for rows.Next() {
+		var message types.OutboxMessage
+		if err := rows.Scan(&message.ID, &message.To, &message.Subject, &message.Text, &message.HTML, &message.Attempts); err != nil {
+			return nil, fmt.Errorf("kuyruktaki e-posta okunamadı: %w", err)
+		}
+		messages = append(messages, message)
+	}
+
+	return messages, rows.Err()
+}
+
+// MarkSent - E-postayı gönderildi olarak işaretler
+// Alıcı ve içerik artık gerekmediğinden silinir, kayıt sadece gönderim istatistiği olarak kalır.
+func (r *Repository) MarkSent(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE email_outbox
+		SET status = 'sent', sent_at = NOW(), locked_at = NULL, last_error = '',
+			to_email = '', subject = '', text_body = '', html_body = ''
+		WHERE id = $1
+	`, id)
+	if err != nil {
+		return fmt.Errorf("e-posta durumu güncellenemedi: %w", err)
+	}
+
+	return nil
+}
+
+// MarkFailed - Gönderilemeyen e-postayı tekrar denenmek üzere bekletir, deneme hakkı bittiyse 'failed' yapar
+func (r *Repository) MarkFailed(ctx context.Context, message types.OutboxMessage, sendErr error) error {
+	status := "pending"
+	if message.Attempts >= configs.OUTBOX_MAX_ATTEMPTS {
+		status = "failed"
+	}
+
+	retryAt := time.Now().Add(time.Duration(message.Attempts) * configs.OUTBOX_RETRY_DELAY)
+
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE email_outbox
+		SET status = $1, last_error = $2, send_after = $3, locked_at = NULL
+		WHERE id = $4
+	`, status, sendErr.Error(), retryAt, message.ID)
+	if err != nil {
+		return fmt.Errorf("e-posta durumu güncellenemedi: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package OutboxRepository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// EnqueueTx - E-postaları verilen transaction içinde kuyruğa yazar
+// Diğer repository'ler kendi işlemleriyle aynı transaction'da e-posta kuyruklamak için kullanır.
+func EnqueueTx(ctx context.Context, tx *sql.Tx, messages []types.OutboxMessage) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	stmt, err := tx.PrepareContext(ctx, `
+		INSERT INTO email_outbox (to_email, subject, text_body, html_body)
+		VALUES ($1, $2, $3, $4)
+	`)
+	if err != nil {
+		return fmt.Errorf("e-posta kuyruğu hazırlanamadı: %w", err)
+	}
+	defer stmt.Close()
+
+	for _, message := range messages {
+		if _, err := stmt.ExecContext(ctx, message.To, message.Subject, message.Text, message.HTML); err != nil {
+			return fmt.Errorf("e-posta kuyruğa eklenemedi: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Enqueue - E-postaları kuyruğa yazar
+func (r *Repository) Enqueue(ctx context.Context, messages []types.OutboxMessage) error {
+	defer utils.TimeTrack(time.Now(), "Outbox -> Enqueue")
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("transaction başlatılamadı: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := EnqueueTx(ctx, tx, messages); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("transaction tamamlanamadı: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package OutboxRepository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/okanay/backend-holding/utils"
+)
+
+// DeleteByEmail - Alıcısı verilen e-posta olan tüm kuyruk kayıtlarını siler (kişisel veri silme talebi)
+// Gönderilmeyi bekleyen e-postalar da silinir, silme talebinden sonra adaya e-posta gitmez.
+func (r *Repository) DeleteByEmail(ctx context.Context, email string) (int, error) {
+	defer utils.TimeTrack(time.Now(), "Outbox -> Delete By Email")
+
+	result, err := r.db.ExecContext(ctx, "DELETE FROM email_outbox WHERE LOWER(to_email) = LOWER($1)", email)
+	if err != nil {
+		return 0, fmt.Errorf("e-posta kuyruğu kayıtları silinemedi: %w", err)
+	}
+
+	deleted, _ := result.RowsAffected()
+	return int(deleted), nil
+}
+
+// PurgeBefore - Verilen zamandan önce oluşturulmuş, gönderilmiş veya kalıcı olarak başarısız kuyruk kayıtlarını siler
+func (r *Repository) PurgeBefore(ctx context.Context, before time.Time) (int, error) {
+	defer utils.TimeTrack(time.Now(), "Outbox -> Purge Before")
+
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM email_outbox
+		WHERE status IN ('sent', 'failed') AND created_at < $1
+	`, before)
+	if err != nil {
+		return 0, fmt.Errorf("eski e-posta kuyruğu kayıtları silinemedi: %w", err)
+	}
+
+	deleted, _ := result.RowsAffected()
+	return int(deleted), nil
+}
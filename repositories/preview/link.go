@@ -0,0 +1,139 @@
+package PreviewRepository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+const linkColumns = `
+	id, resource_type, resource_id, created_by, note,
+	expires_at, revoked_at, view_count, last_viewed_at, created_at
+`
+
+// CreateLink - Yeni önizleme linki kaydı oluşturur
+func (r *Repository) CreateLink(ctx context.Context, resource types.PreviewResource, resourceID, userID uuid.UUID, note string, expiresAt time.Time) (types.PreviewLink, error) {
+	defer utils.TimeTrack(time.Now(), "Preview -> Create Link")
+
+	query := `
+		INSERT INTO preview_links (resource_type, resource_id, created_by, note, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING ` + linkColumns
+
+	link, err := scanLink(r.db.QueryRowContext(ctx, query, resource, resourceID, userID, note, expiresAt))
+	if err != nil {
+		return link, fmt.Errorf("önizleme linki oluşturulamadı: %w", err)
+	}
+
+	return link, nil
+}
+
+// GetLink - ID'ye göre önizleme linkini getirir, bulunamazsa boş döner
+func (r *Repository) GetLink(ctx context.Context, linkID uuid.UUID) (types.PreviewLink, error) {
+	defer utils.TimeTrack(time.Now(), "Preview -> Get Link")
+
+	query := `SELECT ` + linkColumns + ` FROM preview_links WHERE id = $1`
+
+	link, err := scanLink(r.db.QueryRowContext(ctx, query, linkID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return types.PreviewLink{}, nil
+		}
+		return link, fmt.Errorf("önizleme linki getirilemedi: %w", err)
+	}
+
+	return link, nil
+}
+
+// ListLinks - Bir kaydın tüm önizleme linklerini yeniden eskiye listeler
+func (r *Repository) ListLinks(ctx context.Context, resource types.PreviewResource, resourceID uuid.UUID) ([]types.PreviewLink, error) {
+	defer utils.TimeTrack(time.Now(), "Preview -> List Links")
+
+	query := `
+		SELECT ` + linkColumns + `
+		FROM preview_links
+		WHERE resource_type = $1 AND resource_id = $2
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, resource, resourceID)
+	if err != nil {
+		return nil, fmt.Errorf("önizleme linkleri getirilemedi: %w", err)
+	}
+	defer rows.Close()
+
+	links := []types.PreviewLink{}
+	for rows.Next() {
+		link, err := scanLink(rows)
+		if err != nil {
+			return nil, fmt.Errorf("satır okuma hatası: %w", err)
+		}
+		links = append(links, link)
+	}
+
+	return links, rows.Err()
+}
+
+// RevokeLink - Önizleme linkini iptal eder
+func (r *Repository) RevokeLink(ctx context.Context, resource types.PreviewResource, resourceID, linkID uuid.UUID) error {
+	defer utils.TimeTrack(time.Now(), "Preview -> Revoke Link")
+
+	query := `
+		UPDATE preview_links
+		SET revoked_at = NOW()
+		WHERE id = $1 AND resource_type = $2 AND resource_id = $3 AND revoked_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, query, linkID, resource, resourceID)
+	if err != nil {
+		return fmt.Errorf("önizleme linki iptal edilemedi: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("etkilenen satır sayısı alınamadı: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("önizleme linki bulunamadı veya zaten iptal edilmiş (ID: %s)", linkID)
+	}
+
+	return nil
+}
+
+// RecordView - Linkin görüntülenme sayısını ve son görüntülenme zamanını günceller
+func (r *Repository) RecordView(ctx context.Context, linkID uuid.UUID) error {
+	_, err := r.db.ExecContext(ctx,
+		"UPDATE preview_links SET view_count = view_count + 1, last_viewed_at = NOW() WHERE id = $1",
+		linkID)
+	if err != nil {
+		return fmt.Errorf("görüntülenme kaydedilemedi: %w", err)
+	}
+
+	return nil
+}
+
+// scanLink - Tek bir satırı PreviewLink struct'ına dönüştürür
+func scanLink(scanner interface{ Scan(dest ...any) error }) (types.PreviewLink, error) {
+	var link types.PreviewLink
+
+	err := scanner.Scan(
+		&link.ID,
+		&link.ResourceType,
+		&link.ResourceID,
+		&link.CreatedBy,
+		&link.Note,
+		&link.ExpiresAt,
+		&link.RevokedAt,
+		&link.ViewCount,
+		&link.LastViewedAt,
+		&link.CreatedAt,
+	)
+
+	return link, err
+}
@@ -0,0 +1,154 @@
+package PrivacyRepository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/okanay/backend-holding/configs"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// scanApplicantRecords - Başvuru ID ve form verisi satırlarını okur
+func scanApplicantRecords(rows *sql.Rows) ([]types.ApplicantRecord, error) {
+	defer rows.Close()
+
+	records := []types.ApplicantRecord{}
+	for rows.Next() {
+		var record types.ApplicantRecord
+		if err := rows.Scan(&record.ID, &record.FormJSON); err != nil {
+			return nil, fmt.Errorf("başvuru okunamadı: %w", err)
+		}
+		records = append(records, record)
+	}
+
+	return records, rows.Err()
+}
+
+// ListExpiredApplications - Son işlemi verilen tarihten eski ve henüz anonimleştirilmemiş başvuruları getirir
+func (r *Repository) ListExpiredApplications(ctx context.Context, before time.Time, limit int) ([]types.ApplicantRecord, error) {
+	defer utils.TimeTrack(time.Now(), "Privacy -> List Expired Applications")
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, form_json::text
+		FROM job_applications
+		WHERE anonymized_at IS NULL AND updated_at < $1
+		ORDER BY updated_at
+		LIMIT $2
+	`, before, limit)
+	if err != nil {
+		return nil, fmt.Errorf("süresi dolan başvurular getirilemedi: %w", err)
+	}
+
+	return scanApplicantRecords(rows)
+}
+
+// ListApplicationsByEmail - Adayın anonimleştirilmemiş tüm başvurularını getirir
+func (r *Repository) ListApplicationsByEmail(ctx context.Context, email string) ([]types.ApplicantRecord, error) {
+	defer utils.TimeTrack(time.Now(), "Privacy -> List Applications By Email")
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, form_json::text
+		FROM job_applications
+		WHERE email = $1 AND anonymized_at IS NULL
+	`, email)
+	if err != nil {
+		return nil, fmt.Errorf("aday başvuruları getirilemedi: %w", err)
+	}
+
+	return scanApplicantRecords(rows)
+}
+
+// AnonymizeApplications - Başvurulardaki kişisel verileri siler, istatistik için kayıtlar (ilan, durum, kanal, tarih) korunur
+func (r *Repository) AnonymizeApplications(ctx context.Context, applicationIDs []uuid.UUID) (int, error) {
+	defer utils.TimeTrack(time.Now(), "Privacy -> Anonymize Applications")
+
+	if len(applicationIDs) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]string, len(applicationIDs))
+	for i, id := range applicationIDs {
+		ids[i] = id.String()
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("transaction başlatılamadı: %w", err)
+	}
+	defer tx.Rollback()
+
+	// updated_at değiştirilmez, böylece durum geçmişi ve analitikler etkilenmez
+	result, err := tx.ExecContext(ctx, `
+		UPDATE job_applications
+		SET full_name = $1, email = '', phone = '', form_json = '{}'::jsonb, ai_screening = NULL, anonymized_at = NOW()
+		WHERE id = ANY($2::uuid[]) AND anonymized_at IS NULL
+	`, configs.PRIVACY_ANONYMIZED_NAME, pq.Array(ids))
+	if err != nil {
+		return 0, fmt.Errorf("başvurular anonimleştirilemedi: %w", err)
+	}
+
+	// Adayın mülakat notları da kişisel veri sayılır
+	_, err = tx.ExecContext(ctx, `
+		UPDATE job_interviews
+		SET candidate_note = ''
+		WHERE application_id = ANY($1::uuid[])
+	`, pq.Array(ids))
+	if err != nil {
+		return 0, fmt.Errorf("mülakat notları temizlenemedi: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("transaction tamamlanamadı: %w", err)
+	}
+
+	affected, _ := result.RowsAffected()
+	return int(affected), nil
+}
+
+// DeleteTrackingData - Adayın takip kodlarını ve oturumlarını siler
+func (r *Repository) DeleteTrackingData(ctx context.Context, email string) error {
+	defer utils.TimeTrack(time.Now(), "Privacy -> Delete Tracking Data")
+
+	if _, err := r.db.ExecContext(ctx, "DELETE FROM jobs_tracking_codes WHERE email = $1", email); err != nil {
+		return fmt.Errorf("takip kodları silinemedi: %w", err)
+	}
+
+	if _, err := r.db.ExecContext(ctx, "DELETE FROM jobs_tracking_sessions WHERE email = $1", email); err != nil {
+		return fmt.Errorf("takip oturumları silinemedi: %w", err)
+	}
+
+	return nil
+}
+
+// GetStatusHistoryByEmail - Adayın başvurularının durum geçmişini getirir
+func (r *Repository) GetStatusHistoryByEmail(ctx context.Context, email string) ([]types.ApplicantStatusEvent, error) {
+	defer utils.TimeTrack(time.Now(), "Privacy -> Get Status History By Email")
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT h.application_id, h.from_status, h.to_status, h.changed_at
+		FROM job_application_status_history h
+		JOIN job_applications a ON a.id = h.application_id
+		WHERE a.email = $1
+		ORDER BY h.changed_at
+	`, email)
+	if err != nil {
+		return nil, fmt.Errorf("durum geçmişi getirilemedi: %w", err)
+	}
+	defer rows.Close()
+
+	events := []types.ApplicantStatusEvent{}
+	for rows.Next() {
+		var event types.ApplicantStatusEvent
+		if err := rows.Scan(&event.ApplicationID, &event.FromStatus, &event.ToStatus, &event.ChangedAt); err != nil {
+			return nil, fmt.Errorf("durum geçmişi okunamadı: %w", err)
+		}
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
@@ -0,0 +1,116 @@
+package PrivacyRepository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// CreateRequest - Kişisel veri talebini 'pending' olarak kaydeder
+func (r *Repository) CreateRequest(ctx context.Context, requestType types.DataPrivacyRequestType, emailHash string, ipAddress string) (uuid.UUID, error) {
+	defer utils.TimeTrack(time.Now(), "Privacy -> Create Request")
+
+	var id uuid.UUID
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO data_privacy_requests (request_type, email_hash, ip_address)
+		VALUES ($1, NULLIF($2, ''), NULLIF($3, ''))
+		RETURNING id
+	`, requestType, emailHash, ipAddress).Scan(&id)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("kişisel veri talebi kaydedilemedi: %w", err)
+	}
+
+	return id, nil
+}
+
+// CompleteRequest - Talebin sonucunu kaydeder, hata varsa 'failed' olarak işaretlenir
+func (r *Repository) CompleteRequest(ctx context.Context, requestID uuid.UUID, result types.ApplicantErasureResult, failure error) error {
+	defer utils.TimeTrack(time.Now(), "Privacy -> Complete Request")
+
+	status := types.DataPrivacyRequestCompleted
+	message := ""
+	if failure != nil {
+		status = types.DataPrivacyRequestFailed
+		message = failure.Error()
+	}
+
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE data_privacy_requests
+		SET status = $1, applications_affected = $2, files_deleted = $3, error = $4, completed_at = NOW()
+		WHERE id = $5
+	`, status, result.ApplicationsAffected, result.FilesDeleted, message, requestID)
+	if err != nil {
+		return fmt.Errorf("kişisel veri talebi güncellenemedi: %w", err)
+	}
+
+	return nil
+}
+
+// ListRequests - Kişisel veri taleplerini yeniden eskiye listeler
+func (r *Repository) ListRequests(ctx context.Context, params types.DataPrivacyRequestSearchParams) ([]types.DataPrivacyRequest, int, error) {
+	defer utils.TimeTrack(time.Now(), "Privacy -> List Requests")
+
+	whereClause := " WHERE 1=1"
+	args := []any{}
+	paramIndex := 1
+
+	if params.RequestType != "" {
+		whereClause += fmt.Sprintf(" AND request_type = $%d", paramIndex)
+		args = append(args, params.RequestType)
+		paramIndex++
+	}
+
+	if params.EmailHash != "" {
+		whereClause += fmt.Sprintf(" AND email_hash = $%d", paramIndex)
+		args = append(args, params.EmailHash)
+		paramIndex++
+	}
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM data_privacy_requests"+whereClause, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("kişisel veri talepleri sayılamadı: %w", err)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT id, request_type, status, email_hash, ip_address, applications_affected,
+			files_deleted, error, requested_at, completed_at
+		FROM data_privacy_requests
+		%s
+		ORDER BY requested_at DESC
+		LIMIT $%d OFFSET $%d
+	`, whereClause, paramIndex, paramIndex+1)
+	args = append(args, params.Limit, (params.Page-1)*params.Limit)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("kişisel veri talepleri getirilemedi: %w", err)
+	}
+	defer rows.Close()
+
+	requests := []types.DataPrivacyRequest{}
+	for rows.Next() {
+		var request types.DataPrivacyRequest
+		err := rows.Scan(
+			&request.ID,
+			&request.RequestType,
+			&request.Status,
+			&request.EmailHash,
+			&request.IPAddress,
+			&request.ApplicationsAffected,
+			&request.FilesDeleted,
+			&request.Error,
+			&request.RequestedAt,
+			&request.CompletedAt,
+		)
+		if err != nil {
+			return nil, 0, fmt.Errorf("kişisel veri talebi okunamadı: %w", err)
+		}
+		requests = append(requests, request)
+	}
+
+	return requests, total, rows.Err()
+}
@@ -0,0 +1,33 @@
+package R2Repository
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// GetObject R2 bucket'tan bir nesneyi okur, maxBytes'tan büyük nesneler için hata döner
+func (r *Repository) GetObject(ctx context.Context, objectKey string, maxBytes int64) ([]byte, error) {
+	output, err := r.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(r.bucketName),
+		Key:    aws.String(objectKey),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("nesne okunamadı (key: %s): %w", objectKey, err)
+	}
+	defer output.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(output.Body, maxBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("nesne içeriği okunamadı (key: %s): %w", objectKey, err)
+	}
+
+	if int64(len(data)) > maxBytes {
+		return nil, fmt.Errorf("nesne boyutu sınırı aşıyor (key: %s)", objectKey)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,13 @@
+package R2Repository
+
+import "strings"
+
+// ObjectKeyFromURL public dosya URL'inden bucket içindeki nesne anahtarını çıkarır
+// Örn: https://files.example.com/uploads/cv/dosya.pdf -> uploads/cv/dosya.pdf
+func (r *Repository) ObjectKeyFromURL(url string) string {
+	base := strings.TrimRight(r.publicURLBase, "/") + "/"
+	if base != "/" && strings.HasPrefix(url, base) {
+		return strings.TrimPrefix(url, base)
+	}
+	return url
+}
@@ -0,0 +1,100 @@
+// aiusage/index.go
+package aiusage
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/configs"
+	AIUsageRepository "github.com/okanay/backend-holding/repositories/aiusage"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+	"github.com/sashabaranov/go-openai"
+)
+
+// ErrLimitExceeded kullanıcı AI_RATE_LIMIT_WINDOW içinde izin verilen çağrı veya token sınırını aştığında döner
+var ErrLimitExceeded = errors.New("yapay zeka kullanım sınırı aşıldı")
+
+// Service yapay zeka çağrılarının kullanım sınırlarını kontrol eder ve maliyetlerini kaydeder
+type Service struct {
+	repository *AIUsageRepository.Repository
+	config     configs.AIConfig
+}
+
+// NewService yeni bir yapay zeka kullanım servisi oluşturur
+// Maliyetler verilen yapılandırmadaki fiyat tablosuyla hesaplanır; yapılandırma başlangıçta bir kez okunur.
+func NewService(r *AIUsageRepository.Repository, config configs.AIConfig) *Service {
+	return &Service{
+		repository: r,
+		config:     config,
+	}
+}
+
+// Budget kullanıcının AI_RATE_LIMIT_WINDOW içindeki kullanımını ve kalan hakkını döner
+func (s *Service) Budget(ctx context.Context, userID uuid.UUID) (types.AIUsageBudget, error) {
+	usage, err := s.repository.GetUserUsageSince(ctx, userID, time.Now().Add(-configs.AI_RATE_LIMIT_WINDOW))
+	if err != nil {
+		return types.AIUsageBudget{}, err
+	}
+
+	budget := types.AIUsageBudget{
+		Window:            configs.AI_RATE_LIMIT_WINDOW.String(),
+		Requests:          usage.Requests,
+		Tokens:            usage.Tokens,
+		MaxRequests:       configs.AI_RATE_LIMIT_MAX_REQUESTS,
+		MaxTokens:         configs.AI_RATE_LIMIT_MAX_TOKENS,
+		RemainingRequests: max(configs.AI_RATE_LIMIT_MAX_REQUESTS-usage.Requests, 0),
+		RemainingTokens:   max(configs.AI_RATE_LIMIT_MAX_TOKENS-usage.Tokens, 0),
+	}
+	if usage.OldestAt != nil {
+		resetsAt := usage.OldestAt.Add(configs.AI_RATE_LIMIT_WINDOW)
+		budget.ResetsAt = &resetsAt
+	}
+
+	return budget, nil
+}
+
+// CheckLimit kullanıcının pencere içindeki çağrı ve token kullanımını kontrol eder
+// AIBudgetMiddleware isteği başta kontrol eder; birden fazla çağrı yapan işlemler (örn. parça parça çeviri) her çağrıdan önce tekrar kontrol eder.
+func (s *Service) CheckLimit(ctx context.Context, userID uuid.UUID) error {
+	budget, err := s.Budget(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if budget.RemainingRequests == 0 || budget.RemainingTokens == 0 {
+		return ErrLimitExceeded
+	}
+
+	return nil
+}
+
+// Record çağrının token ve maliyet bilgisini kaydeder ve utils.CalculateAICostWithOutput çıktısını döner
+// Kayıt hatası çağrıyı etkilemez, sadece loglanır.
+func (s *Service) Record(ctx context.Context, feature types.AIUsageFeature, model string, usage openai.Usage, userID uuid.UUID, referenceID uuid.UUID) map[string]any {
+	price, _ := s.config.Price(model)
+	cost := utils.CalculateAICostWithOutput(model, price, usage.PromptTokens, usage.CompletionTokens)
+	totalCost, _ := cost["totalCostUSD"].(float64)
+
+	// Çağrı zaman aşımına uğrasa da kayıt yazılsın
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+
+	err := s.repository.RecordUsage(ctx, types.AIUsageRecord{
+		Feature:      feature,
+		Model:        model,
+		InputTokens:  usage.PromptTokens,
+		OutputTokens: usage.CompletionTokens,
+		CostUSD:      totalCost,
+		UserID:       userID,
+		ReferenceID:  referenceID,
+	})
+	if err != nil {
+		log.Printf("[AI USAGE] %v", err)
+	}
+
+	return cost
+}
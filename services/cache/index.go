@@ -16,15 +16,31 @@ import (
 
 // Cache grupları - gerektiğinde ekleyebilirsiniz
 const (
-	GroupJobs    = "jobs"
-	GroupContent = "content"
+	GroupJobs      = "jobs"
+	GroupContent   = "content"
+	GroupAnalytics = "analytics"
+	GroupSitemap   = "sitemap"
 )
 
+// groupDependents - Bir grup temizlendiğinde birlikte temizlenen gruplar
+// Sitemap ilan ve içeriklerden üretildiği için yayın değişikliklerinde geçersiz olur.
+var groupDependents = map[string][]string{
+	GroupJobs:    {GroupSitemap},
+	GroupContent: {GroupSitemap},
+}
+
+// withDependents - Grubu ve ona bağlı grupları döner
+func withDependents(group string) []string {
+	return append([]string{group}, groupDependents[group]...)
+}
+
 // CacheService, tüm cache implementasyonları için ortak arayüz
 type CacheService interface {
 	TryCache(ctx *gin.Context, group, identifier string) bool
+	TryCacheRaw(ctx *gin.Context, group, identifier, contentType string) bool
 	SaveCache(response any, group, identifier string) error
 	SaveCacheTTL(response any, group, identifier string, ttl time.Duration) error
+	SaveCacheRawTTL(data []byte, group, identifier string, ttl time.Duration) error
 	ClearGroup(group string)
 	ClearAll()
 	Stop()
@@ -93,6 +109,11 @@ func NewInMemoryCache(ttl time.Duration) *InMemoryCache {
 
 // TryCache önbellekteki veriyi kontrol eder ve varsa yanıt olarak döndürür
 func (c *InMemoryCache) TryCache(ctx *gin.Context, group, identifier string) bool {
+	return c.TryCacheRaw(ctx, group, identifier, "application/json")
+}
+
+// TryCacheRaw önbellekteki veriyi verilen içerik tipiyle döndürür (XML, RSS vb. için)
+func (c *InMemoryCache) TryCacheRaw(ctx *gin.Context, group, identifier, contentType string) bool {
 	cacheKey := fmt.Sprintf("%s:%s", group, identifier)
 
 	c.mu.RLock()
@@ -123,8 +144,8 @@ func (c *InMemoryCache) TryCache(ctx *gin.Context, group, identifier string) boo
 	}
 
 	// Cache hit - önbellekteki veriyi dön
-	ctx.Data(http.StatusOK, "application/json", item.value)
 	ctx.Header("X-Cache", "HIT")
+	ctx.Data(http.StatusOK, contentType, item.value)
 	return true
 }
 
@@ -154,11 +175,16 @@ func (c *InMemoryCache) SaveCacheTTL(response any, group, identifier string, ttl
 		return err
 	}
 
+	return c.SaveCacheRawTTL(jsonData, group, identifier, ttl)
+}
+
+// SaveCacheRawTTL hazır byte dizisini özel TTL ile önbelleğe alır
+func (c *InMemoryCache) SaveCacheRawTTL(data []byte, group, identifier string, ttl time.Duration) error {
 	cacheKey := fmt.Sprintf("%s:%s", group, identifier)
 
 	c.mu.Lock()
 	c.data[cacheKey] = cacheItem{
-		value:    jsonData,
+		value:    data,
 		cachedAt: time.Now(),
 		ttl:      ttl,
 	}
@@ -167,17 +193,19 @@ func (c *InMemoryCache) SaveCacheTTL(response any, group, identifier string, ttl
 	return nil
 }
 
-// ClearGroup bir grubu önbellekten temizler
+// ClearGroup bir grubu ve bağlı gruplarını önbellekten temizler
 func (c *InMemoryCache) ClearGroup(group string) {
-	prefix := group + ":"
-
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	// Belirli bir önekle başlayan tüm anahtarları temizle
-	for key := range c.data {
-		if len(key) >= len(prefix) && key[:len(prefix)] == prefix {
-			delete(c.data, key)
+	for _, g := range withDependents(group) {
+		prefix := g + ":"
+
+		// Belirli bir önekle başlayan tüm anahtarları temizle
+		for key := range c.data {
+			if len(key) >= len(prefix) && key[:len(prefix)] == prefix {
+				delete(c.data, key)
+			}
 		}
 	}
 }
@@ -256,6 +284,11 @@ func NewRedisCache(addr string, password string, db int, ttl time.Duration) *Red
 
 // TryCache önbellekteki veriyi kontrol eder ve varsa yanıt olarak döndürür
 func (c *RedisCache) TryCache(ctx *gin.Context, group, identifier string) bool {
+	return c.TryCacheRaw(ctx, group, identifier, "application/json")
+}
+
+// TryCacheRaw önbellekteki veriyi verilen içerik tipiyle döndürür (XML, RSS vb. için)
+func (c *RedisCache) TryCacheRaw(ctx *gin.Context, group, identifier, contentType string) bool {
 	cacheKey := fmt.Sprintf("%s:%s", group, identifier)
 
 	// Redis'ten veriyi al
@@ -266,8 +299,8 @@ func (c *RedisCache) TryCache(ctx *gin.Context, group, identifier string) bool {
 	}
 
 	// Cache hit - önbellekteki veriyi dön
-	ctx.Data(http.StatusOK, "application/json", val)
 	ctx.Header("X-Cache", "HIT")
+	ctx.Data(http.StatusOK, contentType, val)
 	return true
 }
 
@@ -291,22 +324,29 @@ func (c *RedisCache) SaveCacheTTL(response any, group, identifier string, ttl ti
 		return err
 	}
 
+	return c.SaveCacheRawTTL(jsonData, group, identifier, ttl)
+}
+
+// SaveCacheRawTTL hazır byte dizisini özel TTL ile önbelleğe alır
+func (c *RedisCache) SaveCacheRawTTL(data []byte, group, identifier string, ttl time.Duration) error {
 	cacheKey := fmt.Sprintf("%s:%s", group, identifier)
 
 	// Redis'e özel TTL ile kaydet
-	return c.client.Set(c.ctx, cacheKey, jsonData, ttl).Err()
+	return c.client.Set(c.ctx, cacheKey, data, ttl).Err()
 }
 
-// ClearGroup bir grubu önbellekten temizler
+// ClearGroup bir grubu ve bağlı gruplarını önbellekten temizler
 func (c *RedisCache) ClearGroup(group string) {
-	prefix := group + ":"
+	for _, g := range withDependents(group) {
+		prefix := g + ":"
 
-	// Redis'te desen araması yap
-	iter := c.client.Scan(c.ctx, 0, prefix+"*", 0).Iterator()
+		// Redis'te desen araması yap
+		iter := c.client.Scan(c.ctx, 0, prefix+"*", 0).Iterator()
 
-	// Bulunan tüm anahtarları sil
-	for iter.Next(c.ctx) {
-		c.client.Del(c.ctx, iter.Val())
+		// Bulunan tüm anahtarları sil
+		for iter.Next(c.ctx) {
+			c.client.Del(c.ctx, iter.Val())
+		}
 	}
 }
 
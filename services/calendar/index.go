@@ -0,0 +1,120 @@
+// calendar/index.go
+package calendar
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Method - iCalendar METHOD değeri (RFC 5546)
+type Method string
+
+const (
+	MethodRequest Method = "REQUEST"
+	MethodCancel  Method = "CANCEL"
+)
+
+// Attendee - Etkinlik katılımcısı
+type Attendee struct {
+	Name  string
+	Email string
+}
+
+// Event - Davetiyeye dönüştürülecek etkinlik
+type Event struct {
+	UID         string // Aynı etkinliğin güncellemelerinde sabit kalmalı
+	Sequence    int    // Her güncellemede artırılır
+	Start       time.Time
+	End         time.Time
+	Summary     string
+	Description string
+	Location    string
+	URL         string
+	Organizer   Attendee
+	Attendees   []Attendee
+	Cancelled   bool
+}
+
+// BuildInvite - Etkinliği RFC 5545 uyumlu .ics içeriğine dönüştürür
+func BuildInvite(productName string, method Method, event Event) []byte {
+	var b strings.Builder
+
+	writeLine(&b, "BEGIN:VCALENDAR")
+	writeLine(&b, "VERSION:2.0")
+	writeLine(&b, "PRODID:-//"+escapeText(productName)+"//Interviews//TR")
+	writeLine(&b, "CALSCALE:GREGORIAN")
+	writeLine(&b, "METHOD:"+string(method))
+	writeLine(&b, "BEGIN:VEVENT")
+	writeLine(&b, "UID:"+event.UID)
+	writeLine(&b, fmt.Sprintf("SEQUENCE:%d", event.Sequence))
+	writeLine(&b, "DTSTAMP:"+formatTime(time.Now()))
+	writeLine(&b, "DTSTART:"+formatTime(event.Start))
+	writeLine(&b, "DTEND:"+formatTime(event.End))
+	writeLine(&b, "SUMMARY:"+escapeText(event.Summary))
+
+	if event.Description != "" {
+		writeLine(&b, "DESCRIPTION:"+escapeText(event.Description))
+	}
+	if event.Location != "" {
+		writeLine(&b, "LOCATION:"+escapeText(event.Location))
+	}
+	if event.URL != "" {
+		writeLine(&b, "URL:"+event.URL)
+	}
+	if event.Organizer.Email != "" {
+		writeLine(&b, "ORGANIZER;CN="+quoteParam(event.Organizer.Name)+":mailto:"+event.Organizer.Email)
+	}
+	for _, attendee := range event.Attendees {
+		writeLine(&b, "ATTENDEE;CN="+quoteParam(attendee.Name)+";ROLE=REQ-PARTICIPANT;PARTSTAT=NEEDS-ACTION;RSVP=FALSE:mailto:"+attendee.Email)
+	}
+
+	if event.Cancelled || method == MethodCancel {
+		writeLine(&b, "STATUS:CANCELLED")
+	} else {
+		writeLine(&b, "STATUS:CONFIRMED")
+	}
+
+	writeLine(&b, "END:VEVENT")
+	writeLine(&b, "END:VCALENDAR")
+
+	return []byte(b.String())
+}
+
+// formatTime - Zamanı UTC biçiminde yazar (örn. 20250102T150405Z)
+func formatTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// escapeText - TEXT değerlerindeki özel karakterleri kaçırır
+func escapeText(value string) string {
+	value = strings.ReplaceAll(value, "\\", "\\\\")
+	value = strings.ReplaceAll(value, ";", "\\;")
+	value = strings.ReplaceAll(value, ",", "\\,")
+	value = strings.ReplaceAll(value, "\r\n", "\\n")
+	value = strings.ReplaceAll(value, "\n", "\\n")
+	return value
+}
+
+// quoteParam - Parametre değerini çift tırnak içine alır (CN gibi)
+func quoteParam(value string) string {
+	return `"` + strings.ReplaceAll(value, `"`, "'") + `"`
+}
+
+// writeLine - Satırı 75 oktetten uzunsa katlayarak yazar (RFC 5545, 3.1)
+func writeLine(b *strings.Builder, line string) {
+	limit := 75
+
+	for len(line) > limit {
+		cut := limit
+		// Çok baytlı UTF-8 karakterlerini bölmemek için geri git
+		for cut > 0 && line[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		b.WriteString(line[:cut] + "\r\n ")
+		line = line[cut:]
+		limit = 74 // Devam satırları baştaki boşlukla birlikte 75 okteti geçmemeli
+	}
+
+	b.WriteString(line + "\r\n")
+}
@@ -0,0 +1,210 @@
+// candidate/index.go
+package candidate
+
+import (
+	"context"
+	"fmt"
+	"log"
+	netmail "net/mail"
+	"net/url"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/configs"
+	EmailTemplateRepository "github.com/okanay/backend-holding/repositories/emailtemplate"
+	OutboxRepository "github.com/okanay/backend-holding/repositories/outbox"
+	"github.com/okanay/backend-holding/services/calendar"
+	"github.com/okanay/backend-holding/services/mail"
+	"github.com/okanay/backend-holding/types"
+)
+
+// InterviewEvent - Adaya ve mülakatçılara bildirilen mülakat olayı
+type InterviewEvent string
+
+const (
+	InterviewScheduled   InterviewEvent = "scheduled"
+	InterviewRescheduled InterviewEvent = "rescheduled"
+	InterviewCancelled   InterviewEvent = "cancelled"
+)
+
+// Service adaylara giden e-postaları (takip kodu, mülakat davetleri, şablonlu başvuru bildirimleri) gönderir
+type Service struct {
+	mailer    mail.Mailer
+	templates *EmailTemplateRepository.Repository
+	outbox    *OutboxRepository.Repository
+}
+
+// NewService yeni bir aday e-posta servisi oluşturur
+func NewService(m mail.Mailer, t *EmailTemplateRepository.Repository, o *OutboxRepository.Repository) *Service {
+	return &Service{
+		mailer:    m,
+		templates: t,
+		outbox:    o,
+	}
+}
+
+// SendTrackingCode başvuru takip oturumu için tek kullanımlık kodu gönderir
+func (s *Service) SendTrackingCode(ctx context.Context, email string, code string) error {
+	message, err := buildTrackingCodeMessage(email, code)
+	if err != nil {
+		return err
+	}
+
+	if err := s.mailer.Send(ctx, message); err != nil {
+		return fmt.Errorf("takip kodu e-postası gönderilemedi: %w", err)
+	}
+
+	return nil
+}
+
+// NotifyInterview mülakat davetini (.ics ekli) adaya ve mülakatçılara arka planda gönderir
+func (s *Service) NotifyInterview(interview types.JobInterviewView, event InterviewEvent) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), configs.CANDIDATE_MAIL_TIMEOUT)
+		defer cancel()
+
+		messages, err := buildInterviewMessages(interview, event)
+		if err != nil {
+			log.Printf("[CANDIDATE] Mülakat e-postası hazırlanamadı: %v", err)
+			return
+		}
+
+		for _, message := range messages {
+			if err := s.mailer.Send(ctx, message); err != nil {
+				log.Printf("[CANDIDATE] Mülakat e-postası gönderilemedi (%s): %v", message.To, err)
+			}
+		}
+	}()
+}
+
+// NotifyCandidateResponse adayın onayını veya yeniden planlama talebini mülakatçılara arka planda bildirir
+func (s *Service) NotifyCandidateResponse(interview types.JobInterviewView) {
+	if len(interview.Interviewers) == 0 {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), configs.CANDIDATE_MAIL_TIMEOUT)
+		defer cancel()
+
+		for _, message := range buildCandidateResponseMessages(interview) {
+			if err := s.mailer.Send(ctx, message); err != nil {
+				log.Printf("[CANDIDATE] Aday yanıtı bildirilemedi (%s): %v", message.To, err)
+			}
+		}
+	}()
+}
+
+// NotifyApplication başvuru olayı için adayın dilindeki şablonu işler ve e-postayı kuyruğa yazar
+// Olay için aktif şablon yoksa e-posta gönderilmez.
+func (s *Service) NotifyApplication(ctx context.Context, event types.EmailTemplateEvent, target types.JobApplicationRecipient, status string) error {
+	message, ok, err := s.buildTemplatedMessage(ctx, event, target, status, "")
+	if err != nil || !ok {
+		return err
+	}
+
+	if err := s.outbox.Enqueue(ctx, []types.OutboxMessage{message}); err != nil {
+		return fmt.Errorf("başvuru bildirimi kuyruğa alınamadı: %w", err)
+	}
+
+	return nil
+}
+
+// BulkNotifications toplu işlemden etkilenen adaylara gidecek şablonlu e-postaları hazırlayan fonksiyonu döner
+// E-postalar doğrudan gönderilmez, repository tarafından işlemle aynı transaction içinde kuyruğa yazılır.
+func (s *Service) BulkNotifications(ctx context.Context, input types.JobApplicationBulkInput) func([]types.JobApplicationRecipient) []types.OutboxMessage {
+	event := templateEvent(input.Action)
+
+	return func(targets []types.JobApplicationRecipient) []types.OutboxMessage {
+		// Şablonlar dil başına bir kez aranır
+		templates := map[string]types.EmailTemplate{}
+		messages := make([]types.OutboxMessage, 0, len(targets))
+
+		for _, target := range targets {
+			// Anonimleştirilmiş başvuruların e-posta adresi yoktur
+			if target.Email == "" {
+				continue
+			}
+
+			template, found := templates[target.Language]
+			if !found {
+				var err error
+				template, err = s.templates.FindTemplate(ctx, event, input.Status, target.Language, configs.EMAIL_DEFAULT_LANGUAGE)
+				if err != nil {
+					log.Printf("[CANDIDATE] Başvuru bildirimi hazırlanamadı (%s): %v", target.Email, err)
+					continue
+				}
+				templates[target.Language] = template
+			}
+
+			if template.ID != uuid.Nil {
+				messages = append(messages, renderMessage(template, target, input.Status, input.NotifyMessage))
+			}
+		}
+
+		return messages
+	}
+}
+
+// InterviewCalendar mülakatın .ics içeriğini döner (takip oturumundan indirme için de kullanılır)
+func InterviewCalendar(interview types.JobInterviewView) []byte {
+	site := configs.GetSiteConfig()
+
+	method := calendar.MethodRequest
+	if interview.Status == types.JobInterviewStatusCancelled {
+		method = calendar.MethodCancel
+	}
+
+	return calendar.BuildInvite(site.OrganizationName, method, interviewCalendarEvent(interview))
+}
+
+// interviewCalendarEvent - Mülakatı takvim etkinliğine dönüştürür
+func interviewCalendarEvent(interview types.JobInterviewView) calendar.Event {
+	site := configs.GetSiteConfig()
+
+	location := interview.Location
+	if location == "" {
+		location = interview.VideoURL
+	}
+
+	attendees := []calendar.Attendee{{Name: interview.CandidateName, Email: interview.CandidateEmail}}
+	for _, interviewer := range interview.Interviewers {
+		attendees = append(attendees, calendar.Attendee{Name: interviewer.Username, Email: interviewer.Email})
+	}
+
+	return calendar.Event{
+		UID:         interview.ID.String() + "@" + uidDomain(site.BaseURL),
+		Sequence:    interview.Sequence,
+		Start:       interview.StartsAt,
+		End:         interview.EndsAt,
+		Summary:     fmt.Sprintf("%s - %s mülakatı", site.OrganizationName, interview.JobTitle),
+		Description: interviewDescription(interview),
+		Location:    location,
+		URL:         interview.VideoURL,
+		Organizer:   organizer(site.OrganizationName),
+		Attendees:   attendees,
+		Cancelled:   interview.Status == types.JobInterviewStatusCancelled,
+	}
+}
+
+// uidDomain - Takvim UID'sinin alan adı kısmı (site adresi yoksa sabit değer)
+func uidDomain(baseURL string) string {
+	if parsed, err := url.Parse(baseURL); err == nil && parsed.Hostname() != "" {
+		return parsed.Hostname()
+	}
+	return "interviews.local"
+}
+
+// organizer - Davetin düzenleyicisi olarak gönderici adresini kullanır
+func organizer(name string) calendar.Attendee {
+	address, err := netmail.ParseAddress(os.Getenv("MAIL_FROM"))
+	if err != nil {
+		return calendar.Attendee{}
+	}
+
+	if address.Name != "" {
+		name = address.Name
+	}
+
+	return calendar.Attendee{Name: name, Email: address.Address}
+}
@@ -0,0 +1,250 @@
+package candidate
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"strings"
+	"time"
+
+	"github.com/okanay/backend-holding/configs"
+	"github.com/okanay/backend-holding/services/mail"
+	"github.com/okanay/backend-holding/types"
+)
+
+// displayLocation - E-postalardaki saatlerin gösterildiği saat dilimi
+var displayLocation = loadDisplayLocation()
+
+func loadDisplayLocation() *time.Location {
+	location, err := time.LoadLocation("Europe/Istanbul")
+	if err != nil {
+		return time.Local
+	}
+	return location
+}
+
+var trackingCodeTemplate = template.Must(template.New("tracking-code").Parse(`<!DOCTYPE html>
+<html lang="tr">
+<body style="font-family: Arial, sans-serif; color: #1f2937;">
+  <p>Merhaba,</p>
+  <p>{{.Organization}} başvurularınızı görüntülemek için takip kodunuz:</p>
+  <p style="font-size: 24px; font-weight: bold; letter-spacing: 4px;">{{.Code}}</p>
+  <p>Kod {{.Minutes}} dakika boyunca geçerlidir ve yalnızca bir kez kullanılabilir.</p>
+  <p style="font-size: 12px; color: #6b7280;">Bu isteği siz yapmadıysanız bu e-postayı dikkate almayın.</p>
+</body>
+</html>`))
+
+var interviewTemplate = template.Must(template.New("interview").Parse(`<!DOCTYPE html>
+<html lang="tr">
+<body style="font-family: Arial, sans-serif; color: #1f2937;">
+  <p>Merhaba {{.Name}},</p>
+  <p>{{.Intro}}</p>
+  <ul>
+    <li><strong>Pozisyon:</strong> {{.JobTitle}}</li>
+    <li><strong>Tarih:</strong> {{.When}}</li>
+    {{- if .Location}}
+    <li><strong>Adres:</strong> {{.Location}}</li>
+    {{- end}}
+    {{- if .VideoURL}}
+    <li><strong>Görüntülü görüşme:</strong> <a href="{{.VideoURL}}">{{.VideoURL}}</a></li>
+    {{- end}}
+  </ul>
+  {{- if .Notes}}
+  <p>{{.Notes}}</p>
+  {{- end}}
+  {{- if .TrackingURL}}
+  <p><a href="{{.TrackingURL}}">Mülakatı onaylamak veya yeni bir zaman istemek için başvuru takip sayfasına gidin</a></p>
+  {{- end}}
+  <p style="font-size: 12px; color: #6b7280;">Takviminize eklemek için ekteki davet dosyasını (.ics) açabilirsiniz.</p>
+</body>
+</html>`))
+
+// interviewContent - Mülakat e-postası şablon verisi
+type interviewContent struct {
+	Name        string
+	Intro       string
+	JobTitle    string
+	When        string
+	Location    string
+	VideoURL    string
+	Notes       string
+	TrackingURL string
+}
+
+// buildTrackingCodeMessage - Takip kodu e-postasını hazırlar
+func buildTrackingCodeMessage(email string, code string) (mail.Message, error) {
+	site := configs.GetSiteConfig()
+	minutes := int(configs.JOBS_TRACKING_CODE_DURATION.Minutes())
+
+	var html bytes.Buffer
+	err := trackingCodeTemplate.Execute(&html, map[string]any{
+		"Organization": site.OrganizationName,
+		"Code":         code,
+		"Minutes":      minutes,
+	})
+	if err != nil {
+		return mail.Message{}, fmt.Errorf("takip kodu e-postası oluşturulamadı: %w", err)
+	}
+
+	text := fmt.Sprintf(
+		"Merhaba,\n\n%s başvurularınızı görüntülemek için takip kodunuz: %s\n\nKod %d dakika boyunca geçerlidir ve yalnızca bir kez kullanılabilir.\n",
+		site.OrganizationName, code, minutes,
+	)
+
+	return mail.Message{
+		To:      email,
+		Subject: site.OrganizationName + " başvuru takip kodunuz",
+		Text:    text,
+		HTML:    html.String(),
+	}, nil
+}
+
+// buildInterviewMessages - Adaya ve mülakatçılara gidecek davet e-postalarını hazırlar
+func buildInterviewMessages(interview types.JobInterviewView, event InterviewEvent) ([]mail.Message, error) {
+	site := configs.GetSiteConfig()
+
+	subject, intro := interviewSubject(event, interview.JobTitle)
+	attachment := mail.Attachment{
+		Filename:    "mulakat.ics",
+		ContentType: "text/calendar; charset=utf-8; method=REQUEST",
+		Data:        InterviewCalendar(interview),
+	}
+	if event == InterviewCancelled {
+		attachment.ContentType = "text/calendar; charset=utf-8; method=CANCEL"
+	}
+
+	content := interviewContent{
+		Name:     interview.CandidateName,
+		Intro:    intro,
+		JobTitle: interview.JobTitle,
+		When:     formatRange(interview.StartsAt, interview.EndsAt),
+		Location: interview.Location,
+		VideoURL: interview.VideoURL,
+		Notes:    interview.Notes,
+	}
+	if event != InterviewCancelled {
+		content.TrackingURL = site.TrackingURL()
+	}
+
+	candidateMessage, err := renderInterviewMessage(interview.CandidateEmail, subject, content, attachment)
+	if err != nil {
+		return nil, err
+	}
+	messages := []mail.Message{candidateMessage}
+
+	// Mülakatçılar aynı daveti aday takip bağlantısı olmadan alır
+	for _, interviewer := range interview.Interviewers {
+		content.Name = interviewer.Username
+		content.TrackingURL = ""
+		content.Intro = interviewerIntro(event, interview.CandidateName)
+
+		message, err := renderInterviewMessage(interviewer.Email, subject, content, attachment)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, message)
+	}
+
+	return messages, nil
+}
+
+// renderInterviewMessage - Şablonu tek alıcı için işler
+func renderInterviewMessage(to, subject string, content interviewContent, attachment mail.Attachment) (mail.Message, error) {
+	var html bytes.Buffer
+	if err := interviewTemplate.Execute(&html, content); err != nil {
+		return mail.Message{}, fmt.Errorf("mülakat e-postası oluşturulamadı: %w", err)
+	}
+
+	var text strings.Builder
+	fmt.Fprintf(&text, "Merhaba %s,\n\n%s\n\nPozisyon: %s\nTarih: %s\n", content.Name, content.Intro, content.JobTitle, content.When)
+	if content.Location != "" {
+		fmt.Fprintf(&text, "Adres: %s\n", content.Location)
+	}
+	if content.VideoURL != "" {
+		fmt.Fprintf(&text, "Görüntülü görüşme: %s\n", content.VideoURL)
+	}
+	if content.Notes != "" {
+		fmt.Fprintf(&text, "\n%s\n", content.Notes)
+	}
+	if content.TrackingURL != "" {
+		fmt.Fprintf(&text, "\nMülakatı onaylamak veya yeni bir zaman istemek için: %s\n", content.TrackingURL)
+	}
+
+	return mail.Message{
+		To:          to,
+		Subject:     subject,
+		Text:        text.String(),
+		HTML:        html.String(),
+		Attachments: []mail.Attachment{attachment},
+	}, nil
+}
+
+// buildCandidateResponseMessages - Adayın yanıtını mülakatçılara bildiren e-postaları hazırlar
+func buildCandidateResponseMessages(interview types.JobInterviewView) []mail.Message {
+	var summary string
+	switch interview.Status {
+	case types.JobInterviewStatusConfirmed:
+		summary = fmt.Sprintf("%s, %s tarihindeki %s mülakatını onayladı.", interview.CandidateName, formatRange(interview.StartsAt, interview.EndsAt), interview.JobTitle)
+	default:
+		summary = fmt.Sprintf("%s, %s tarihindeki %s mülakatı için yeni bir zaman istedi.\n\nAdayın notu: %s", interview.CandidateName, formatRange(interview.StartsAt, interview.EndsAt), interview.JobTitle, interview.CandidateNote)
+	}
+
+	messages := make([]mail.Message, 0, len(interview.Interviewers))
+	for _, interviewer := range interview.Interviewers {
+		messages = append(messages, mail.Message{
+			To:      interviewer.Email,
+			Subject: fmt.Sprintf("Mülakat yanıtı: %s - %s", interview.CandidateName, interview.JobTitle),
+			Text:    fmt.Sprintf("Merhaba %s,\n\n%s\n", interviewer.Username, summary),
+		})
+	}
+
+	return messages
+}
+
+// interviewSubject - Olaya göre konu ve giriş cümlesini döner
+func interviewSubject(event InterviewEvent, jobTitle string) (string, string) {
+	switch event {
+	case InterviewRescheduled:
+		return "Mülakat zamanı güncellendi: " + jobTitle, "Mülakatınızın zamanı veya detayları güncellendi."
+	case InterviewCancelled:
+		return "Mülakat iptal edildi: " + jobTitle, "Planlanan mülakatınız iptal edildi."
+	default:
+		return "Mülakat daveti: " + jobTitle, "Başvurunuz için bir mülakat planlandı."
+	}
+}
+
+// interviewerIntro - Mülakatçılara giden e-postanın giriş cümlesi
+func interviewerIntro(event InterviewEvent, candidateName string) string {
+	switch event {
+	case InterviewRescheduled:
+		return candidateName + " ile yapılacak mülakatın zamanı veya detayları güncellendi."
+	case InterviewCancelled:
+		return candidateName + " ile planlanan mülakat iptal edildi."
+	default:
+		return candidateName + " ile bir mülakat planlandı."
+	}
+}
+
+// interviewDescription - Takvim etkinliğinin açıklaması
+func interviewDescription(interview types.JobInterviewView) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Pozisyon: %s\nAday: %s", interview.JobTitle, interview.CandidateName)
+	if interview.VideoURL != "" {
+		fmt.Fprintf(&b, "\nGörüntülü görüşme: %s", interview.VideoURL)
+	}
+	if interview.Notes != "" {
+		fmt.Fprintf(&b, "\n\n%s", interview.Notes)
+	}
+	return b.String()
+}
+
+// formatRange - Mülakat zaman aralığını okunabilir biçimde döner (örn. 02.01.2025 15:00 - 16:00)
+func formatRange(start, end time.Time) string {
+	start = start.In(displayLocation)
+	end = end.In(displayLocation)
+
+	if start.Format("02.01.2006") == end.Format("02.01.2006") {
+		return fmt.Sprintf("%s - %s (%s)", start.Format("02.01.2006 15:04"), end.Format("15:04"), start.Format("MST"))
+	}
+	return fmt.Sprintf("%s - %s (%s)", start.Format("02.01.2006 15:04"), end.Format("02.01.2006 15:04"), start.Format("MST"))
+}
@@ -0,0 +1,198 @@
+package candidate
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/configs"
+	"github.com/okanay/backend-holding/types"
+)
+
+// placeholderPattern - Şablonlardaki {{degisken}} ifadeleri
+var placeholderPattern = regexp.MustCompile(`\{\{\s*([a-z_]+)\s*\}\}`)
+
+// blankLinesPattern - Boş değişkenlerden (örn. not) kalan fazla boş satırlar
+var blankLinesPattern = regexp.MustCompile(`\n{3,}`)
+
+// templateVariables - Şablonlarda kullanılabilecek değişken adları
+var templateVariables = []string{"candidate_name", "job_title", "status", "tracking_link", "organization", "note"}
+
+// UnknownPlaceholders şablonda geçen ancak desteklenmeyen değişkenleri döner (kaydetmeden önce doğrulama için)
+func UnknownPlaceholders(texts ...string) []string {
+	known := make(map[string]bool, len(templateVariables))
+	for _, name := range templateVariables {
+		known[name] = true
+	}
+
+	seen := map[string]bool{}
+	var unknown []string
+	for _, text := range texts {
+		for _, match := range placeholderPattern.FindAllStringSubmatch(text, -1) {
+			if name := match[1]; !known[name] && !seen[name] {
+				seen[name] = true
+				unknown = append(unknown, name)
+			}
+		}
+	}
+
+	return unknown
+}
+
+// SampleVariables önizlemede kullanılan örnek değerler
+func SampleVariables() types.EmailTemplateVariables {
+	site := configs.GetSiteConfig()
+
+	return types.EmailTemplateVariables{
+		CandidateName: "Ayşe Yılmaz",
+		JobTitle:      "Kıdemli Yazılım Geliştirici",
+		Status:        "reviewing",
+		TrackingLink:  site.TrackingURL(),
+		Organization:  site.OrganizationName,
+		Note:          "Değerlendirme sürecimiz yaklaşık iki hafta sürmektedir.",
+	}
+}
+
+// RenderTemplate şablonu verilen değişkenlerle işler
+// HTML gövde boşsa düz metinden üretilir; HTML'e yerleştirilen değerler kaçışlanır.
+func RenderTemplate(subject, textBody, htmlBody string, variables types.EmailTemplateVariables) types.EmailTemplatePreview {
+	values := map[string]string{
+		"candidate_name": variables.CandidateName,
+		"job_title":      variables.JobTitle,
+		"status":         variables.Status,
+		"tracking_link":  variables.TrackingLink,
+		"organization":   variables.Organization,
+		"note":           variables.Note,
+	}
+
+	// Şablon notu içermiyorsa eklenen not gövdenin sonuna yazılır
+	if variables.Note != "" && !hasPlaceholder(textBody, "note") {
+		textBody += "\n\n{{note}}"
+	}
+
+	text := substitute(textBody, values, false)
+	text = strings.TrimSpace(blankLinesPattern.ReplaceAllString(text, "\n\n")) + "\n"
+
+	var body string
+	if htmlBody != "" {
+		body = substitute(htmlBody, values, true)
+		if variables.Note != "" && !hasPlaceholder(htmlBody, "note") {
+			note := "<p>" + html.EscapeString(variables.Note) + "</p>\n"
+			if i := strings.LastIndex(body, "</body>"); i >= 0 {
+				body = body[:i] + note + body[i:]
+			} else {
+				body += note
+			}
+		}
+	} else {
+		body = textToHTML(text)
+	}
+
+	return types.EmailTemplatePreview{
+		Subject: strings.TrimSpace(substitute(subject, values, false)),
+		Text:    text,
+		HTML:    body,
+	}
+}
+
+// hasPlaceholder - Metnin verilen değişkeni içerip içermediğini döner
+func hasPlaceholder(text string, name string) bool {
+	for _, match := range placeholderPattern.FindAllStringSubmatch(text, -1) {
+		if match[1] == name {
+			return true
+		}
+	}
+	return false
+}
+
+// substitute - Değişkenleri değerleriyle değiştirir, bilinmeyen değişkenler olduğu gibi kalır
+func substitute(text string, values map[string]string, escape bool) string {
+	return placeholderPattern.ReplaceAllStringFunc(text, func(match string) string {
+		name := placeholderPattern.FindStringSubmatch(match)[1]
+		value, ok := values[name]
+		if !ok {
+			return match
+		}
+		if escape {
+			return html.EscapeString(value)
+		}
+		return value
+	})
+}
+
+// textToHTML - Düz metin gövdeyi paragraflara bölerek basit bir HTML e-postaya dönüştürür
+func textToHTML(text string) string {
+	var b strings.Builder
+	b.WriteString("<!DOCTYPE html>\n<html>\n<body style=\"font-family: Arial, sans-serif; color: #1f2937;\">\n")
+	for _, paragraph := range strings.Split(strings.TrimSpace(text), "\n\n") {
+		lines := strings.Split(paragraph, "\n")
+		for i, line := range lines {
+			lines[i] = linkify(html.EscapeString(line))
+		}
+		fmt.Fprintf(&b, "  <p>%s</p>\n", strings.Join(lines, "<br>"))
+	}
+	b.WriteString("</body>\n</html>")
+	return b.String()
+}
+
+// linkify - Satırdaki http(s) adreslerini bağlantıya çevirir (satır önceden kaçışlanmış olmalı)
+func linkify(line string) string {
+	words := strings.Fields(line)
+	for i, word := range words {
+		if strings.HasPrefix(word, "http://") || strings.HasPrefix(word, "https://") {
+			words[i] = fmt.Sprintf(`<a href="%s">%s</a>`, word, word)
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// templateEvent - Toplu işleme karşılık gelen şablon olayı
+func templateEvent(action types.JobApplicationBulkAction) types.EmailTemplateEvent {
+	if action == types.JobApplicationBulkDelete {
+		return types.EmailEventApplicationDeleted
+	}
+	return types.EmailEventStatusChanged
+}
+
+// buildTemplatedMessage - Adayın dilindeki şablonu bulur ve kuyruğa yazılacak e-postayı hazırlar
+// Uygun aktif şablon veya adayın e-posta adresi yoksa ok false döner ve e-posta gönderilmez.
+func (s *Service) buildTemplatedMessage(ctx context.Context, event types.EmailTemplateEvent, target types.JobApplicationRecipient, status string, note string) (types.OutboxMessage, bool, error) {
+	// Anonimleştirilmiş başvuruların e-posta adresi yoktur
+	if target.Email == "" {
+		return types.OutboxMessage{}, false, nil
+	}
+
+	template, err := s.templates.FindTemplate(ctx, event, status, target.Language, configs.EMAIL_DEFAULT_LANGUAGE)
+	if err != nil {
+		return types.OutboxMessage{}, false, err
+	}
+
+	if template.ID == uuid.Nil {
+		return types.OutboxMessage{}, false, nil
+	}
+
+	return renderMessage(template, target, status, note), true, nil
+}
+
+// renderMessage - Şablonu aday bilgileriyle işleyerek e-postaya dönüştürür
+func renderMessage(template types.EmailTemplate, target types.JobApplicationRecipient, status string, note string) types.OutboxMessage {
+	site := configs.GetSiteConfig()
+	rendered := RenderTemplate(template.Subject, template.TextBody, template.HTMLBody, types.EmailTemplateVariables{
+		CandidateName: target.FullName,
+		JobTitle:      target.JobTitle,
+		Status:        status,
+		TrackingLink:  site.TrackingURL(),
+		Organization:  site.OrganizationName,
+		Note:          note,
+	})
+
+	return types.OutboxMessage{
+		To:      target.Email,
+		Subject: rendered.Subject,
+		Text:    rendered.Text,
+		HTML:    rendered.HTML,
+	}
+}
@@ -0,0 +1,173 @@
+package candidate
+
+import (
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	netmail "net/mail"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/okanay/backend-holding/services/mail"
+	"github.com/okanay/backend-holding/types"
+)
+
+func TestRenderMessageThroughFileMailer(t *testing.T) {
+	t.Setenv("SITE_BASE_URL", "https://kariyer.example.com/")
+	t.Setenv("SITE_APPLICATION_TRACKING_PATH", "/basvurularim")
+	t.Setenv("SITE_ORGANIZATION_NAME", "Örnek Holding")
+
+	trackingLink := "https://kariyer.example.com/basvurularim"
+
+	tests := []struct {
+		name     string
+		template types.EmailTemplate
+		target   types.JobApplicationRecipient
+		note     string
+		subject  string
+		text     []string
+		html     []string
+	}{
+		{
+			name: "varsayılan düz metin şablonu",
+			template: types.EmailTemplate{
+				Event:    types.EmailEventStatusChanged,
+				Language: "tr",
+				Subject:  "Başvurunuz güncellendi: {{job_title}}",
+				TextBody: "Merhaba {{candidate_name}},\n\n{{job_title}} pozisyonuna yaptığınız başvurunun durumu güncellendi: {{status}}\n\n{{note}}\n\nBaşvurularınızı takip etmek için: {{tracking_link}}\n\n{{organization}}",
+			},
+			target:  types.JobApplicationRecipient{Email: "ayse@example.com", FullName: "Ayşe Yılmaz", JobTitle: "Yazılım Geliştirici"},
+			note:    "Mülakat tarihi için sizinle iletişime geçeceğiz.",
+			subject: "Başvurunuz güncellendi: Yazılım Geliştirici",
+			text: []string{
+				"Merhaba Ayşe Yılmaz,",
+				"Yazılım Geliştirici pozisyonuna",
+				"güncellendi: interview",
+				"Mülakat tarihi için sizinle iletişime geçeceğiz.",
+				"takip etmek için: " + trackingLink,
+				"Örnek Holding",
+			},
+			html: []string{
+				"<p>Merhaba Ayşe Yılmaz,</p>",
+				`<a href="` + trackingLink + `">` + trackingLink + `</a>`,
+			},
+		},
+		{
+			name: "HTML şablonunda değerler kaçışlanır",
+			template: types.EmailTemplate{
+				Event:    types.EmailEventStatusChanged,
+				Language: "en",
+				Subject:  "Update: {{job_title}}",
+				TextBody: "Hello {{candidate_name}}, track: {{tracking_link}}",
+				HTMLBody: `<html><body><p>Hello {{candidate_name}}</p><p>{{job_title}}</p><a href="{{tracking_link}}">Track</a></body></html>`,
+			},
+			target:  types.JobApplicationRecipient{Email: "john@example.com", FullName: "John <b>Doe</b>", JobTitle: "R&D Engineer"},
+			note:    "See you soon",
+			subject: "Update: R&D Engineer",
+			text: []string{
+				"Hello John <b>Doe</b>, track: " + trackingLink,
+				"See you soon",
+			},
+			html: []string{
+				"<p>Hello John &lt;b&gt;Doe&lt;/b&gt;</p>",
+				"<p>R&amp;D Engineer</p>",
+				`<a href="` + trackingLink + `">Track</a>`,
+				"<p>See you soon</p>",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			message := renderMessage(tt.template, tt.target, "interview", tt.note)
+
+			dir := t.TempDir()
+			mailer := mail.NewFileMailer(dir, "Kariyer <no-reply@example.com>")
+			err := mailer.Send(context.Background(), mail.Message{
+				To:      message.To,
+				Subject: message.Subject,
+				Text:    message.Text,
+				HTML:    message.HTML,
+			})
+			if err != nil {
+				t.Fatalf("e-posta yazılamadı: %v", err)
+			}
+
+			files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+			if err != nil || len(files) != 1 {
+				t.Fatalf("tek .eml dosyası bekleniyordu: %v (%v)", files, err)
+			}
+			subject, parts := readEML(t, files[0])
+
+			if subject != tt.subject {
+				t.Errorf("konu = %q, beklenen %q", subject, tt.subject)
+			}
+			for _, want := range tt.text {
+				if !strings.Contains(parts["text/plain"], want) {
+					t.Errorf("düz metin %q içermeli:\n%s", want, parts["text/plain"])
+				}
+			}
+			for _, want := range tt.html {
+				if !strings.Contains(parts["text/html"], want) {
+					t.Errorf("HTML %q içermeli:\n%s", want, parts["text/html"])
+				}
+			}
+			if tt.note != "" && strings.Index(parts["text/html"], tt.note) > strings.LastIndex(parts["text/html"], "</body>") {
+				t.Error("not </body> etiketinden önce eklenmeli")
+			}
+			if strings.Contains(parts["text/plain"]+parts["text/html"], "{{") {
+				t.Error("işlenmemiş değişken kalmamalı")
+			}
+		})
+	}
+}
+
+// readEML - .eml dosyasının konusunu ve içerik türüne göre çözülmüş gövde parçalarını döner
+func readEML(t *testing.T, path string) (string, map[string]string) {
+	t.Helper()
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("dosya açılamadı: %v", err)
+	}
+	defer file.Close()
+
+	message, err := netmail.ReadMessage(file)
+	if err != nil {
+		t.Fatalf("e-posta okunamadı: %v", err)
+	}
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(message.Header.Get("Subject"))
+	if err != nil {
+		t.Fatalf("konu çözülemedi: %v", err)
+	}
+
+	_, params, err := mime.ParseMediaType(message.Header.Get("Content-Type"))
+	if err != nil {
+		t.Fatalf("içerik türü çözülemedi: %v", err)
+	}
+
+	parts := map[string]string{}
+	reader := multipart.NewReader(message.Body, params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("gövde parçası okunamadı: %v", err)
+		}
+
+		mediaType, _, _ := mime.ParseMediaType(part.Header.Get("Content-Type"))
+		body, err := io.ReadAll(part)
+		if err != nil {
+			t.Fatalf("gövde parçası okunamadı: %v", err)
+		}
+		parts[mediaType] = string(body)
+	}
+
+	return subject, parts
+}
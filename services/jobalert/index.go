@@ -0,0 +1,113 @@
+// jobalert/index.go
+package jobalert
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/configs"
+	JobAlertRepository "github.com/okanay/backend-holding/repositories/jobalert"
+	"github.com/okanay/backend-holding/services/mail"
+	"github.com/okanay/backend-holding/types"
+)
+
+// Service iş alarmı e-postalarını (onay ve ilan özeti) gönderir
+type Service struct {
+	repository *JobAlertRepository.Repository
+	mailer     mail.Mailer
+	mu         sync.Mutex // Aynı anda tek bir özet gönderimi çalışsın
+}
+
+// NewService yeni bir iş alarmı servisi oluşturur
+func NewService(r *JobAlertRepository.Repository, m mail.Mailer) *Service {
+	return &Service{
+		repository: r,
+		mailer:     m,
+	}
+}
+
+// SendConfirmation aboneye çift onay (double opt-in) e-postası gönderir
+func (s *Service) SendConfirmation(ctx context.Context, subscription types.JobAlertSubscription) error {
+	message, err := buildConfirmationMessage(subscription)
+	if err != nil {
+		return err
+	}
+
+	if err := s.mailer.Send(ctx, message); err != nil {
+		return fmt.Errorf("onay e-postası gönderilemedi: %w", err)
+	}
+
+	return nil
+}
+
+// SendDigests verilen sıklıktaki aktif abonelere yeni ilanları gönderir, frequency boşsa tüm aboneler işlenir
+func (s *Service) SendDigests(ctx context.Context, frequency types.JobAlertFrequency) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	subscriptions, err := s.repository.ListActiveSubscriptions(ctx, frequency)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, subscription := range subscriptions {
+		if err := ctx.Err(); err != nil {
+			return sent, err
+		}
+
+		jobs, err := s.repository.ListPendingJobsForSubscription(ctx, subscription)
+		if err != nil {
+			log.Printf("[JOB ALERT] %s için ilanlar alınamadı: %v", subscription.ID, err)
+			continue
+		}
+
+		if len(jobs) == 0 {
+			continue
+		}
+
+		message, err := buildDigestMessage(subscription, jobs)
+		if err != nil {
+			log.Printf("[JOB ALERT] %s için e-posta hazırlanamadı: %v", subscription.ID, err)
+			continue
+		}
+
+		if err := s.mailer.Send(ctx, message); err != nil {
+			log.Printf("[JOB ALERT] %s için e-posta gönderilemedi: %v", subscription.ID, err)
+			continue
+		}
+
+		jobIDs := make([]uuid.UUID, 0, len(jobs))
+		for _, job := range jobs {
+			jobIDs = append(jobIDs, job.ID)
+		}
+
+		if err := s.repository.MarkJobsDelivered(ctx, subscription.ID, jobIDs); err != nil {
+			log.Printf("[JOB ALERT] %s için gönderim kaydedilemedi: %v", subscription.ID, err)
+			continue
+		}
+
+		sent++
+	}
+
+	if sent > 0 {
+		log.Printf("[JOB ALERT] %d aboneye ilan özeti gönderildi", sent)
+	}
+
+	return sent, nil
+}
+
+// NotifyJobPublished bir ilan yayınlandığında anlık bildirim isteyen abonelere arka planda gönderim yapar
+func (s *Service) NotifyJobPublished() {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), configs.JOB_ALERT_SEND_TIMEOUT)
+		defer cancel()
+
+		if _, err := s.SendDigests(ctx, types.JobAlertFrequencyInstant); err != nil {
+			log.Printf("[JOB ALERT] Anlık bildirimler gönderilemedi: %v", err)
+		}
+	}()
+}
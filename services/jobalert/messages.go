@@ -0,0 +1,146 @@
+package jobalert
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"strings"
+
+	"github.com/okanay/backend-holding/configs"
+	"github.com/okanay/backend-holding/services/mail"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+var confirmationTemplate = template.Must(template.New("confirmation").Parse(`<!DOCTYPE html>
+<html lang="tr">
+<body style="font-family: Arial, sans-serif; color: #1f2937;">
+  <p>Merhaba,</p>
+  <p>{{.Organization}} iş ilanı bildirimlerine abone olmak için aşağıdaki bağlantıya tıklayarak e-posta adresinizi onaylayın.</p>
+  <p><a href="{{.ConfirmURL}}">Aboneliğimi onayla</a></p>
+  <p style="font-size: 12px; color: #6b7280;">Bu isteği siz yapmadıysanız bu e-postayı dikkate almayın, herhangi bir bildirim almayacaksınız.</p>
+</body>
+</html>`))
+
+var digestTemplate = template.Must(template.New("digest").Parse(`<!DOCTYPE html>
+<html lang="tr">
+<body style="font-family: Arial, sans-serif; color: #1f2937;">
+  <p>Merhaba,</p>
+  <p>Kriterlerinize uyan {{len .Jobs}} yeni iş ilanı yayınlandı:</p>
+  <ul>
+  {{- range .Jobs}}
+    <li><a href="{{.URL}}">{{.Title}}</a>{{if .Meta}} — {{.Meta}}{{end}}</li>
+  {{- end}}
+  </ul>
+  <p style="font-size: 12px; color: #6b7280;">
+    <a href="{{.ManageURL}}">Bildirim tercihlerini düzenle</a> · <a href="{{.UnsubscribeURL}}">Abonelikten çık</a>
+  </p>
+</body>
+</html>`))
+
+// digestJob - Şablonda kullanılan ilan satırı
+type digestJob struct {
+	Title string
+	URL   string
+	Meta  string
+}
+
+// buildConfirmationMessage - Çift onay e-postasını hazırlar
+func buildConfirmationMessage(subscription types.JobAlertSubscription) (mail.Message, error) {
+	site := configs.GetSiteConfig()
+
+	token, err := utils.GenerateJobAlertToken(subscription.ID, configs.JOB_ALERT_CONFIRM_SUBJECT, configs.JOB_ALERT_CONFIRM_DURATION)
+	if err != nil {
+		return mail.Message{}, fmt.Errorf("onay token'ı oluşturulamadı: %w", err)
+	}
+
+	confirmURL := site.JobAlertURL("confirm", token)
+
+	var html bytes.Buffer
+	err = confirmationTemplate.Execute(&html, map[string]string{
+		"Organization": site.OrganizationName,
+		"ConfirmURL":   confirmURL,
+	})
+	if err != nil {
+		return mail.Message{}, fmt.Errorf("onay e-postası oluşturulamadı: %w", err)
+	}
+
+	text := fmt.Sprintf(
+		"Merhaba,\n\n%s iş ilanı bildirimlerine abone olmak için e-posta adresinizi onaylayın:\n%s\n\nBu isteği siz yapmadıysanız bu e-postayı dikkate almayın.\n",
+		site.OrganizationName, confirmURL,
+	)
+
+	return mail.Message{
+		To:      subscription.Email,
+		Subject: site.OrganizationName + " iş ilanı bildirimleri - aboneliğinizi onaylayın",
+		Text:    text,
+		HTML:    html.String(),
+	}, nil
+}
+
+// buildDigestMessage - Yeni ilanları içeren özet e-postasını hazırlar, her e-posta süresi dolmayan abonelikten çıkma linki taşır
+func buildDigestMessage(subscription types.JobAlertSubscription, jobs []types.JobAlertJob) (mail.Message, error) {
+	site := configs.GetSiteConfig()
+
+	token, err := utils.GenerateJobAlertToken(subscription.ID, configs.JOB_ALERT_MANAGE_SUBJECT, configs.JOB_ALERT_MANAGE_DURATION)
+	if err != nil {
+		return mail.Message{}, fmt.Errorf("yönetim token'ı oluşturulamadı: %w", err)
+	}
+
+	manageURL := site.JobAlertURL("manage", token)
+	unsubscribeURL := site.JobAlertURL("unsubscribe", subscription.UnsubscribeToken)
+
+	items := make([]digestJob, 0, len(jobs))
+	var text strings.Builder
+	text.WriteString("Merhaba,\n\nKriterlerinize uyan yeni iş ilanları yayınlandı:\n\n")
+
+	for _, job := range jobs {
+		meta := strings.Join(nonEmpty(job.Location, job.WorkMode, job.EmploymentType), " · ")
+		item := digestJob{Title: job.Title, URL: site.JobURL(job.Slug), Meta: meta}
+		items = append(items, item)
+
+		fmt.Fprintf(&text, "- %s\n  %s\n", item.Title, item.URL)
+		if meta != "" {
+			fmt.Fprintf(&text, "  %s\n", meta)
+		}
+	}
+
+	fmt.Fprintf(&text, "\nBildirim tercihlerini düzenle: %s\nAbonelikten çık: %s\n", manageURL, unsubscribeURL)
+
+	var html bytes.Buffer
+	err = digestTemplate.Execute(&html, map[string]any{
+		"Jobs":           items,
+		"ManageURL":      manageURL,
+		"UnsubscribeURL": unsubscribeURL,
+	})
+	if err != nil {
+		return mail.Message{}, fmt.Errorf("özet e-postası oluşturulamadı: %w", err)
+	}
+
+	subject := fmt.Sprintf("%s: %d yeni iş ilanı", site.OrganizationName, len(jobs))
+	if len(jobs) == 1 {
+		subject = fmt.Sprintf("%s: %s", site.OrganizationName, jobs[0].Title)
+	}
+
+	return mail.Message{
+		To:      subscription.Email,
+		Subject: subject,
+		Text:    text.String(),
+		HTML:    html.String(),
+		Headers: map[string]string{
+			"List-Unsubscribe":      "<" + site.JobAlertOneClickUnsubscribeURL(subscription.UnsubscribeToken) + ">",
+			"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+		},
+	}, nil
+}
+
+// nonEmpty - Boş olmayan değerleri döner
+func nonEmpty(values ...string) []string {
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if strings.TrimSpace(value) != "" {
+			result = append(result, value)
+		}
+	}
+	return result
+}
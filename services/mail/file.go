@@ -0,0 +1,47 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/okanay/backend-holding/utils"
+)
+
+// ===== FILE IMPLEMENTATION =====
+
+// FileMailer e-postaları göndermek yerine .eml dosyası olarak diske yazar (geliştirme ortamı için)
+type FileMailer struct {
+	dir  string
+	from string
+}
+
+// NewFileMailer yeni bir dosya tabanlı mailer oluşturur
+func NewFileMailer(dir, from string) *FileMailer {
+	return &FileMailer{dir: dir, from: from}
+}
+
+// Send e-postayı .eml dosyası olarak kaydeder
+func (m *FileMailer) Send(ctx context.Context, message Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	body, err := buildMIME(m.from, message)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(m.dir, 0o755); err != nil {
+		return fmt.Errorf("e-posta klasörü oluşturulamadı: %w", err)
+	}
+
+	name := fmt.Sprintf("%s-%s.eml", time.Now().Format("20060102-150405"), utils.GenerateRandomString(8))
+	if err := os.WriteFile(filepath.Join(m.dir, name), body, 0o644); err != nil {
+		return fmt.Errorf("e-posta dosyası yazılamadı: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+// mail/index.go
+package mail
+
+import (
+	"context"
+	"fmt"
+	"os"
+)
+
+// Message - Gönderilecek e-posta
+type Message struct {
+	To      string
+	Subject string
+	Text    string            // Düz metin gövde
+	HTML    string            // HTML gövde (opsiyonel)
+	Headers map[string]string // Ek başlıklar (örn. List-Unsubscribe)
+
+	Attachments []Attachment
+}
+
+// Attachment - E-posta eki
+type Attachment struct {
+	Filename    string
+	ContentType string // örn. "text/calendar; method=REQUEST"
+	Data        []byte
+}
+
+// Mailer, tüm e-posta gönderim implementasyonları için ortak arayüz
+type Mailer interface {
+	Send(ctx context.Context, message Message) error
+}
+
+// NewMailer, ortam değişkenlerine göre uygun e-posta servisini döndürür
+func NewMailer() Mailer {
+	backend := os.Getenv("MAIL_BACKEND")
+	from := os.Getenv("MAIL_FROM")
+
+	if backend == "smtp" {
+		host := os.Getenv("SMTP_HOST")
+		port := os.Getenv("SMTP_PORT")
+		if port == "" {
+			port = "587"
+		}
+
+		fmt.Println("📧 [SMTP MAIL] : Starting SMTP mail backend")
+		fmt.Printf("🔗 SMTP address : %s:%s\n", host, port)
+		return NewSMTPMailer(host, port, os.Getenv("SMTP_USERNAME"), os.Getenv("SMTP_PASSWORD"), from)
+	}
+
+	dir := os.Getenv("MAIL_FILE_DIR")
+	if dir == "" {
+		dir = "./tmp/mails"
+	}
+
+	fmt.Println("📁 [FILE MAIL] : Starting file mail backend")
+	fmt.Printf("📂 Mail directory : %s\n", dir)
+	return NewFileMailer(dir, from)
+}
@@ -0,0 +1,114 @@
+package mail
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net/textproto"
+	"sort"
+	"time"
+
+	"github.com/okanay/backend-holding/utils"
+)
+
+// buildMIME - Mesajı MIME formatına dönüştürür; ek varsa gövde multipart/mixed içine alınır
+func buildMIME(from string, message Message) ([]byte, error) {
+	var buf bytes.Buffer
+	boundary := "hoi-" + utils.GenerateRandomString(24)
+
+	contentType := fmt.Sprintf("multipart/alternative; boundary=%q", boundary)
+	mixedBoundary := ""
+	if len(message.Attachments) > 0 {
+		mixedBoundary = "hoi-" + utils.GenerateRandomString(24)
+		contentType = fmt.Sprintf("multipart/mixed; boundary=%q", mixedBoundary)
+	}
+
+	headers := map[string]string{
+		"From":         from,
+		"To":           message.To,
+		"Subject":      mime.QEncoding.Encode("utf-8", message.Subject),
+		"Date":         time.Now().Format(time.RFC1123Z),
+		"MIME-Version": "1.0",
+		"Content-Type": contentType,
+	}
+	for key, value := range message.Headers {
+		headers[textproto.CanonicalMIMEHeaderKey(key)] = value
+	}
+
+	// Başlıkları sabit sırada yaz
+	keys := make([]string, 0, len(headers))
+	for key := range headers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fmt.Fprintf(&buf, "%s: %s\r\n", key, headers[key])
+	}
+	buf.WriteString("\r\n")
+
+	if mixedBoundary != "" {
+		fmt.Fprintf(&buf, "--%s\r\n", mixedBoundary)
+		fmt.Fprintf(&buf, "Content-Type: multipart/alternative; boundary=%q\r\n\r\n", boundary)
+	}
+
+	if err := writePart(&buf, boundary, "text/plain", message.Text); err != nil {
+		return nil, err
+	}
+
+	if message.HTML != "" {
+		if err := writePart(&buf, boundary, "text/html", message.HTML); err != nil {
+			return nil, err
+		}
+	}
+
+	fmt.Fprintf(&buf, "--%s--\r\n", boundary)
+
+	if mixedBoundary != "" {
+		for _, attachment := range message.Attachments {
+			writeAttachment(&buf, mixedBoundary, attachment)
+		}
+		fmt.Fprintf(&buf, "--%s--\r\n", mixedBoundary)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// writePart - Tek bir gövde parçasını quoted-printable olarak yazar
+func writePart(buf *bytes.Buffer, boundary, contentType, body string) error {
+	fmt.Fprintf(buf, "--%s\r\n", boundary)
+	fmt.Fprintf(buf, "Content-Type: %s; charset=utf-8\r\n", contentType)
+	buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+
+	writer := quotedprintable.NewWriter(buf)
+	if _, err := writer.Write([]byte(body)); err != nil {
+		return fmt.Errorf("e-posta gövdesi yazılamadı: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("e-posta gövdesi yazılamadı: %w", err)
+	}
+
+	buf.WriteString("\r\n")
+	return nil
+}
+
+// writeAttachment - Eki base64 olarak 76 karakterlik satırlarla yazar
+func writeAttachment(buf *bytes.Buffer, boundary string, attachment Attachment) {
+	contentType := attachment.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	fmt.Fprintf(buf, "--%s\r\n", boundary)
+	fmt.Fprintf(buf, "Content-Type: %s; name=%q\r\n", contentType, attachment.Filename)
+	fmt.Fprintf(buf, "Content-Disposition: attachment; filename=%q\r\n", attachment.Filename)
+	buf.WriteString("Content-Transfer-Encoding: base64\r\n\r\n")
+
+	encoded := base64.StdEncoding.EncodeToString(attachment.Data)
+	for len(encoded) > 76 {
+		buf.WriteString(encoded[:76] + "\r\n")
+		encoded = encoded[76:]
+	}
+	buf.WriteString(encoded + "\r\n\r\n")
+}
@@ -0,0 +1,103 @@
+package mail
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	netmail "net/mail"
+	"net/smtp"
+)
+
+// ===== SMTP IMPLEMENTATION =====
+
+// SMTPMailer SMTP sunucusu üzerinden e-posta gönderir
+type SMTPMailer struct {
+	host     string
+	port     string
+	username string
+	password string
+	from     string
+}
+
+// NewSMTPMailer yeni bir SMTP mailer oluşturur
+func NewSMTPMailer(host, port, username, password, from string) *SMTPMailer {
+	return &SMTPMailer{
+		host:     host,
+		port:     port,
+		username: username,
+		password: password,
+		from:     from,
+	}
+}
+
+// Send e-postayı SMTP üzerinden gönderir
+func (m *SMTPMailer) Send(ctx context.Context, message Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	body, err := buildMIME(m.from, message)
+	if err != nil {
+		return err
+	}
+
+	address := net.JoinHostPort(m.host, m.port)
+
+	// Zarf göndericisi görünen ad içermemeli ("Ad <adres>" -> "adres")
+	sender := m.from
+	if parsed, err := netmail.ParseAddress(m.from); err == nil {
+		sender = parsed.Address
+	}
+
+	var auth smtp.Auth
+	if m.username != "" {
+		auth = smtp.PlainAuth("", m.username, m.password, m.host)
+	}
+
+	// 465 portu doğrudan TLS kullanır, diğerleri STARTTLS
+	if m.port != "465" {
+		if err := smtp.SendMail(address, auth, sender, []string{message.To}, body); err != nil {
+			return fmt.Errorf("e-posta gönderilemedi: %w", err)
+		}
+		return nil
+	}
+
+	conn, err := tls.Dial("tcp", address, &tls.Config{ServerName: m.host})
+	if err != nil {
+		return fmt.Errorf("SMTP sunucusuna bağlanılamadı: %w", err)
+	}
+
+	client, err := smtp.NewClient(conn, m.host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("SMTP istemcisi oluşturulamadı: %w", err)
+	}
+	defer client.Close()
+
+	if auth != nil {
+		if err := client.Auth(auth); err != nil {
+			return fmt.Errorf("SMTP kimlik doğrulaması başarısız: %w", err)
+		}
+	}
+
+	if err := client.Mail(sender); err != nil {
+		return fmt.Errorf("gönderen adresi reddedildi: %w", err)
+	}
+	if err := client.Rcpt(message.To); err != nil {
+		return fmt.Errorf("alıcı adresi reddedildi: %w", err)
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("e-posta gövdesi gönderilemedi: %w", err)
+	}
+	if _, err := writer.Write(body); err != nil {
+		return fmt.Errorf("e-posta gövdesi gönderilemedi: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("e-posta gövdesi gönderilemedi: %w", err)
+	}
+
+	return client.Quit()
+}
@@ -0,0 +1,74 @@
+// outbox/index.go
+package outbox
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	"github.com/okanay/backend-holding/configs"
+	OutboxRepository "github.com/okanay/backend-holding/repositories/outbox"
+	"github.com/okanay/backend-holding/services/mail"
+)
+
+// Service kuyruktaki e-postaları arka planda gönderir
+type Service struct {
+	repository *OutboxRepository.Repository
+	mailer     mail.Mailer
+	mu         sync.Mutex // Aynı sunucuda aynı anda tek bir gönderim turu çalışsın
+}
+
+// NewService yeni bir e-posta kuyruğu servisi oluşturur
+func NewService(r *OutboxRepository.Repository, m mail.Mailer) *Service {
+	return &Service{
+		repository: r,
+		mailer:     m,
+	}
+}
+
+// Dispatch gönderim zamanı gelen e-postaları gönderir (zamanlayıcı tarafından çağrılır)
+func (s *Service) Dispatch(ctx context.Context) {
+	if !s.mu.TryLock() {
+		return
+	}
+	defer s.mu.Unlock()
+
+	sent, failed := 0, 0
+	for ctx.Err() == nil {
+		messages, err := s.repository.ClaimPending(ctx, configs.OUTBOX_BATCH_SIZE)
+		if err != nil {
+			log.Printf("[OUTBOX] Kuyruk okunamadı: %v", err)
+			return
+		}
+
+		for _, message := range messages {
+			err := s.mailer.Send(ctx, mail.Message{
+				To:      message.To,
+				Subject: message.Subject,
+				Text:    message.Text,
+				HTML:    message.HTML,
+			})
+
+			if err != nil {
+				failed++
+				if markErr := s.repository.MarkFailed(ctx, message, err); markErr != nil {
+					log.Printf("[OUTBOX] %v", markErr)
+				}
+				continue
+			}
+
+			sent++
+			if markErr := s.repository.MarkSent(ctx, message.ID); markErr != nil {
+				log.Printf("[OUTBOX] %v", markErr)
+			}
+		}
+
+		if len(messages) < configs.OUTBOX_BATCH_SIZE {
+			break
+		}
+	}
+
+	if sent > 0 || failed > 0 {
+		log.Printf("[OUTBOX] %d e-posta gönderildi, %d e-posta gönderilemedi", sent, failed)
+	}
+}
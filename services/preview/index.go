@@ -0,0 +1,90 @@
+// preview/index.go
+package preview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/configs"
+	PreviewRepository "github.com/okanay/backend-holding/repositories/preview"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// ErrInvalidLink token geçersiz, süresi dolmuş, iptal edilmiş veya başka bir kayda ait olduğunda döner
+var ErrInvalidLink = errors.New("önizleme linki geçersiz veya süresi dolmuş")
+
+// Service taslak önizleme linklerini üretir ve doğrular
+type Service struct {
+	repository *PreviewRepository.Repository
+}
+
+// NewService yeni bir önizleme servisi oluşturur
+func NewService(r *PreviewRepository.Repository) *Service {
+	return &Service{
+		repository: r,
+	}
+}
+
+// CreateLink kayıt için süreli önizleme linki oluşturur; token ve adres sadece bu yanıtta döner
+func (s *Service) CreateLink(ctx context.Context, resource types.PreviewResource, resourceID, userID uuid.UUID, input types.PreviewLinkInput) (types.PreviewLink, error) {
+	duration := configs.PREVIEW_DEFAULT_DURATION
+	if input.ExpiresInHours > 0 {
+		duration = time.Duration(input.ExpiresInHours) * time.Hour
+	}
+
+	// Token ile kaydın bitiş zamanı aynı olsun diye saniyeye yuvarlanır (JWT saniye hassasiyetindedir)
+	expiresAt := time.Now().Add(duration).Truncate(time.Second)
+
+	link, err := s.repository.CreateLink(ctx, resource, resourceID, userID, input.Note, expiresAt)
+	if err != nil {
+		return link, err
+	}
+
+	link.Token, err = utils.GeneratePreviewToken(link.ID, expiresAt)
+	if err != nil {
+		return link, fmt.Errorf("önizleme token'ı üretilemedi: %w", err)
+	}
+	link.URL = configs.GetSiteConfig().PreviewURL(string(resource), link.Token)
+
+	return link, nil
+}
+
+// Resolve token'ı doğrular ve önizlenecek kaydın ID'sini döner
+// İmza ve süre kontrolünden sonra linkin iptal edilmediği veritabanından doğrulanır.
+func (s *Service) Resolve(ctx context.Context, resource types.PreviewResource, token string) (uuid.UUID, error) {
+	linkID, err := utils.VerifyPreviewToken(token)
+	if err != nil {
+		return uuid.Nil, ErrInvalidLink
+	}
+
+	link, err := s.repository.GetLink(ctx, linkID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if link.ID == uuid.Nil || link.ResourceType != resource || link.RevokedAt != nil || time.Now().After(link.ExpiresAt) {
+		return uuid.Nil, ErrInvalidLink
+	}
+
+	// Görüntülenme istatistiği önizlemeyi engellememeli
+	if err := s.repository.RecordView(ctx, link.ID); err != nil {
+		log.Printf("[PREVIEW] %v", err)
+	}
+
+	return link.ResourceID, nil
+}
+
+// ListLinks kaydın önizleme linklerini döner (token'lar tekrar üretilmez)
+func (s *Service) ListLinks(ctx context.Context, resource types.PreviewResource, resourceID uuid.UUID) ([]types.PreviewLink, error) {
+	return s.repository.ListLinks(ctx, resource, resourceID)
+}
+
+// RevokeLink linki iptal eder, token'ın süresi dolmamış olsa bile artık kabul edilmez
+func (s *Service) RevokeLink(ctx context.Context, resource types.PreviewResource, resourceID, linkID uuid.UUID) error {
+	return s.repository.RevokeLink(ctx, resource, resourceID, linkID)
+}
@@ -0,0 +1,289 @@
+// privacy/index.go
+package privacy
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/configs"
+	FileRepository "github.com/okanay/backend-holding/repositories/file"
+	JobRepository "github.com/okanay/backend-holding/repositories/job"
+	JobAlertRepository "github.com/okanay/backend-holding/repositories/jobalert"
+	OutboxRepository "github.com/okanay/backend-holding/repositories/outbox"
+	PrivacyRepository "github.com/okanay/backend-holding/repositories/privacy"
+	R2Repository "github.com/okanay/backend-holding/repositories/r2"
+	"github.com/okanay/backend-holding/services/cache"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+)
+
+// Service KVKK saklama süresi, aday verisi dışa aktarımı ve silme taleplerini yönetir
+type Service struct {
+	privacy   *PrivacyRepository.Repository
+	jobs      *JobRepository.Repository
+	jobAlerts *JobAlertRepository.Repository
+	outbox    *OutboxRepository.Repository
+	files     *FileRepository.Repository
+	r2        *R2Repository.Repository
+	cache     cache.CacheService
+	mu        sync.Mutex // Aynı anda tek bir saklama süresi işlemi çalışsın
+}
+
+// NewService yeni bir kişisel veri servisi oluşturur
+func NewService(p *PrivacyRepository.Repository, j *JobRepository.Repository, ja *JobAlertRepository.Repository, o *OutboxRepository.Repository, f *FileRepository.Repository, r2 *R2Repository.Repository, c cache.CacheService) *Service {
+	return &Service{
+		privacy:   p,
+		jobs:      j,
+		jobAlerts: ja,
+		outbox:    o,
+		files:     f,
+		r2:        r2,
+		cache:     c,
+	}
+}
+
+// HashEmail e-posta adresinin kayıtlarda kullanılan SHA-256 özetini döner
+func HashEmail(email string) string {
+	sum := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(email))))
+	return hex.EncodeToString(sum[:])
+}
+
+// RunRetention saklama süresi dolan başvuruları anonimleştirir, eklerini ve eski e-posta kuyruğu kayıtlarını siler (zamanlayıcı tarafından çağrılır)
+func (s *Service) RunRetention(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	before := time.Now().Add(-configs.GetPrivacyConfig().ApplicationRetention)
+	var total types.ApplicantErasureResult
+	var failures []error
+
+	for ctx.Err() == nil {
+		records, err := s.privacy.ListExpiredApplications(ctx, before, configs.PRIVACY_RETENTION_BATCH_SIZE)
+		if err != nil {
+			failures = append(failures, err)
+			break
+		}
+
+		if len(records) == 0 {
+			break
+		}
+
+		result, err := s.eraseApplications(ctx, records)
+		total.ApplicationsAffected += result.ApplicationsAffected
+		total.FilesDeleted += result.FilesDeleted
+
+		if err != nil {
+			failures = append(failures, err)
+			// Hiçbir kayıt işlenemediyse aynı kayıtlarla sonsuz döngüye girme
+			if result.ApplicationsAffected == 0 {
+				break
+			}
+		}
+
+		if len(records) < configs.PRIVACY_RETENTION_BATCH_SIZE {
+			break
+		}
+	}
+
+	// Gönderilmiş veya başarısız e-postalar kuyrukta kişisel veri olarak birikmesin
+	purged, err := s.outbox.PurgeBefore(ctx, time.Now().Add(-configs.OUTBOX_RETENTION))
+	if err != nil {
+		failures = append(failures, err)
+	} else if purged > 0 {
+		log.Printf("[PRIVACY] E-posta kuyruğundan %d eski kayıt silindi", purged)
+	}
+
+	// İşlem yapılmayan günler için kayıt oluşturma
+	if total.ApplicationsAffected == 0 && len(failures) == 0 {
+		return
+	}
+
+	s.logRequest(ctx, types.DataPrivacyRequestRetention, "", "", total, errors.Join(failures...))
+	s.cache.ClearGroup(cache.GroupJobs)
+
+	log.Printf("[PRIVACY] Saklama süresi dolan %d başvuru anonimleştirildi, %d dosya silindi", total.ApplicationsAffected, total.FilesDeleted)
+}
+
+// ExportApplicantData adayın sistemdeki tüm verilerini döner ve talebi kayıt altına alır
+func (s *Service) ExportApplicantData(ctx context.Context, email string, ipAddress string) (types.ApplicantDataExport, error) {
+	export := types.ApplicantDataExport{
+		Email:      email,
+		ExportedAt: time.Now(),
+	}
+
+	err := s.collectExport(ctx, &export)
+
+	var result types.ApplicantErasureResult
+	result.ApplicationsAffected = len(export.Applications)
+	s.logRequest(ctx, types.DataPrivacyRequestExport, email, ipAddress, result, err)
+
+	return export, err
+}
+
+// collectExport - Dışa aktarımın tüm bölümlerini doldurur
+func (s *Service) collectExport(ctx context.Context, export *types.ApplicantDataExport) error {
+	var err error
+
+	if export.Applications, err = s.jobs.GetJobApplicationsByEmail(ctx, export.Email); err != nil {
+		return err
+	}
+
+	if export.StatusHistory, err = s.privacy.GetStatusHistoryByEmail(ctx, export.Email); err != nil {
+		return err
+	}
+
+	interviews, err := s.jobs.ListInterviewsByEmail(ctx, export.Email)
+	if err != nil {
+		return err
+	}
+	export.Interviews = make([]types.ApplicantInterview, 0, len(interviews))
+	for _, interview := range interviews {
+		export.Interviews = append(export.Interviews, types.ApplicantInterview{
+			ID:            interview.ID,
+			ApplicationID: interview.ApplicationID,
+			StartsAt:      interview.StartsAt,
+			EndsAt:        interview.EndsAt,
+			Location:      interview.Location,
+			VideoURL:      interview.VideoURL,
+			Status:        interview.Status,
+			CandidateNote: interview.CandidateNote,
+		})
+	}
+
+	if export.AlertSubscriptions, err = s.jobAlerts.ListSubscriptionsByEmail(ctx, export.Email); err != nil {
+		return err
+	}
+
+	var urls []string
+	for _, application := range export.Applications {
+		urls = append(urls, utils.CollectURLs(application.FormJSON)...)
+	}
+	if export.Files, err = s.files.GetFilesByURLs(ctx, urls); err != nil {
+		return fmt.Errorf("başvuru ekleri getirilemedi: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteApplicantData adayın başvurularını anonimleştirir, eklerini, alarm aboneliklerini, kuyruktaki e-postalarını ve takip oturumlarını siler
+func (s *Service) DeleteApplicantData(ctx context.Context, email string, ipAddress string) (types.ApplicantErasureResult, error) {
+	result, err := s.deleteApplicantData(ctx, email)
+	s.logRequest(ctx, types.DataPrivacyRequestDelete, email, ipAddress, result, err)
+	s.cache.ClearGroup(cache.GroupJobs)
+
+	return result, err
+}
+
+// deleteApplicantData - Silme talebinin adımlarını uygular
+func (s *Service) deleteApplicantData(ctx context.Context, email string) (types.ApplicantErasureResult, error) {
+	records, err := s.privacy.ListApplicationsByEmail(ctx, email)
+	if err != nil {
+		return types.ApplicantErasureResult{}, err
+	}
+
+	result, err := s.eraseApplications(ctx, records)
+	if err != nil {
+		return result, err
+	}
+
+	if _, err := s.jobAlerts.DeleteSubscriptionsByEmail(ctx, email); err != nil {
+		return result, err
+	}
+
+	if _, err := s.outbox.DeleteByEmail(ctx, email); err != nil {
+		return result, err
+	}
+
+	if err := s.privacy.DeleteTrackingData(ctx, email); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// eraseApplications - Başvuruların eklerini R2'den siler ve başarılı olanları anonimleştirir
+// Eki silinemeyen başvuru anonimleştirilmez, böylece form verisindeki dosya bağlantısı kaybolmaz ve sonraki çalışmada tekrar denenir.
+func (s *Service) eraseApplications(ctx context.Context, records []types.ApplicantRecord) (types.ApplicantErasureResult, error) {
+	var result types.ApplicantErasureResult
+	var failures []error
+	ready := make([]uuid.UUID, 0, len(records))
+
+	for _, record := range records {
+		deleted, err := s.deleteAttachments(ctx, utils.CollectURLs(record.FormJSON))
+		result.FilesDeleted += deleted
+
+		if err != nil {
+			failures = append(failures, fmt.Errorf("başvuru %s: %w", record.ID, err))
+			continue
+		}
+
+		ready = append(ready, record.ID)
+	}
+
+	affected, err := s.privacy.AnonymizeApplications(ctx, ready)
+	result.ApplicationsAffected = affected
+	if err != nil {
+		failures = append(failures, err)
+	}
+
+	return result, errors.Join(failures...)
+}
+
+// DeleteApplicationAttachments silinen başvuruların form verisindeki eklerini R2'den siler
+func (s *Service) DeleteApplicationAttachments(ctx context.Context, formJSONs []string) (int, error) {
+	var urls []string
+	for _, formJSON := range formJSONs {
+		urls = append(urls, utils.CollectURLs(formJSON)...)
+	}
+
+	return s.deleteAttachments(ctx, urls)
+}
+
+// deleteAttachments - Dosyaları R2'den siler ve kayıtlarını 'deleted' olarak işaretler
+func (s *Service) deleteAttachments(ctx context.Context, urls []string) (int, error) {
+	files, err := s.files.GetFilesByURLs(ctx, urls)
+	if err != nil {
+		return 0, fmt.Errorf("başvuru ekleri getirilemedi: %w", err)
+	}
+
+	deleted := 0
+	for _, file := range files {
+		if err := s.r2.DeleteObject(ctx, s.r2.ObjectKeyFromURL(file.URL)); err != nil {
+			return deleted, err
+		}
+
+		if err := s.files.DeleteFile(ctx, file.ID); err != nil {
+			return deleted, fmt.Errorf("dosya kaydı silinemedi (ID: %s): %w", file.ID, err)
+		}
+
+		deleted++
+	}
+
+	return deleted, nil
+}
+
+// logRequest - Talebi ve sonucunu yönetici kaydına yazar
+func (s *Service) logRequest(ctx context.Context, requestType types.DataPrivacyRequestType, email string, ipAddress string, result types.ApplicantErasureResult, failure error) {
+	emailHash := ""
+	if email != "" {
+		emailHash = HashEmail(email)
+	}
+
+	requestID, err := s.privacy.CreateRequest(ctx, requestType, emailHash, ipAddress)
+	if err != nil {
+		log.Printf("[PRIVACY] Talep kaydı oluşturulamadı: %v", err)
+		return
+	}
+
+	if err := s.privacy.CompleteRequest(ctx, requestID, result, failure); err != nil {
+		log.Printf("[PRIVACY] Talep kaydı güncellenemedi: %v", err)
+	}
+}
@@ -0,0 +1,56 @@
+// publishing/index.go
+package publishing
+
+import (
+	"context"
+	"log"
+
+	ContentRepository "github.com/okanay/backend-holding/repositories/content"
+	JobRepository "github.com/okanay/backend-holding/repositories/job"
+	"github.com/okanay/backend-holding/services/cache"
+	"github.com/okanay/backend-holding/services/jobalert"
+)
+
+// Service zamanlanmış yayın ve kapatma geçişlerini uygular
+type Service struct {
+	jobs     *JobRepository.Repository
+	contents *ContentRepository.Repository
+	cache    cache.CacheService
+	jobAlert *jobalert.Service
+}
+
+// NewService yeni bir zamanlanmış yayın servisi oluşturur
+func NewService(j *JobRepository.Repository, c *ContentRepository.Repository, cs cache.CacheService, ja *jobalert.Service) *Service {
+	return &Service{
+		jobs:     j,
+		contents: c,
+		cache:    cs,
+		jobAlert: ja,
+	}
+}
+
+// RunScheduledTransitions zamanı gelen ilan ve içerikleri yayınlar/kapatır, değişiklik varsa ilgili cache gruplarını temizler
+func (s *Service) RunScheduledTransitions(ctx context.Context) {
+	// İş ilanları
+	jobResult, err := s.jobs.ApplyScheduledTransitions(ctx)
+	if err != nil {
+		log.Printf("[PUBLISHING] İş ilanı zamanlamaları uygulanamadı: %v", err)
+	} else if len(jobResult.Published) > 0 || len(jobResult.Unpublished) > 0 {
+		log.Printf("[PUBLISHING] %d iş ilanı yayınlandı, %d iş ilanı kapatıldı", len(jobResult.Published), len(jobResult.Unpublished))
+		s.cache.ClearGroup(cache.GroupJobs)
+
+		// Yayınlanan ilanları anlık bildirim isteyen abonelere gönder
+		if len(jobResult.Published) > 0 {
+			s.jobAlert.NotifyJobPublished()
+		}
+	}
+
+	// İçerikler
+	contentResult, err := s.contents.ApplyScheduledTransitions(ctx)
+	if err != nil {
+		log.Printf("[PUBLISHING] İçerik zamanlamaları uygulanamadı: %v", err)
+	} else if len(contentResult.Published) > 0 || len(contentResult.Unpublished) > 0 {
+		log.Printf("[PUBLISHING] %d içerik yayınlandı, %d içerik kapatıldı", len(contentResult.Published), len(contentResult.Unpublished))
+		s.cache.ClearGroup(cache.GroupContent)
+	}
+}
@@ -0,0 +1,99 @@
+// scheduler/index.go
+package scheduler
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+// Job - Zamanlanmış olarak çalıştırılacak iş
+type Job func(ctx context.Context)
+
+// Scheduler arka planda periyodik işleri çalıştırır
+type Scheduler struct {
+	wg   sync.WaitGroup
+	stop chan struct{}
+}
+
+// NewScheduler yeni bir zamanlayıcı oluşturur
+func NewScheduler() *Scheduler {
+	return &Scheduler{stop: make(chan struct{})}
+}
+
+// Every işi verilen aralıklarla çalıştırır
+func (s *Scheduler) Every(name string, interval time.Duration, job Job) {
+	s.wg.Add(1)
+
+	go func() {
+		defer s.wg.Done()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		log.Printf("[SCHEDULER] %s her %s çalışacak", name, interval)
+
+		for {
+			select {
+			case <-ticker.C:
+				s.run(name, job)
+			case <-s.stop:
+				log.Printf("[SCHEDULER] %s durduruldu", name)
+				return
+			}
+		}
+	}()
+}
+
+// DailyAt işi her gün verilen saat ve dakikada (sunucu saatine göre) çalıştırır
+func (s *Scheduler) DailyAt(name string, hour, minute int, job Job) {
+	s.wg.Add(1)
+
+	go func() {
+		defer s.wg.Done()
+
+		for {
+			next := nextDailyRun(time.Now(), hour, minute)
+			log.Printf("[SCHEDULER] %s bir sonraki çalışma: %s", name, next.Format(time.RFC3339))
+
+			timer := time.NewTimer(time.Until(next))
+			select {
+			case <-timer.C:
+				s.run(name, job)
+			case <-s.stop:
+				timer.Stop()
+				log.Printf("[SCHEDULER] %s durduruldu", name)
+				return
+			}
+		}
+	}()
+}
+
+// Stop tüm zamanlanmış işleri durdurur ve çalışan işlerin bitmesini bekler
+func (s *Scheduler) Stop() {
+	close(s.stop)
+	s.wg.Wait()
+}
+
+// run - İşi panic'lere karşı korumalı şekilde çalıştırır
+func (s *Scheduler) run(name string, job Job) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("[SCHEDULER] %s çalışırken panic: %v", name, r)
+		}
+	}()
+
+	start := time.Now()
+	job(context.Background())
+	log.Printf("[SCHEDULER] %s tamamlandı (%s)", name, time.Since(start))
+}
+
+// nextDailyRun - Verilen saat ve dakikaya göre bir sonraki çalışma zamanını hesaplar
+func nextDailyRun(now time.Time, hour, minute int) time.Time {
+	next := time.Date(now.Year(), now.Month(), now.Day(), hour, minute, 0, 0, now.Location())
+	if !next.After(now) {
+		next = next.Add(24 * time.Hour)
+	}
+	return next
+}
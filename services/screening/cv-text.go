@@ -0,0 +1,262 @@
+package screening
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/zlib"
+	"encoding/xml"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// extractText dosya türüne göre ekin düz metnini çıkarır, desteklenmeyen türler için boş döner
+// Harici kütüphane kullanılmaz; PDF için sadece metin katmanı olan (taranmamış) basit belgeler okunabilir.
+func extractText(fileType string, filename string, data []byte) string {
+	ext := strings.ToLower(path.Ext(filename))
+
+	switch {
+	case fileType == "application/pdf" || ext == ".pdf":
+		return extractPDFText(data)
+	case strings.Contains(fileType, "wordprocessingml") || ext == ".docx":
+		return extractDOCXText(data)
+	case strings.HasPrefix(fileType, "text/") || ext == ".txt" || ext == ".md":
+		if utf8.Valid(data) {
+			return string(data)
+		}
+	}
+
+	return ""
+}
+
+// extractDOCXText - word/document.xml içindeki paragrafları okur
+func extractDOCXText(data []byte) string {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return ""
+	}
+
+	for _, file := range archive.File {
+		if file.Name != "word/document.xml" {
+			continue
+		}
+
+		reader, err := file.Open()
+		if err != nil {
+			return ""
+		}
+		defer reader.Close()
+
+		var b strings.Builder
+		decoder := xml.NewDecoder(io.LimitReader(reader, 20*1024*1024))
+		inText := false
+		for {
+			token, err := decoder.Token()
+			if err != nil {
+				break
+			}
+
+			switch t := token.(type) {
+			case xml.StartElement:
+				switch t.Name.Local {
+				case "t":
+					inText = true
+				case "tab":
+					b.WriteString("\t")
+				case "br":
+					b.WriteString("\n")
+				}
+			case xml.EndElement:
+				switch t.Name.Local {
+				case "t":
+					inText = false
+				case "p":
+					b.WriteString("\n")
+				}
+			case xml.CharData:
+				if inText {
+					b.Write(t)
+				}
+			}
+		}
+
+		return b.String()
+	}
+
+	return ""
+}
+
+// pdfStreamPattern - PDF içindeki stream bloklarını ve sözlüklerini yakalar
+var pdfStreamPattern = regexp.MustCompile(`(?s)<<(.*?)>>\s*stream\r?\n`)
+
+// extractPDFText - Sıkıştırılmış (FlateDecode) veya düz içerik akışlarındaki metin operatörlerini okur
+func extractPDFText(data []byte) string {
+	var b strings.Builder
+
+	for _, match := range pdfStreamPattern.FindAllSubmatchIndex(data, -1) {
+		dictionary := data[match[2]:match[3]]
+		start := match[1]
+		end := bytes.Index(data[start:], []byte("endstream"))
+		if end < 0 {
+			continue
+		}
+		content := data[start : start+end]
+
+		// Görsel ve font akışları atlanır
+		if bytes.Contains(dictionary, []byte("/Image")) || bytes.Contains(dictionary, []byte("/FontFile")) {
+			continue
+		}
+
+		if bytes.Contains(dictionary, []byte("/FlateDecode")) {
+			reader, err := zlib.NewReader(bytes.NewReader(content))
+			if err != nil {
+				continue
+			}
+			content, err = io.ReadAll(io.LimitReader(reader, 20*1024*1024))
+			reader.Close()
+			if err != nil && len(content) == 0 {
+				continue
+			}
+		} else if bytes.Contains(dictionary, []byte("/Filter")) {
+			continue // Desteklenmeyen sıkıştırma
+		}
+
+		b.WriteString(pdfContentText(content))
+	}
+
+	text := b.String()
+	if !looksLikeText(text) {
+		return ""
+	}
+
+	return text
+}
+
+// pdfContentText - İçerik akışında BT/ET blokları arasındaki metin dizilerini toplar
+func pdfContentText(content []byte) string {
+	var b strings.Builder
+	inText := false
+
+	for i := 0; i < len(content); i++ {
+		switch ch := content[i]; {
+		case ch == '(' && inText:
+			value, next := readPDFString(content, i)
+			b.WriteString(value)
+			i = next
+		case ch == '%':
+			// Yorum satırı
+			for i < len(content) && content[i] != '\n' && content[i] != '\r' {
+				i++
+			}
+		default:
+			operator := pdfOperator(content, i)
+			switch operator {
+			case "BT":
+				inText = true
+			case "ET":
+				inText = false
+				b.WriteString("\n")
+			case "Td", "TD", "T*", "'", "\"":
+				if inText {
+					b.WriteString("\n")
+				}
+			case "Tj", "TJ":
+				if inText {
+					b.WriteString(" ")
+				}
+			}
+			if operator != "" {
+				i += len(operator) - 1
+			}
+		}
+	}
+
+	return b.String()
+}
+
+// pdfOperator - Konumdaki kelime bir metin operatörüyse döner
+func pdfOperator(content []byte, i int) string {
+	if i > 0 && !isPDFDelimiter(content[i-1]) {
+		return ""
+	}
+
+	for _, operator := range []string{"BT", "ET", "Td", "TD", "T*", "Tj", "TJ", "'", "\""} {
+		end := i + len(operator)
+		if end <= len(content) && string(content[i:end]) == operator && (end == len(content) || isPDFDelimiter(content[end])) {
+			return operator
+		}
+	}
+
+	return ""
+}
+
+func isPDFDelimiter(ch byte) bool {
+	return ch == ' ' || ch == '\n' || ch == '\r' || ch == '\t' || ch == ']' || ch == ')' || ch == '[' || ch == '('
+}
+
+// readPDFString - Parantezli PDF metnini kaçış karakterleriyle birlikte okur, kapanış parantezinin konumunu döner
+func readPDFString(content []byte, start int) (string, int) {
+	var b strings.Builder
+	depth := 0
+
+	for i := start; i < len(content); i++ {
+		ch := content[i]
+		switch {
+		case ch == '\\' && i+1 < len(content):
+			i++
+			switch next := content[i]; next {
+			case 'n':
+				b.WriteByte('\n')
+			case 'r', 't', 'b', 'f':
+				b.WriteByte(' ')
+			case '(', ')', '\\':
+				b.WriteByte(next)
+			default:
+				// Sekizlik karakter kodu (\ddd)
+				if next >= '0' && next <= '7' {
+					code := 0
+					j := i
+					for ; j < len(content) && j < i+3 && content[j] >= '0' && content[j] <= '7'; j++ {
+						code = code*8 + int(content[j]-'0')
+					}
+					b.WriteRune(rune(code))
+					i = j - 1
+				}
+			}
+		case ch == '(':
+			if depth > 0 {
+				b.WriteByte(ch)
+			}
+			depth++
+		case ch == ')':
+			depth--
+			if depth == 0 {
+				return b.String(), i
+			}
+			b.WriteByte(ch)
+		default:
+			b.WriteRune(rune(ch)) // PDFDocEncoding, Latin-1 ile uyumlu kabul edilir
+		}
+	}
+
+	return b.String(), len(content)
+}
+
+// looksLikeText - Özel font kodlaması kullanan PDF'lerden çıkan anlamsız karakterleri eler
+func looksLikeText(text string) bool {
+	total, readable := 0, 0
+	for _, r := range text {
+		if unicode.IsSpace(r) {
+			continue
+		}
+		total++
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsPunct(r) {
+			readable++
+		}
+	}
+
+	return total > 20 && float64(readable)/float64(total) > 0.85
+}
@@ -0,0 +1,154 @@
+// screening/index.go
+package screening
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/configs"
+	AIRepository "github.com/okanay/backend-holding/repositories/ai"
+	FileRepository "github.com/okanay/backend-holding/repositories/file"
+	JobRepository "github.com/okanay/backend-holding/repositories/job"
+	R2Repository "github.com/okanay/backend-holding/repositories/r2"
+	"github.com/okanay/backend-holding/services/aiusage"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+	"github.com/sashabaranov/go-openai"
+)
+
+// Service başvuruları yapay zeka ile ilanın gereksinimlerine göre ön değerlendirmeden geçirir
+type Service struct {
+	ai    *AIRepository.Repository
+	usage *aiusage.Service
+	jobs  *JobRepository.Repository
+	files *FileRepository.Repository
+	r2    *R2Repository.Repository
+}
+
+// NewService yeni bir ön değerlendirme servisi oluşturur
+func NewService(ai *AIRepository.Repository, u *aiusage.Service, j *JobRepository.Repository, f *FileRepository.Repository, r2 *R2Repository.Repository) *Service {
+	return &Service{
+		ai:    ai,
+		usage: u,
+		jobs:  j,
+		files: f,
+		r2:    r2,
+	}
+}
+
+// ScreenApplication başvuruyu değerlendirir, sonucu başvuruya yazar ve çağrının maliyetini kaydeder
+func (s *Service) ScreenApplication(ctx context.Context, application types.JobApplication, userID uuid.UUID) (types.ApplicationScreeningResult, error) {
+	var result types.ApplicationScreeningResult
+
+	if err := s.usage.CheckLimit(ctx, userID); err != nil {
+		return result, err
+	}
+
+	job, err := s.jobs.GetJobByID(ctx, application.JobID)
+	if err != nil {
+		return result, err
+	}
+	if job.ID == uuid.Nil {
+		return result, fmt.Errorf("başvurunun ilanı bulunamadı")
+	}
+
+	cvText := s.collectCVText(ctx, application.FormJSON)
+
+	ctx, cancel := context.WithTimeout(ctx, configs.AI_SCREENING_TIMEOUT)
+	defer cancel()
+
+	response, err := s.ai.CreateChatCompletion(ctx, openai.ChatCompletionRequest{
+		Model: s.ai.Config().ScreeningModel,
+		Messages: []openai.ChatCompletionMessage{
+			{Role: openai.ChatMessageRoleSystem, Content: systemPrompt},
+			{Role: openai.ChatMessageRoleUser, Content: buildUserPrompt(job, application, cvText)},
+		},
+		MaxCompletionTokens: configs.AI_SCREENING_MAX_OUTPUT_TOKENS,
+		Temperature:         0.2,
+		ResponseFormat: &openai.ChatCompletionResponseFormat{
+			Type: openai.ChatCompletionResponseFormatTypeJSONSchema,
+			JSONSchema: &openai.ChatCompletionResponseFormatJSONSchema{
+				Name:   "application_screening",
+				Schema: &screeningSchema,
+				Strict: true,
+			},
+		},
+	})
+	if err != nil {
+		return result, fmt.Errorf("yapay zeka değerlendirmesi alınamadı: %w", err)
+	}
+
+	// Yanıt kullanılamasa bile harcanan token kaydedilir
+	result.Usage = s.usage.Record(ctx, types.AIFeatureApplicationScreening, response.Model, response.Usage, userID, application.ID)
+	totalCost, _ := result.Usage["totalCostUSD"].(float64)
+
+	if len(response.Choices) == 0 {
+		return result, fmt.Errorf("yapay zeka boş yanıt döndü")
+	}
+
+	var output modelOutput
+	if err := json.Unmarshal([]byte(response.Choices[0].Message.Content), &output); err != nil {
+		return result, fmt.Errorf("yapay zeka yanıtı okunamadı: %w", err)
+	}
+
+	result.Screening = types.ApplicationScreening{
+		FitScore:       min(max(output.FitScore, 0), 100),
+		Recommendation: output.Recommendation,
+		Summary:        strings.TrimSpace(output.Summary),
+		Strengths:      output.Strengths,
+		Concerns:       output.Concerns,
+		Model:          response.Model,
+		CostUSD:        totalCost,
+		CVIncluded:     cvText != "",
+		ScreenedBy:     userID,
+		ScreenedAt:     time.Now(),
+	}
+
+	if err := s.jobs.SaveApplicationScreening(ctx, application.ID, result.Screening); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// collectCVText - Başvuru formundaki eklerin metnini çıkarır ve sınırı aşan kısmı keser
+// Okunamayan ekler değerlendirmeyi durdurmaz, sadece loglanır.
+func (s *Service) collectCVText(ctx context.Context, formJSON string) string {
+	files, err := s.files.GetFilesByURLs(ctx, utils.CollectURLs(formJSON))
+	if err != nil {
+		log.Printf("[SCREENING] %v", err)
+		return ""
+	}
+
+	var b strings.Builder
+	for _, file := range files {
+		if b.Len() >= configs.AI_SCREENING_MAX_CV_CHARS {
+			break
+		}
+
+		data, err := s.r2.GetObject(ctx, s.r2.ObjectKeyFromURL(file.URL), configs.AI_SCREENING_MAX_FILE_SIZE)
+		if err != nil {
+			log.Printf("[SCREENING] %v", err)
+			continue
+		}
+
+		text := strings.TrimSpace(utils.NormalizeWhitespace(extractText(file.FileType, file.Filename, data)))
+		if text == "" {
+			continue
+		}
+
+		fmt.Fprintf(&b, "--- %s ---\n%s\n", file.Filename, text)
+	}
+
+	text := []rune(b.String())
+	if len(text) > configs.AI_SCREENING_MAX_CV_CHARS {
+		text = text[:configs.AI_SCREENING_MAX_CV_CHARS]
+	}
+
+	return string(text)
+}
@@ -0,0 +1,74 @@
+package screening
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+	"github.com/sashabaranov/go-openai/jsonschema"
+)
+
+const systemPrompt = `Sen bir işe alım uzmanına yardımcı olan ön değerlendirme asistanısın.
+Adayın başvuru formunu ve özgeçmişini ilanın açıklaması ve gereksinimleriyle karşılaştır.
+Yalnızca verilen bilgilere dayan, bilgi yoksa varsayımda bulunma ve eksik bilgiyi endişe olarak belirt.
+Yaş, cinsiyet, medeni durum, etnik köken, din, engellilik gibi korunan özellikleri değerlendirmeye katma.
+Özet, güçlü yönler ve endişeler Türkçe ve kısa maddeler halinde olmalı.
+Uygunluk puanı 0 (hiç uygun değil) ile 100 (tamamen uygun) arasında bir tam sayıdır.`
+
+// screeningSchema - Modelin döndürmesi gereken yapı (strict JSON schema)
+var screeningSchema = jsonschema.Definition{
+	Type: jsonschema.Object,
+	Properties: map[string]jsonschema.Definition{
+		"fitScore":       {Type: jsonschema.Integer, Description: "0-100 arası uygunluk puanı"},
+		"recommendation": {Type: jsonschema.String, Enum: []string{"strong_yes", "yes", "maybe", "no"}},
+		"summary":        {Type: jsonschema.String, Description: "En fazla 3 cümlelik değerlendirme özeti"},
+		"strengths":      {Type: jsonschema.Array, Items: &jsonschema.Definition{Type: jsonschema.String}},
+		"concerns":       {Type: jsonschema.Array, Items: &jsonschema.Definition{Type: jsonschema.String}},
+	},
+	Required:             []string{"fitScore", "recommendation", "summary", "strengths", "concerns"},
+	AdditionalProperties: false,
+}
+
+// modelOutput - Model yanıtının ayrıştırıldığı yapı
+type modelOutput struct {
+	FitScore       int      `json:"fitScore"`
+	Recommendation string   `json:"recommendation"`
+	Summary        string   `json:"summary"`
+	Strengths      []string `json:"strengths"`
+	Concerns       []string `json:"concerns"`
+}
+
+// buildUserPrompt - İlan, başvuru formu ve özgeçmiş metnini tek mesajda birleştirir
+// Aday kimlik bilgileri (ad, e-posta, telefon) modele gönderilmez.
+func buildUserPrompt(job types.JobView, application types.JobApplication, cvText string) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "## İLAN\nPozisyon: %s\n", job.Details.Title)
+	if job.Details.ExperienceLevel != "" {
+		fmt.Fprintf(&b, "Deneyim seviyesi: %s\n", job.Details.ExperienceLevel)
+	}
+	if job.Details.EmploymentType != "" {
+		fmt.Fprintf(&b, "Çalışma tipi: %s\n", job.Details.EmploymentType)
+	}
+	if job.Details.WorkMode != "" {
+		fmt.Fprintf(&b, "Çalışma şekli: %s\n", job.Details.WorkMode)
+	}
+	if job.Details.Location != "" {
+		fmt.Fprintf(&b, "Lokasyon: %s\n", job.Details.Location)
+	}
+	if job.Details.Description != "" {
+		fmt.Fprintf(&b, "Kısa açıklama: %s\n", job.Details.Description)
+	}
+	fmt.Fprintf(&b, "\nAçıklama ve gereksinimler:\n%s\n", utils.StripHTML(job.Details.HTML))
+
+	fmt.Fprintf(&b, "\n## BAŞVURU FORMU (%s)\n%s\n", application.FormType, application.FormJSON)
+
+	if cvText != "" {
+		fmt.Fprintf(&b, "\n## ÖZGEÇMİŞ METNİ\n%s\n", cvText)
+	} else {
+		b.WriteString("\n## ÖZGEÇMİŞ METNİ\nEk dosyalardan metin çıkarılamadı, sadece başvuru formunu değerlendir.\n")
+	}
+
+	return b.String()
+}
@@ -0,0 +1,194 @@
+package tiptap
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Blok değişiklik türleri
+const (
+	BlockAdded   = "added"
+	BlockRemoved = "removed"
+	BlockChanged = "changed" // Aynı konum yolundaki blok içeriği, biçimi veya nitelikleri değişti
+)
+
+// diffMaxCells - LCS tablosunun üst sınırı; aşılırsa belgeler tamamen değişmiş sayılır
+const diffMaxCells = 4_000_000
+
+// BlockChange - İki belge arasında değişen blok
+// Index değerleri düzleştirilmiş blok listesindeki sıradır (eklenende FromIndex, silinende ToIndex -1).
+type BlockChange struct {
+	Op        string `json:"op"`
+	Type      string `json:"type"`
+	Path      string `json:"path"` // Örn. "bulletList > listItem > paragraph"
+	FromIndex int    `json:"fromIndex"`
+	ToIndex   int    `json:"toIndex"`
+	Before    string `json:"before,omitempty"`
+	After     string `json:"after,omitempty"`
+}
+
+// block - Karşılaştırılan en küçük birim: metin bloğu (paragraf, başlık) veya içeriksiz düğüm (görsel, çizgi)
+type block struct {
+	node Node
+	path string
+	key  string // Biçim ve nitelikler dahil kanonik JSON
+	text string
+}
+
+// DiffBlocks iki belgeyi blok düzeyinde karşılaştırır ve değişen blokları döner
+// Yapı, biçim veya bağlantı değişiklikleri de blok değişikliği olarak görünür.
+func DiffBlocks(from, to Node) []BlockChange {
+	a, b := flatten(from), flatten(to)
+	changes := []BlockChange{}
+
+	if len(a)*len(b) > diffMaxCells {
+		for i, item := range a {
+			changes = append(changes, removed(item, i))
+		}
+		for j, item := range b {
+			changes = append(changes, added(item, j))
+		}
+		return changes
+	}
+
+	// En uzun ortak alt dizi (LCS) tablosu, sondan başa
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i].key == b[j].key {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var pendingRemoved []int
+	var pendingAdded []int
+
+	// Ardışık silinen ve eklenen blokları eşleştirir; aynı konum yolundakiler (örn. iki paragraf) "changed" olur
+	flush := func() {
+		paired := make(map[int]int, len(pendingRemoved)) // eklenen -> silinen
+		used := make([]bool, len(pendingAdded))
+		for _, i := range pendingRemoved {
+			for k, j := range pendingAdded {
+				if !used[k] && a[i].path == b[j].path {
+					used[k] = true
+					paired[j] = i
+					break
+				}
+			}
+		}
+
+		matched := make(map[int]bool, len(paired))
+		for _, i := range paired {
+			matched[i] = true
+		}
+		for _, i := range pendingRemoved {
+			if !matched[i] {
+				changes = append(changes, removed(a[i], i))
+			}
+		}
+		for _, j := range pendingAdded {
+			if i, ok := paired[j]; ok {
+				changes = append(changes, BlockChange{
+					Op: BlockChanged, Type: b[j].node.Type, Path: b[j].path,
+					FromIndex: i, ToIndex: j, Before: a[i].text, After: b[j].text,
+				})
+			} else {
+				changes = append(changes, added(b[j], j))
+			}
+		}
+		pendingRemoved, pendingAdded = nil, nil
+	}
+
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i].key == b[j].key:
+			flush()
+			i++
+			j++
+		case j < len(b) && (i == len(a) || lcs[i][j+1] >= lcs[i+1][j]):
+			pendingAdded = append(pendingAdded, j)
+			j++
+		default:
+			pendingRemoved = append(pendingRemoved, i)
+			i++
+		}
+	}
+	flush()
+
+	return changes
+}
+
+func removed(item block, index int) BlockChange {
+	return BlockChange{Op: BlockRemoved, Type: item.node.Type, Path: item.path, FromIndex: index, ToIndex: -1, Before: item.text}
+}
+
+func added(item block, index int) BlockChange {
+	return BlockChange{Op: BlockAdded, Type: item.node.Type, Path: item.path, FromIndex: -1, ToIndex: index, After: item.text}
+}
+
+// flatten - Belgeyi karşılaştırılabilir bloklara ayırır
+func flatten(doc Node) []block {
+	var blocks []block
+
+	var walk func(node Node, path []string)
+	walk = func(node Node, path []string) {
+		path = append(path, node.Type)
+
+		if len(node.Content) == 0 || isTextBlock(node) {
+			key, _ := json.Marshal(node)
+			blocks = append(blocks, block{
+				node: node,
+				path: strings.Join(path[1:], " > "), // "doc" yazılmaz
+				key:  string(key),
+				text: plainText(node),
+			})
+			return
+		}
+
+		for _, child := range node.Content {
+			walk(child, path)
+		}
+	}
+
+	for _, child := range doc.Content {
+		walk(child, []string{doc.Type})
+	}
+
+	return blocks
+}
+
+// isTextBlock - Doğrudan satır içi düğüm (metin, satır sonu) içeren blok
+func isTextBlock(node Node) bool {
+	for _, child := range node.Content {
+		if child.Type == "text" || child.Type == "hardBreak" {
+			return true
+		}
+	}
+	return false
+}
+
+// plainText - Bloğun düz metni; içeriksiz düğümlerde görsel adresi gibi ayırt edici nitelik kullanılır
+func plainText(node Node) string {
+	if node.Type == "text" {
+		return node.Text
+	}
+	if node.Type == "hardBreak" {
+		return "\n"
+	}
+	if len(node.Content) == 0 {
+		return attrString(node.Attrs, "src")
+	}
+
+	var b strings.Builder
+	for _, child := range node.Content {
+		b.WriteString(plainText(child))
+	}
+	return b.String()
+}
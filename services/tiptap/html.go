@@ -0,0 +1,166 @@
+package tiptap
+
+import (
+	"fmt"
+	"html"
+	"strings"
+)
+
+// HTML düğümü Tiptap'in ürettiği HTML ile aynı yapıda çıktıya çevirir
+// Bilinmeyen düğümlerin sadece içeriği yazılır, böylece özel eklentiler metni kaybettirmez.
+func (n Node) HTML() string {
+	var b strings.Builder
+	writeHTML(&b, n)
+	return b.String()
+}
+
+func writeHTML(b *strings.Builder, n Node) {
+	switch n.Type {
+	case "doc":
+		writeChildren(b, n)
+	case "text":
+		b.WriteString(markText(n))
+	case "heading":
+		level := attrInt(n.Attrs, "level")
+		if level < 1 || level > 6 {
+			level = 2
+		}
+		writeElement(b, fmt.Sprintf("h%d", level), alignStyle(n.Attrs), n)
+	case "paragraph":
+		writeElement(b, "p", alignStyle(n.Attrs), n)
+	case "bulletList":
+		writeElement(b, "ul", "", n)
+	case "orderedList":
+		attrs := ""
+		if start := attrInt(n.Attrs, "start"); start > 1 {
+			attrs = fmt.Sprintf(` start="%d"`, start)
+		}
+		writeElement(b, "ol", attrs, n)
+	case "listItem":
+		writeElement(b, "li", "", n)
+	case "blockquote":
+		writeElement(b, "blockquote", "", n)
+	case "codeBlock":
+		attrs := ""
+		if language := attrString(n.Attrs, "language"); language != "" {
+			attrs = ` class="language-` + html.EscapeString(language) + `"`
+		}
+		b.WriteString("<pre><code" + attrs + ">")
+		for _, child := range n.Content {
+			b.WriteString(html.EscapeString(child.Text))
+		}
+		b.WriteString("</code></pre>")
+	case "horizontalRule":
+		b.WriteString("<hr>")
+	case "hardBreak":
+		b.WriteString("<br>")
+	case "image":
+		b.WriteString(`<img src="` + html.EscapeString(attrString(n.Attrs, "src")) + `"`)
+		for _, key := range []string{"alt", "title"} {
+			if value := attrString(n.Attrs, key); value != "" {
+				b.WriteString(" " + key + `="` + html.EscapeString(value) + `"`)
+			}
+		}
+		b.WriteString(">")
+	case "table":
+		b.WriteString("<table><tbody>")
+		writeChildren(b, n)
+		b.WriteString("</tbody></table>")
+	case "tableRow":
+		writeElement(b, "tr", "", n)
+	case "tableHeader":
+		writeElement(b, "th", cellSpan(n.Attrs), n)
+	case "tableCell":
+		writeElement(b, "td", cellSpan(n.Attrs), n)
+	default:
+		writeChildren(b, n)
+	}
+}
+
+func writeElement(b *strings.Builder, tag, attrs string, n Node) {
+	b.WriteString("<" + tag + attrs + ">")
+	writeChildren(b, n)
+	b.WriteString("</" + tag + ">")
+}
+
+func writeChildren(b *strings.Builder, n Node) {
+	for _, child := range n.Content {
+		writeHTML(b, child)
+	}
+}
+
+// markText - Metni biçimleriyle sarar; ilk biçim en içte kalır (Tiptap ile aynı sıra)
+func markText(n Node) string {
+	text := html.EscapeString(n.Text)
+
+	for _, mark := range n.Marks {
+		switch mark.Type {
+		case "bold":
+			text = "<strong>" + text + "</strong>"
+		case "italic":
+			text = "<em>" + text + "</em>"
+		case "underline":
+			text = "<u>" + text + "</u>"
+		case "strike":
+			text = "<s>" + text + "</s>"
+		case "code":
+			text = "<code>" + text + "</code>"
+		case "highlight":
+			text = "<mark>" + text + "</mark>"
+		case "subscript":
+			text = "<sub>" + text + "</sub>"
+		case "superscript":
+			text = "<sup>" + text + "</sup>"
+		case "link":
+			attrs := ` href="` + html.EscapeString(attrString(mark.Attrs, "href")) + `"`
+			if target := attrString(mark.Attrs, "target"); target != "" {
+				attrs += ` target="` + html.EscapeString(target) + `"`
+			}
+			if rel := attrString(mark.Attrs, "rel"); rel != "" {
+				attrs += ` rel="` + html.EscapeString(rel) + `"`
+			}
+			text = "<a" + attrs + ">" + text + "</a>"
+		}
+	}
+
+	return text
+}
+
+// alignStyle - textAlign eklentisinin hizalama stili (varsayılan sola hizalama yazılmaz)
+func alignStyle(attrs map[string]any) string {
+	align := attrString(attrs, "textAlign")
+	switch align {
+	case "center", "right", "justify":
+		return ` style="text-align: ` + align + `"`
+	default:
+		return ""
+	}
+}
+
+func cellSpan(attrs map[string]any) string {
+	var span string
+	if colspan := attrInt(attrs, "colspan"); colspan > 1 {
+		span += fmt.Sprintf(` colspan="%d"`, colspan)
+	}
+	if rowspan := attrInt(attrs, "rowspan"); rowspan > 1 {
+		span += fmt.Sprintf(` rowspan="%d"`, rowspan)
+	}
+	return span
+}
+
+func attrString(attrs map[string]any, key string) string {
+	value, _ := attrs[key].(string)
+	return value
+}
+
+// attrInt - JSON'dan okunan sayılar float64, kodda oluşturulanlar int olarak gelir
+func attrInt(attrs map[string]any, key string) int {
+	switch value := attrs[key].(type) {
+	case int:
+		return value
+	case float64:
+		return int(value)
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,52 @@
+// tiptap/index.go
+package tiptap
+
+import (
+	"encoding/json"
+)
+
+// Node - Tiptap (ProseMirror) JSON düğümü, editöre doğrudan eklenebilir
+type Node struct {
+	Type    string         `json:"type"`
+	Attrs   map[string]any `json:"attrs,omitempty"`
+	Content []Node         `json:"content,omitempty"`
+	Text    string         `json:"text,omitempty"`
+	Marks   []Mark         `json:"marks,omitempty"`
+}
+
+// Mark - Metin biçimi (bold, italic, link...)
+type Mark struct {
+	Type  string         `json:"type"`
+	Attrs map[string]any `json:"attrs,omitempty"`
+}
+
+// Parse editörün kaydettiği JSON'u düğüme çevirir
+// contents.content_json eski kayıtlarda JSON metni olarak (iki kez kodlanmış) saklanmış olabilir, bu durum da desteklenir.
+func Parse(data []byte) (Node, error) {
+	var doc Node
+
+	var encoded string
+	if err := json.Unmarshal(data, &encoded); err == nil {
+		data = []byte(encoded)
+	}
+
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return doc, err
+	}
+
+	return doc, nil
+}
+
+// Doc - Verilen blokları kök düğüme sarar
+func Doc(blocks ...Node) Node {
+	return Node{Type: "doc", Content: blocks}
+}
+
+// JSON düğümü editörün beklediği JSON metnine çevirir (job_posting_details.json ve contents.json ile aynı biçim)
+func (n Node) JSON() string {
+	data, err := json.Marshal(n)
+	if err != nil {
+		return "{}"
+	}
+	return string(data)
+}
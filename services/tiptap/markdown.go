@@ -0,0 +1,123 @@
+package tiptap
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	headingPattern        = regexp.MustCompile(`^(#{1,6})\s+(.*)$`)
+	bulletPattern         = regexp.MustCompile(`^\s*[-*+]\s+(.*)$`)
+	orderedPattern        = regexp.MustCompile(`^\s*(\d+)[.)]\s+(.*)$`)
+	inlineMarkPattern     = regexp.MustCompile(`\*\*(.+?)\*\*|\*([^*\s][^*]*?)\*`) // Alt çizgili biçimler snake_case kelimeleri bozmasın diye desteklenmez
+	horizontalRulePattern = regexp.MustCompile(`^\s*([-*_])(\s*([-*_])){2,}\s*$`)
+)
+
+// FromMarkdown modelin ürettiği Markdown metnini Tiptap belgesine çevirir
+// Desteklenen yapılar: başlıklar, paragraflar, madde işaretli ve numaralı listeler, kalın ve italik metin.
+// Editördeki başlık seviyeleri 2'den başladığı için tek # da h2 olarak eklenir.
+func FromMarkdown(markdown string) Node {
+	lines := strings.Split(strings.ReplaceAll(markdown, "\r\n", "\n"), "\n")
+
+	var blocks []Node
+	var paragraph []string
+	var list *Node
+
+	flushParagraph := func() {
+		if len(paragraph) > 0 {
+			blocks = append(blocks, Node{Type: "paragraph", Content: Inline(strings.Join(paragraph, " "))})
+			paragraph = nil
+		}
+	}
+	flushList := func() {
+		if list != nil {
+			blocks = append(blocks, *list)
+			list = nil
+		}
+	}
+
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case trimmed == "" || horizontalRulePattern.MatchString(trimmed) || strings.HasPrefix(trimmed, "```"):
+			flushParagraph()
+			flushList()
+
+		case headingPattern.MatchString(trimmed):
+			flushParagraph()
+			flushList()
+			match := headingPattern.FindStringSubmatch(trimmed)
+			level := max(len(match[1]), 2)
+			blocks = append(blocks, Node{
+				Type:    "heading",
+				Attrs:   map[string]any{"level": min(level, 4)},
+				Content: Inline(strings.TrimSpace(match[2])),
+			})
+
+		case bulletPattern.MatchString(line):
+			flushParagraph()
+			if list == nil || list.Type != "bulletList" {
+				flushList()
+				list = &Node{Type: "bulletList"}
+			}
+			text := bulletPattern.FindStringSubmatch(line)[1]
+			list.Content = append(list.Content, listItem(text))
+
+		case orderedPattern.MatchString(line):
+			flushParagraph()
+			match := orderedPattern.FindStringSubmatch(line)
+			if list == nil || list.Type != "orderedList" {
+				flushList()
+				start, _ := strconv.Atoi(match[1])
+				list = &Node{Type: "orderedList", Attrs: map[string]any{"start": max(start, 1)}}
+			}
+			list.Content = append(list.Content, listItem(match[2]))
+
+		default:
+			// Listeden sonra boş satır olmadan gelen metin yeni paragraf başlatır
+			flushList()
+			paragraph = append(paragraph, trimmed)
+		}
+	}
+
+	flushParagraph()
+	flushList()
+
+	return Doc(blocks...)
+}
+
+// listItem - Liste elemanı tek paragraf içerir (Tiptap şeması)
+func listItem(text string) Node {
+	return Node{
+		Type:    "listItem",
+		Content: []Node{{Type: "paragraph", Content: Inline(strings.TrimSpace(text))}},
+	}
+}
+
+// Inline satır içi Markdown biçimlerini (kalın, italik) metin düğümlerine çevirir
+func Inline(text string) []Node {
+	var nodes []Node
+	last := 0
+
+	for _, match := range inlineMarkPattern.FindAllStringSubmatchIndex(text, -1) {
+		if match[0] > last {
+			nodes = append(nodes, Node{Type: "text", Text: text[last:match[0]]})
+		}
+
+		if match[2] >= 0 {
+			nodes = append(nodes, Node{Type: "text", Text: text[match[2]:match[3]], Marks: []Mark{{Type: "bold"}}})
+		} else {
+			nodes = append(nodes, Node{Type: "text", Text: text[match[4]:match[5]], Marks: []Mark{{Type: "italic"}}})
+		}
+
+		last = match[1]
+	}
+
+	if last < len(text) {
+		nodes = append(nodes, Node{Type: "text", Text: text[last:]})
+	}
+
+	return nodes
+}
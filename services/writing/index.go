@@ -0,0 +1,178 @@
+// writing/index.go
+package writing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/configs"
+	AIRepository "github.com/okanay/backend-holding/repositories/ai"
+	"github.com/okanay/backend-holding/services/aiusage"
+	"github.com/okanay/backend-holding/services/tiptap"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+	"github.com/sashabaranov/go-openai"
+)
+
+// Service editörler için iş ilanı ve içerik yazım asistanı
+// Tüm çağrılar akış (stream) olarak yapılır; metin parçaları onDelta ile iletilir, sonuç Tiptap belgesine çevrilir.
+type Service struct {
+	ai    *AIRepository.Repository
+	usage *aiusage.Service
+}
+
+// NewService yeni bir yazım asistanı servisi oluşturur
+func NewService(ai *AIRepository.Repository, u *aiusage.Service) *Service {
+	return &Service{
+		ai:    ai,
+		usage: u,
+	}
+}
+
+// DraftJobDescription yapılandırılmış alanlardan iş ilanı açıklaması taslağı üretir
+func (s *Service) DraftJobDescription(ctx context.Context, input types.AIJobDraftInput, userID uuid.UUID, onDelta func(string)) (types.AIWritingResult, error) {
+	system, user := jobDraftMessages(input)
+	return s.writeDocument(ctx, types.AIFeatureJobDraft, system, user, configs.AI_WRITING_MAX_OUTPUT_TOKENS, userID, onDelta)
+}
+
+// RewriteSelection editörde seçili metni yeniden yazar veya kısaltır
+func (s *Service) RewriteSelection(ctx context.Context, input types.AIRewriteInput, userID uuid.UUID, onDelta func(string)) (types.AIWritingResult, error) {
+	system, user := rewriteMessages(input)
+	return s.writeDocument(ctx, types.AIFeatureRewrite, system, user, configs.AI_WRITING_MAX_OUTPUT_TOKENS, userID, onDelta)
+}
+
+// SuggestSEO içerik için başlık, açıklama ve slug önerileri üretir
+func (s *Service) SuggestSEO(ctx context.Context, input types.AISEOInput, userID uuid.UUID, onDelta func(string)) (types.AISEOSuggestions, error) {
+	var suggestions types.AISEOSuggestions
+
+	// İçerik HTML olarak gelebilir, modele düz metin ve sınırlı uzunlukta gönderilir
+	content := []rune(utils.StripHTML(input.Content))
+	if len(content) > configs.AI_WRITING_MAX_INPUT_CHARS {
+		content = content[:configs.AI_WRITING_MAX_INPUT_CHARS]
+	}
+
+	system, user := seoMessages(input, string(content))
+	request := chatRequest(s.ai.Config().WritingModel, system, user, configs.AI_SEO_MAX_OUTPUT_TOKENS)
+	request.ResponseFormat = &openai.ChatCompletionResponseFormat{
+		Type: openai.ChatCompletionResponseFormatTypeJSONSchema,
+		JSONSchema: &openai.ChatCompletionResponseFormatJSONSchema{
+			Name:   "seo_suggestions",
+			Schema: &seoSchema,
+			Strict: true,
+		},
+	}
+
+	output, usage, err := s.stream(ctx, types.AIFeatureSEO, request, userID, onDelta)
+	suggestions.Usage = usage
+	if err != nil {
+		return suggestions, err
+	}
+
+	if err := json.Unmarshal([]byte(output), &suggestions); err != nil {
+		return suggestions, fmt.Errorf("yapay zeka yanıtı okunamadı: %w", err)
+	}
+
+	// Model kurala uymasa da slug'lar URL'de kullanılabilir olmalı
+	slugs := make([]string, 0, len(suggestions.Slugs))
+	for _, slug := range suggestions.Slugs {
+		if slug = utils.Slugify(slug); slug != "" {
+			slugs = append(slugs, slug)
+		}
+	}
+	suggestions.Slugs = slugs
+
+	return suggestions, nil
+}
+
+// writeDocument - Markdown çıktısı üreten çağrıyı yapar ve sonucu Tiptap belgesine çevirir
+func (s *Service) writeDocument(ctx context.Context, feature types.AIUsageFeature, system, user string, maxTokens int, userID uuid.UUID, onDelta func(string)) (types.AIWritingResult, error) {
+	var result types.AIWritingResult
+
+	output, usage, err := s.stream(ctx, feature, chatRequest(s.ai.Config().WritingModel, system, user, maxTokens), userID, onDelta)
+	result.Usage = usage
+	if err != nil {
+		return result, err
+	}
+
+	doc := tiptap.FromMarkdown(output)
+	if len(doc.Content) == 0 {
+		return result, fmt.Errorf("yapay zeka boş yanıt döndü")
+	}
+
+	result.Doc = doc
+	result.HTML = doc.HTML()
+
+	return result, nil
+}
+
+// chatRequest - Akış isteğini hazırlar, token kullanımı son parçada döner
+func chatRequest(model, system, user string, maxTokens int) openai.ChatCompletionRequest {
+	return openai.ChatCompletionRequest{
+		Model: model,
+		Messages: []openai.ChatCompletionMessage{
+			{Role: openai.ChatMessageRoleSystem, Content: system},
+			{Role: openai.ChatMessageRoleUser, Content: user},
+		},
+		MaxCompletionTokens: maxTokens,
+		Temperature:         0.7,
+		Stream:              true,
+		StreamOptions:       &openai.StreamOptions{IncludeUsage: true},
+	}
+}
+
+// stream - Akışı okur, parçaları iletir ve tamamlanan metni döner; kullanım ve maliyet kaydedilir
+func (s *Service) stream(ctx context.Context, feature types.AIUsageFeature, request openai.ChatCompletionRequest, userID uuid.UUID, onDelta func(string)) (string, map[string]any, error) {
+	if err := s.usage.CheckLimit(ctx, userID); err != nil {
+		return "", nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, configs.AI_WRITING_TIMEOUT)
+	defer cancel()
+
+	stream, err := s.ai.CreateChatCompletionStream(ctx, request)
+	if err != nil {
+		return "", nil, fmt.Errorf("yapay zeka yanıtı başlatılamadı: %w", err)
+	}
+	defer stream.Close()
+
+	var output strings.Builder
+	var usage *openai.Usage
+	model := request.Model
+
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return output.String(), nil, fmt.Errorf("yapay zeka yanıtı okunamadı: %w", err)
+		}
+
+		if chunk.Model != "" {
+			model = chunk.Model
+		}
+		if chunk.Usage != nil {
+			usage = chunk.Usage
+		}
+
+		if len(chunk.Choices) > 0 && chunk.Choices[0].Delta.Content != "" {
+			delta := chunk.Choices[0].Delta.Content
+			output.WriteString(delta)
+			if onDelta != nil {
+				onDelta(delta)
+			}
+		}
+	}
+
+	var cost map[string]any
+	if usage != nil {
+		cost = s.usage.Record(ctx, feature, model, *usage, userID, uuid.Nil)
+	}
+
+	return output.String(), cost, nil
+}
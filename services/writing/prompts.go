@@ -0,0 +1,117 @@
+package writing
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/okanay/backend-holding/types"
+	"github.com/sashabaranov/go-openai/jsonschema"
+)
+
+// markdownRules - Çıktının Tiptap'e çevrilebilmesi için Markdown alt kümesi
+const markdownRules = `Çıktıyı sadece şu Markdown yapılarıyla yaz: ## ve ### başlıklar, paragraflar, "- " ile madde işaretli liste, "1. " ile numaralı liste, **kalın** ve *italik*.
+Tablo, kod bloğu, bağlantı, görsel veya HTML kullanma. Açıklama ya da giriş cümlesi ekleme, sadece metni döndür.`
+
+// languageName - Dil kodunun modele verilecek adı
+func languageName(code string) string {
+	switch strings.ToLower(code) {
+	case "", "tr":
+		return "Türkçe"
+	case "en":
+		return "English"
+	case "de":
+		return "Deutsch"
+	case "ru":
+		return "Русский"
+	case "ar":
+		return "العربية"
+	default:
+		return code
+	}
+}
+
+func jobDraftMessages(input types.AIJobDraftInput) (string, string) {
+	system := fmt.Sprintf(`Sen kurumsal bir holdingin insan kaynakları ekibi için iş ilanı metinleri yazan bir editörsün.
+Kapsayıcı, ayrımcılık içermeyen ve net bir dil kullan; yaş, cinsiyet gibi kriterler ekleme.
+Metni %s yaz.
+%s`, languageName(input.Language), markdownRules)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Aşağıdaki bilgilerle bir iş ilanı açıklaması yaz. Şu bölümleri içersin: pozisyon hakkında kısa giriş, sorumluluklar, aranan nitelikler, tercih sebepleri ve çalışma koşulları.\n\nPozisyon: %s\n", input.Title)
+	if input.Location != "" {
+		fmt.Fprintf(&b, "Lokasyon: %s\n", input.Location)
+	}
+	if input.WorkMode != "" {
+		fmt.Fprintf(&b, "Çalışma şekli: %s\n", input.WorkMode)
+	}
+	if input.EmploymentType != "" {
+		fmt.Fprintf(&b, "Çalışma tipi: %s\n", input.EmploymentType)
+	}
+	if input.ExperienceLevel != "" {
+		fmt.Fprintf(&b, "Deneyim seviyesi: %s\n", input.ExperienceLevel)
+	}
+	if input.Notes != "" {
+		fmt.Fprintf(&b, "\nEditörün notları (bunlara sadık kal, bilgi uydurma):\n%s\n", input.Notes)
+	}
+
+	return system, b.String()
+}
+
+func rewriteMessages(input types.AIRewriteInput) (string, string) {
+	instruction := "Metni anlamını ve içerdiği bilgileri koruyarak daha akıcı ve anlaşılır biçimde yeniden yaz."
+	if input.Mode == types.AIRewriteModeShorten {
+		instruction = "Metni önemli bilgileri koruyarak yaklaşık yarı uzunluğa kısalt."
+	}
+	if input.Tone != "" {
+		instruction += fmt.Sprintf(" Üslup: %s.", input.Tone)
+	}
+
+	language := "Metnin dilini değiştirme."
+	if input.Language != "" {
+		language = fmt.Sprintf("Metni %s yaz.", languageName(input.Language))
+	}
+
+	system := fmt.Sprintf("Sen bir metin editörüsün. %s\n%s\n%s", instruction, language, markdownRules)
+
+	return system, input.Text
+}
+
+func seoMessages(input types.AISEOInput, content string) (string, string) {
+	system := fmt.Sprintf(`Sen bir SEO editörüsün. Verilen içerik için %s dilinde öneriler üret:
+- 3 başlık önerisi (en fazla 60 karakter)
+- 3 meta açıklama önerisi (120-155 karakter, içeriği özetleyen ve tıklamaya teşvik eden)
+- 3 slug önerisi (küçük harf, Türkçe karakter içermeyen, kelimeler tire ile ayrılmış, en fazla 6 kelime)`, languageName(input.Language))
+
+	return system, fmt.Sprintf("Başlık: %s\n\nİçerik:\n%s", input.Title, content)
+}
+
+// seoSchema - SEO önerilerinin yapısı (strict JSON schema)
+var seoSchema = jsonschema.Definition{
+	Type: jsonschema.Object,
+	Properties: map[string]jsonschema.Definition{
+		"titles":       {Type: jsonschema.Array, Items: &jsonschema.Definition{Type: jsonschema.String}},
+		"descriptions": {Type: jsonschema.Array, Items: &jsonschema.Definition{Type: jsonschema.String}},
+		"slugs":        {Type: jsonschema.Array, Items: &jsonschema.Definition{Type: jsonschema.String}},
+	},
+	Required:             []string{"titles", "descriptions", "slugs"},
+	AdditionalProperties: false,
+}
+
+func translationMessages(sourceLanguage, targetLanguage string) string {
+	return fmt.Sprintf(`Sen kurumsal bir holdingin web sitesi için çeviri yapan profesyonel bir çevirmensin.
+"units" dizisindeki her metni %s dilinden %s diline çevir ve aynı sırayla, aynı sayıda eleman olarak döndür.
+Metinlerdeki <0>...</0> biçimindeki etiketler biçimlendirme ve bağlantıları işaretler: her etiketi aynen koru, sadece içindeki metni çevir.
+Etiketlerin sırası hedef dilin cümle yapısına göre değişebilir. <3/> gibi tekil etiketleri olduğu gibi bırak.
+Metindeki &lt; ve &amp; karakter kodlarını aynen koru.
+Özel isimleri, marka adlarını, e-posta ve web adreslerini çevirme. Açıklama ekleme.`, languageName(sourceLanguage), languageName(targetLanguage))
+}
+
+// translationSchema - Çeviri yanıtının yapısı (strict JSON schema)
+var translationSchema = jsonschema.Definition{
+	Type: jsonschema.Object,
+	Properties: map[string]jsonschema.Definition{
+		"units": {Type: jsonschema.Array, Items: &jsonschema.Definition{Type: jsonschema.String}},
+	},
+	Required:             []string{"units"},
+	AdditionalProperties: false,
+}
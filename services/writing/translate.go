@@ -0,0 +1,301 @@
+package writing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-holding/configs"
+	"github.com/okanay/backend-holding/services/tiptap"
+	"github.com/okanay/backend-holding/types"
+	"github.com/okanay/backend-holding/utils"
+	"github.com/sashabaranov/go-openai"
+)
+
+// inlineTagPattern - Blok içindeki satır içi düğümleri işaretleyen etiketler: <0>metin</0> veya <3/>
+var inlineTagPattern = regexp.MustCompile(`<(\d+)(/?)>|</(\d+)>`)
+
+// textEscaper - Metin düğümlerindeki "<" karakteri etiketlerle karışmasın diye kodlanır ("&" geri çözülebilsin diye kodlanır)
+var textEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;")
+
+// textUnescaper - textEscaper ile kodlanan karakterleri geri çevirir
+var textUnescaper = strings.NewReplacer("&lt;", "<", "&amp;", "&")
+
+// translationUnit - Modele tek eleman olarak gönderilen metin (başlık, açıklama veya bir metin bloğu)
+type translationUnit struct {
+	source string
+	block  *tiptap.Node // nil ise düz metin
+	result string
+}
+
+// TranslateContent içeriğin başlığını, açıklamasını ve Tiptap belgesindeki metinleri hedef dile çevirir
+// Belgenin düğüm yapısı, biçimler ve bağlantılar korunur; sadece metin düğümleri değişir.
+// Uzun içerikler AI_TRANSLATION_BATCH_CHARS boyutunda parçalar halinde çevrilir, her parça kullanım sınırına tabidir.
+func (s *Service) TranslateContent(ctx context.Context, content types.Content, language string, userID uuid.UUID) (types.AIContentTranslation, error) {
+	var result types.AIContentTranslation
+
+	doc, err := tiptap.Parse([]byte(content.ContentJSON))
+	if err != nil {
+		return result, fmt.Errorf("içerik belgesi okunamadı: %w", err)
+	}
+
+	units := []*translationUnit{{source: content.Title}}
+	description := &translationUnit{}
+	if content.Description != nil && strings.TrimSpace(*content.Description) != "" {
+		description.source = *content.Description
+		units = append(units, description)
+	}
+
+	var blocks []*tiptap.Node
+	collectTextBlocks(&doc, &blocks)
+	for _, block := range blocks {
+		units = append(units, &translationUnit{source: encodeBlock(*block), block: block})
+	}
+
+	total := 0
+	for _, unit := range units {
+		total += len(unit.source)
+	}
+	if total > configs.AI_TRANSLATION_MAX_CHARS {
+		return result, fmt.Errorf("içerik çeviri için çok uzun (%d karakter)", total)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, configs.AI_TRANSLATION_TIMEOUT)
+	defer cancel()
+
+	model := s.ai.Config().TranslationModel
+
+	var usage openai.Usage
+	for _, batch := range batchUnits(units, configs.AI_TRANSLATION_BATCH_CHARS) {
+		batchUsage, err := s.translateBatch(ctx, model, batch, content.Language, language, userID, content.ID)
+		usage.PromptTokens += batchUsage.PromptTokens
+		usage.CompletionTokens += batchUsage.CompletionTokens
+		result.Usage = utils.CalculateAICostWithOutput(model, s.ai.Price(model), usage.PromptTokens, usage.CompletionTokens)
+		if err != nil {
+			return result, err
+		}
+	}
+
+	for _, unit := range units {
+		if unit.block == nil {
+			continue
+		}
+		if err := decodeBlock(unit.block, unit.result); err != nil {
+			return result, fmt.Errorf("çeviride belge yapısı korunamadı: %w", err)
+		}
+	}
+
+	result.Title = strings.TrimSpace(units[0].result)
+	result.Description = strings.TrimSpace(description.result)
+	result.ContentJSON = doc.JSON()
+	result.ContentHTML = doc.HTML()
+
+	return result, nil
+}
+
+// translateBatch - Bir grup metni tek çağrıda çevirir ve sonuçları birimlere yazar
+func (s *Service) translateBatch(ctx context.Context, model string, batch []*translationUnit, source, target string, userID, contentID uuid.UUID) (openai.Usage, error) {
+	if err := s.usage.CheckLimit(ctx, userID); err != nil {
+		return openai.Usage{}, err
+	}
+
+	sources := make([]string, len(batch))
+	for i, unit := range batch {
+		sources[i] = unit.source
+	}
+	payload, err := json.Marshal(map[string][]string{"units": sources})
+	if err != nil {
+		return openai.Usage{}, fmt.Errorf("çeviri isteği hazırlanamadı: %w", err)
+	}
+
+	response, err := s.ai.CreateChatCompletion(ctx, openai.ChatCompletionRequest{
+		Model: model,
+		Messages: []openai.ChatCompletionMessage{
+			{Role: openai.ChatMessageRoleSystem, Content: translationMessages(source, target)},
+			{Role: openai.ChatMessageRoleUser, Content: string(payload)},
+		},
+		MaxCompletionTokens: configs.AI_TRANSLATION_MAX_OUTPUT_TOKENS,
+		Temperature:         0.2,
+		ResponseFormat: &openai.ChatCompletionResponseFormat{
+			Type: openai.ChatCompletionResponseFormatTypeJSONSchema,
+			JSONSchema: &openai.ChatCompletionResponseFormatJSONSchema{
+				Name:   "content_translation",
+				Schema: &translationSchema,
+				Strict: true,
+			},
+		},
+	})
+	if err != nil {
+		return openai.Usage{}, fmt.Errorf("yapay zeka çevirisi alınamadı: %w", err)
+	}
+
+	// Yanıt kullanılamasa bile harcanan token kaydedilir
+	s.usage.Record(ctx, types.AIFeatureTranslation, response.Model, response.Usage, userID, contentID)
+
+	if len(response.Choices) == 0 {
+		return response.Usage, fmt.Errorf("yapay zeka boş yanıt döndü")
+	}
+	if response.Choices[0].FinishReason == openai.FinishReasonLength {
+		return response.Usage, fmt.Errorf("çeviri yanıtı token sınırına takıldı")
+	}
+
+	var output struct {
+		Units []string `json:"units"`
+	}
+	if err := json.Unmarshal([]byte(response.Choices[0].Message.Content), &output); err != nil {
+		return response.Usage, fmt.Errorf("yapay zeka yanıtı okunamadı: %w", err)
+	}
+	if len(output.Units) != len(batch) {
+		return response.Usage, fmt.Errorf("çeviri eleman sayısı uyuşmuyor (%d/%d)", len(output.Units), len(batch))
+	}
+
+	for i, unit := range batch {
+		unit.result = output.Units[i]
+	}
+
+	return response.Usage, nil
+}
+
+// batchUnits - Birimleri karakter sınırına göre gruplar; sınırı tek başına aşan birim kendi grubunda gönderilir
+func batchUnits(units []*translationUnit, limit int) [][]*translationUnit {
+	var batches [][]*translationUnit
+	var current []*translationUnit
+	size := 0
+
+	for _, unit := range units {
+		if len(current) > 0 && size+len(unit.source) > limit {
+			batches = append(batches, current)
+			current, size = nil, 0
+		}
+		current = append(current, unit)
+		size += len(unit.source)
+	}
+	if len(current) > 0 {
+		batches = append(batches, current)
+	}
+
+	return batches
+}
+
+// collectTextBlocks - Doğrudan metin düğümü içeren blokları (paragraf, başlık, tablo hücresi paragrafı...) toplar
+// Kod blokları çevrilmez.
+func collectTextBlocks(node *tiptap.Node, blocks *[]*tiptap.Node) {
+	if node.Type == "codeBlock" {
+		return
+	}
+
+	for _, child := range node.Content {
+		if child.Type == "text" && isTranslatable(child) {
+			*blocks = append(*blocks, node)
+			return
+		}
+	}
+
+	for i := range node.Content {
+		collectTextBlocks(&node.Content[i], blocks)
+	}
+}
+
+// isTranslatable - Satır içi kod ve boş metinler çevrilmez
+func isTranslatable(node tiptap.Node) bool {
+	if node.Type != "text" || strings.TrimSpace(node.Text) == "" {
+		return false
+	}
+	for _, mark := range node.Marks {
+		if mark.Type == "code" {
+			return false
+		}
+	}
+	return true
+}
+
+// encodeBlock - Bloğun satır içi düğümlerini sıra numaralı etiketlerle tek metne çevirir
+// Çevrilecek metin düğümleri <i>metin</i>, diğerleri (satır sonu, satır içi kod, görsel) <i/> olarak yazılır.
+// Metindeki "<" ve "&" kodlanır, böylece "<3>" gibi metinler etiket sanılmaz.
+func encodeBlock(block tiptap.Node) string {
+	var b strings.Builder
+	for i, child := range block.Content {
+		if isTranslatable(child) {
+			fmt.Fprintf(&b, "<%d>%s</%d>", i, textEscaper.Replace(child.Text), i)
+		} else {
+			fmt.Fprintf(&b, "<%d/>", i)
+		}
+	}
+	return b.String()
+}
+
+// decodeBlock - Çevrilen metni etiketlerine göre bloğun düğümlerine geri yazar
+// Etiketlerin sırası değişebilir; her düğüm tam bir kez kullanılmalıdır. Etiket dışında kalan metin biçimsiz eklenir.
+// encodeBlock'un kodladığı karakterler metin düğümlerine yazılırken geri çevrilir.
+func decodeBlock(block *tiptap.Node, translated string) error {
+	content := make([]tiptap.Node, 0, len(block.Content))
+	used := make([]bool, len(block.Content))
+	open, openEnd, last := -1, 0, 0
+
+	addText := func(node tiptap.Node, text string) {
+		if text == "" {
+			return
+		}
+		text = textUnescaper.Replace(text)
+		// Biçimsiz komşu metinler tek düğümde birleştirilir (ProseMirror'ın normalize ettiği gibi)
+		if last := len(content) - 1; last >= 0 && len(node.Marks) == 0 && content[last].Type == "text" && len(content[last].Marks) == 0 {
+			content[last].Text += text
+			return
+		}
+		node.Text = text
+		content = append(content, node)
+	}
+
+	for _, match := range inlineTagPattern.FindAllStringSubmatchIndex(translated, -1) {
+		// Kapanış etiketi
+		if match[6] >= 0 {
+			index, _ := strconv.Atoi(translated[match[6]:match[7]])
+			if index != open {
+				return fmt.Errorf("beklenmeyen kapanış etiketi </%d>", index)
+			}
+			addText(block.Content[index], translated[openEnd:match[0]])
+			open, last = -1, match[1]
+			continue
+		}
+
+		if open >= 0 {
+			return fmt.Errorf("<%d> etiketi kapanmadan yeni etiket açıldı", open)
+		}
+		addText(tiptap.Node{Type: "text"}, translated[last:match[0]])
+
+		index, err := strconv.Atoi(translated[match[2]:match[3]])
+		if err != nil || index >= len(block.Content) || used[index] {
+			return fmt.Errorf("geçersiz veya tekrarlanan etiket <%s>", translated[match[2]:match[3]])
+		}
+		used[index] = true
+
+		if match[5] > match[4] { // Tekil etiket: düğüm olduğu gibi korunur
+			content = append(content, block.Content[index])
+		} else {
+			open, openEnd = index, match[1]
+		}
+		last = match[1]
+	}
+
+	if open >= 0 {
+		return fmt.Errorf("<%d> etiketi kapanmadı", open)
+	}
+	addText(tiptap.Node{Type: "text"}, translated[last:])
+
+	for index, ok := range used {
+		if !ok {
+			// Model boşluktan ibaret düğümleri atlayabilir, bunların kaybolması metni bozmaz
+			if block.Content[index].Type == "text" && strings.TrimSpace(block.Content[index].Text) == "" {
+				continue
+			}
+			return fmt.Errorf("<%d> etiketi çeviride bulunamadı", index)
+		}
+	}
+
+	block.Content = content
+	return nil
+}
@@ -0,0 +1,59 @@
+package writing
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/okanay/backend-holding/services/tiptap"
+)
+
+func TestEncodeDecodeBlock(t *testing.T) {
+	bold := []tiptap.Mark{{Type: "bold"}}
+
+	tests := []struct {
+		name    string
+		content []tiptap.Node
+		encoded string
+	}{
+		{
+			name:    "düz metin",
+			content: []tiptap.Node{{Type: "text", Text: "Merhaba dünya"}},
+			encoded: "<0>Merhaba dünya</0>",
+		},
+		{
+			name:    "etikete benzeyen metin",
+			content: []tiptap.Node{{Type: "text", Text: "Sizi seviyoruz <3> ve </1> "}, {Type: "text", Text: "kalın", Marks: bold}},
+			encoded: "<0>Sizi seviyoruz &lt;3> ve &lt;/1> </0><1>kalın</1>",
+		},
+		{
+			name:    "kodlanmış karakter içeren metin",
+			content: []tiptap.Node{{Type: "text", Text: "a &lt; b & c < d"}},
+			encoded: "<0>a &amp;lt; b &amp; c &lt; d</0>",
+		},
+		{
+			name:    "tekil düğüm korunur",
+			content: []tiptap.Node{{Type: "text", Text: "önce"}, {Type: "hardBreak"}, {Type: "text", Text: "sonra", Marks: bold}},
+			encoded: "<0>önce</0><1/><2>sonra</2>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			block := tiptap.Node{Type: "paragraph", Content: tt.content}
+
+			encoded := encodeBlock(block)
+			if encoded != tt.encoded {
+				t.Errorf("kodlanmış = %q, beklenen %q", encoded, tt.encoded)
+			}
+
+			// Değiştirilmeden geri çözülen blok aynı düğümleri üretmeli
+			decoded := tiptap.Node{Type: "paragraph", Content: append([]tiptap.Node(nil), tt.content...)}
+			if err := decodeBlock(&decoded, encoded); err != nil {
+				t.Fatalf("beklenmeyen hata: %v", err)
+			}
+			if !reflect.DeepEqual(decoded.Content, tt.content) {
+				t.Errorf("çözülen = %+v, beklenen %+v", decoded.Content, tt.content)
+			}
+		})
+	}
+}
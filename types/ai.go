@@ -0,0 +1,130 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AIUsageFeature - Yapay zeka çağrısını yapan özellik
+type AIUsageFeature string
+
+const (
+	AIFeatureApplicationScreening AIUsageFeature = "application_screening"
+	AIFeatureJobDraft             AIUsageFeature = "job_draft"
+	AIFeatureRewrite              AIUsageFeature = "rewrite"
+	AIFeatureSEO                  AIUsageFeature = "seo"
+	AIFeatureTranslation          AIUsageFeature = "translation"
+)
+
+// AIUsageRecord - Tek bir model çağrısının kullanım kaydı (ai_usage tablosu)
+type AIUsageRecord struct {
+	Feature      AIUsageFeature
+	Model        string
+	InputTokens  int
+	OutputTokens int
+	CostUSD      float64
+	UserID       uuid.UUID
+	ReferenceID  uuid.UUID
+}
+
+// AIUsageSummary - Belirli bir zaman aralığındaki toplam kullanım
+type AIUsageSummary struct {
+	Requests int        `json:"requests"`
+	Tokens   int        `json:"tokens"`
+	OldestAt *time.Time `json:"oldestAt,omitempty"` // Aralıktaki ilk çağrı
+}
+
+// AIUsageBudget - Kullanıcının AI_RATE_LIMIT_WINDOW içindeki kullanımı ve kalan hakkı
+type AIUsageBudget struct {
+	Window            string     `json:"window"`
+	Requests          int        `json:"requests"`
+	Tokens            int        `json:"tokens"`
+	MaxRequests       int        `json:"maxRequests"`
+	MaxTokens         int        `json:"maxTokens"`
+	RemainingRequests int        `json:"remainingRequests"`
+	RemainingTokens   int        `json:"remainingTokens"`
+	ResetsAt          *time.Time `json:"resetsAt,omitempty"` // En eski çağrının pencereden çıkacağı zaman
+}
+
+// ApplicationScreening - Başvurunun yapay zeka ile ön değerlendirme sonucu (job_applications.ai_screening)
+type ApplicationScreening struct {
+	FitScore       int       `json:"fitScore"`       // 0-100 arası uygunluk puanı
+	Recommendation string    `json:"recommendation"` // strong_yes, yes, maybe, no
+	Summary        string    `json:"summary"`
+	Strengths      []string  `json:"strengths"`
+	Concerns       []string  `json:"concerns"`
+	Model          string    `json:"model"`
+	CostUSD        float64   `json:"costUsd"`    // Değerlendirme çağrısının dolar cinsinden maliyeti
+	CVIncluded     bool      `json:"cvIncluded"` // Ek dosyalardan metin çıkarılabildi mi
+	ScreenedBy     uuid.UUID `json:"screenedBy"`
+	ScreenedAt     time.Time `json:"screenedAt"`
+}
+
+// ApplicationScreeningResult - Değerlendirme isteğinin yanıtı
+type ApplicationScreeningResult struct {
+	Screening ApplicationScreening `json:"screening"`
+	Usage     map[string]any       `json:"usage"` // utils.CalculateAICostWithOutput çıktısı
+}
+
+// ====================
+// YAZIM ASİSTANI
+// ====================
+
+// AIRewriteMode - Seçili metne uygulanacak dönüşüm
+type AIRewriteMode string
+
+const (
+	AIRewriteModeRewrite AIRewriteMode = "rewrite" // Anlamı koruyarak yeniden yaz
+	AIRewriteModeShorten AIRewriteMode = "shorten" // Kısalt
+)
+
+// AIJobDraftInput - Yapılandırılmış alanlardan iş ilanı açıklaması taslağı
+type AIJobDraftInput struct {
+	Title           string `json:"title" binding:"required,max=200"`
+	Location        string `json:"location" binding:"omitempty,max=200"`
+	WorkMode        string `json:"workMode" binding:"omitempty,max=100"`
+	EmploymentType  string `json:"employmentType" binding:"omitempty,max=100"`
+	ExperienceLevel string `json:"experienceLevel" binding:"omitempty,max=100"`
+	Notes           string `json:"notes" binding:"omitempty,max=4000"` // Sorumluluklar, aranan nitelikler vb. serbest notlar
+	Language        string `json:"language" binding:"omitempty,min=2,max=10"`
+}
+
+// AIRewriteInput - Editörde seçili metnin yeniden yazılması veya kısaltılması
+type AIRewriteInput struct {
+	Text     string        `json:"text" binding:"required,max=10000"`
+	Mode     AIRewriteMode `json:"mode" binding:"required,oneof=rewrite shorten"`
+	Tone     string        `json:"tone" binding:"omitempty,max=50"` // örn. "resmi", "samimi"
+	Language string        `json:"language" binding:"omitempty,min=2,max=10"`
+}
+
+// AISEOInput - İçerik için SEO başlığı, açıklaması ve slug önerileri
+type AISEOInput struct {
+	Title    string `json:"title" binding:"required,max=300"`
+	Content  string `json:"content" binding:"required,max=50000"` // HTML veya düz metin
+	Language string `json:"language" binding:"omitempty,min=2,max=10"`
+}
+
+// AIWritingResult - Taslak ve yeniden yazım sonucu, editöre doğrudan eklenebilir
+type AIWritingResult struct {
+	Doc   any            `json:"doc"` // Tiptap JSON belgesi
+	HTML  string         `json:"html"`
+	Usage map[string]any `json:"usage"`
+}
+
+// AISEOSuggestions - SEO önerileri
+type AISEOSuggestions struct {
+	Titles       []string       `json:"titles"`
+	Descriptions []string       `json:"descriptions"`
+	Slugs        []string       `json:"slugs"`
+	Usage        map[string]any `json:"usage"`
+}
+
+// AIContentTranslation - Çevrilen içerik alanları, taslak kardeş içerik bu alanlarla oluşturulur
+type AIContentTranslation struct {
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	ContentJSON string         `json:"contentJson"` // Düğüm yapısı, biçimler ve bağlantılar korunmuş Tiptap belgesi
+	ContentHTML string         `json:"contentHtml"`
+	Usage       map[string]any `json:"usage"`
+}
@@ -0,0 +1,123 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ====================
+// ARAMA PARAMETRELERİ
+// ====================
+
+// AnalyticsParams - Analitik sorgularında kullanılan ortak filtreler
+type AnalyticsParams struct {
+	From     time.Time // Dahil
+	To       time.Time // Hariç (bitiş gününün ertesi günü)
+	Category string
+	JobID    uuid.UUID
+	Limit    int
+}
+
+// ====================
+// SONUÇ MODELLERİ
+// ====================
+
+// ApplicationsPerDay - İlan bazında günlük başvuru sayısı
+type ApplicationsPerDay struct {
+	JobID    uuid.UUID `json:"jobId"`
+	JobTitle string    `json:"jobTitle"`
+	Day      string    `json:"day"` // YYYY-MM-DD
+	Count    int       `json:"count"`
+}
+
+// StatusFunnelStep - Aşamaya ulaşan başvuru sayısı ve dönüşüm oranları
+type StatusFunnelStep struct {
+	Status                 string  `json:"status"`
+	Reached                int     `json:"reached"`
+	ConversionFromPrevious float64 `json:"conversionFromPrevious"` // 0-1 arası
+	ConversionFromStart    float64 `json:"conversionFromStart"`    // 0-1 arası
+}
+
+// StatusTransition - İki durum arasındaki geçiş sayısı
+type StatusTransition struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Count int    `json:"count"`
+}
+
+// StatusConversion - Durumlar arası dönüşüm raporu
+type StatusConversion struct {
+	Funnel      []StatusFunnelStep `json:"funnel"`
+	Transitions []StatusTransition `json:"transitions"`
+}
+
+// StageDuration - Bir aşamada geçirilen süre istatistikleri (saat cinsinden)
+type StageDuration struct {
+	Status      string  `json:"status"`
+	Count       int     `json:"count"`
+	AvgHours    float64 `json:"avgHours"`
+	MedianHours float64 `json:"medianHours"`
+}
+
+// TimeToHire - Başvurudan işe alıma kadar geçen süre istatistikleri (gün cinsinden)
+type TimeToHire struct {
+	HiredStatus string  `json:"hiredStatus"`
+	Hired       int     `json:"hired"`
+	AvgDays     float64 `json:"avgDays"`
+	MedianDays  float64 `json:"medianDays"`
+	MinDays     float64 `json:"minDays"`
+	MaxDays     float64 `json:"maxDays"`
+}
+
+// SourceBreakdown - Başvuru kanalı bazında sayılar
+type SourceBreakdown struct {
+	Source       string  `json:"source"`
+	Applications int     `json:"applications"`
+	Hired        int     `json:"hired"`
+	HireRate     float64 `json:"hireRate"` // 0-1 arası
+}
+
+// CategoryStat - Kategori bazında ilan ve başvuru sayıları
+type CategoryStat struct {
+	Name         string `json:"name"`
+	DisplayName  string `json:"displayName"`
+	Jobs         int    `json:"jobs"`
+	Applications int    `json:"applications"`
+}
+
+// AIUsageTotals - Yapay zeka kullanımının toplamları
+type AIUsageTotals struct {
+	Requests     int     `json:"requests"`
+	InputTokens  int     `json:"inputTokens"`
+	OutputTokens int     `json:"outputTokens"`
+	CostUSD      float64 `json:"costUsd"`
+}
+
+// AIUsageByUser - Kullanıcı bazında yapay zeka harcaması
+type AIUsageByUser struct {
+	UserID   *uuid.UUID `json:"userId"` // Silinmiş kullanıcılar için boş
+	Username string     `json:"username"`
+	AIUsageTotals
+}
+
+// AIUsageByFeature - Özellik bazında yapay zeka harcaması
+type AIUsageByFeature struct {
+	Feature string `json:"feature"`
+	AIUsageTotals
+}
+
+// AIUsagePerDay - Gün ve özellik bazında yapay zeka harcaması
+type AIUsagePerDay struct {
+	Day     string `json:"day"` // YYYY-MM-DD
+	Feature string `json:"feature"`
+	AIUsageTotals
+}
+
+// AIUsageReport - Yapay zeka harcama raporu
+type AIUsageReport struct {
+	Totals    AIUsageTotals      `json:"totals"`
+	ByUser    []AIUsageByUser    `json:"byUser"`
+	ByFeature []AIUsageByFeature `json:"byFeature"`
+	PerDay    []AIUsagePerDay    `json:"perDay"`
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// VersionConflictError - Kayıt, istemcinin If-Match ile bildirdiği sürümden sonra değiştirilmiş
+type VersionConflictError struct {
+	CurrentVersion int
+}
+
+func (e *VersionConflictError) Error() string {
+	return fmt.Sprintf("kayıt başka bir düzenleme ile değiştirilmiş (güncel sürüm: %d)", e.CurrentVersion)
+}
+
+// EditLockResource - Düzenleme kilidi alınabilen kayıt türleri
+type EditLockResource string
+
+const (
+	EditLockContent EditLockResource = "content"
+	EditLockJob     EditLockResource = "job"
+)
+
+// EditLock - Bir kaydı şu anda kimin düzenlediğini gösteren danışma kilidi
+type EditLock struct {
+	ResourceType EditLockResource `db:"resource_type" json:"resourceType"`
+	ResourceID   uuid.UUID        `db:"resource_id" json:"resourceId"`
+	UserID       uuid.UUID        `db:"user_id" json:"userId"`
+	Username     string           `db:"username" json:"username"`
+	Email        string           `db:"email" json:"email"`
+	AcquiredAt   time.Time        `db:"acquired_at" json:"acquiredAt"`
+	ExpiresAt    time.Time        `db:"expires_at" json:"expiresAt"`
+}
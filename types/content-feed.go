@@ -0,0 +1,99 @@
+package types
+
+import "encoding/xml"
+
+// ====================
+// RSS 2.0 MODELLERİ
+// ====================
+
+// RSSFeedXML - <rss> kök elemanı
+type RSSFeedXML struct {
+	XMLName      xml.Name      `xml:"rss"`
+	Version      string        `xml:"version,attr"`
+	XmlnsContent string        `xml:"xmlns:content,attr"`
+	XmlnsAtom    string        `xml:"xmlns:atom,attr"`
+	Channel      RSSChannelXML `xml:"channel"`
+}
+
+// RSSChannelXML - Feed kanalı
+type RSSChannelXML struct {
+	Title         string       `xml:"title"`
+	Link          string       `xml:"link"`
+	Description   string       `xml:"description"`
+	Language      string       `xml:"language,omitempty"`
+	LastBuildDate string       `xml:"lastBuildDate"`
+	SelfLink      AtomLinkXML  `xml:"atom:link"`
+	Items         []RSSItemXML `xml:"item"`
+}
+
+// RSSItemXML - Feed'deki tek bir içerik
+type RSSItemXML struct {
+	Title       XMLCDATA         `xml:"title"`
+	Link        string           `xml:"link"`
+	GUID        RSSGUIDXML       `xml:"guid"`
+	Description XMLCDATA         `xml:"description"`
+	Content     *XMLCDATA        `xml:"content:encoded,omitempty"`
+	Enclosure   *RSSEnclosureXML `xml:"enclosure,omitempty"`
+	Category    string           `xml:"category,omitempty"`
+	PubDate     string           `xml:"pubDate"`
+}
+
+// RSSGUIDXML - Kalıcı kimlik, adres değil (isPermaLink="false")
+type RSSGUIDXML struct {
+	IsPermaLink string `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// RSSEnclosureXML - İçerik görseli
+type RSSEnclosureXML struct {
+	URL    string `xml:"url,attr"`
+	Length string `xml:"length,attr"`
+	Type   string `xml:"type,attr"`
+}
+
+// ====================
+// ATOM MODELLERİ
+// ====================
+
+// AtomFeedXML - <feed> kök elemanı
+type AtomFeedXML struct {
+	XMLName  xml.Name       `xml:"feed"`
+	Xmlns    string         `xml:"xmlns,attr"`
+	Lang     string         `xml:"xml:lang,attr,omitempty"`
+	ID       string         `xml:"id"`
+	Title    string         `xml:"title"`
+	Subtitle string         `xml:"subtitle,omitempty"`
+	Updated  string         `xml:"updated"`
+	Links    []AtomLinkXML  `xml:"link"`
+	Entries  []AtomEntryXML `xml:"entry"`
+}
+
+// AtomEntryXML - Feed'deki tek bir içerik
+type AtomEntryXML struct {
+	ID        string           `xml:"id"`
+	Title     AtomTextXML      `xml:"title"`
+	Updated   string           `xml:"updated"`
+	Published string           `xml:"published"`
+	Links     []AtomLinkXML    `xml:"link"`
+	Summary   *AtomTextXML     `xml:"summary,omitempty"`
+	Content   *AtomTextXML     `xml:"content,omitempty"`
+	Category  *AtomCategoryXML `xml:"category,omitempty"`
+}
+
+// AtomLinkXML - <link href="..." rel="..." type="..."/> (RSS'te atom:link olarak da kullanılır)
+type AtomLinkXML struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+// AtomTextXML - type="text" veya type="html" metin alanı
+type AtomTextXML struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+// AtomCategoryXML - Kategori slug'ı
+type AtomCategoryXML struct {
+	Term string `xml:"term,attr"`
+}
@@ -15,6 +15,7 @@ type ContentStatus string
 
 const (
 	ContentStatusDraft     ContentStatus = "draft"
+	ContentStatusInReview  ContentStatus = "in_review" // Onaylayıcı incelemesi bekleniyor
 	ContentStatusPublished ContentStatus = "published"
 	ContentStatusClosed    ContentStatus = "closed"  // Migration'daki 'closed' ile eşleşiyor
 	ContentStatusDeleted   ContentStatus = "deleted" // Migration'daki 'deleted' ile eşleşiyor
@@ -42,6 +43,13 @@ type Content struct {
 	Status      ContentStatus `db:"status" json:"status"`
 	CreatedAt   time.Time     `db:"created_at" json:"createdAt"`
 	UpdatedAt   time.Time     `db:"updated_at" json:"updatedAt"`
+	PublishAt   *time.Time    `db:"publish_at" json:"publishAt,omitempty"`
+	UnpublishAt *time.Time    `db:"unpublish_at" json:"unpublishAt,omitempty"`
+	ScheduledBy *uuid.UUID    `db:"scheduled_by" json:"scheduledBy,omitempty"`
+	ScheduledAt *time.Time    `db:"scheduled_at" json:"scheduledAt,omitempty"`
+	Version     int           `db:"version" json:"version"`
+	CategoryID  *uuid.UUID    `db:"category_id" json:"categoryId,omitempty"`
+	Tags        []ContentTag  `json:"tags,omitempty"`
 }
 
 // ====================
@@ -64,6 +72,13 @@ type ContentView struct {
 	Status      ContentStatus   `json:"status"`
 	CreatedAt   time.Time       `json:"createdAt"`
 	UpdatedAt   time.Time       `json:"updatedAt"`
+	PublishAt   *time.Time      `json:"publishAt,omitempty"`
+	UnpublishAt *time.Time      `json:"unpublishAt,omitempty"`
+	ScheduledBy *uuid.UUID      `json:"scheduledBy,omitempty"`
+	ScheduledAt *time.Time      `json:"scheduledAt,omitempty"`
+	Version     int             `json:"version"`
+	CategoryID  *uuid.UUID      `json:"categoryId,omitempty"`
+	Tags        []ContentTag    `json:"tags"`
 }
 
 // ====================
@@ -76,12 +91,16 @@ type ContentInput struct {
 	Language    string        `json:"language" binding:"required,min=2,max=10"`
 	Title       string        `json:"title" binding:"required,min=3,max=255"`
 	Description string        `json:"description,omitempty"`
-	Category    string        `json:"category" binding:"required"`
+	Category    string        `json:"category" binding:"required"` // Kategori slug'ı veya herhangi bir dildeki adı
 	ImageURL    string        `json:"imageUrl,omitempty" binding:"omitempty,url"`
 	DetailsJSON string        `json:"detailsJson,omitempty"`
 	ContentJSON string        `json:"contentJson" binding:"required"`
 	ContentHTML string        `json:"contentHtml" binding:"required"`
 	Status      ContentStatus `json:"status,omitempty" binding:"omitempty,oneof=draft published closed deleted"`
+	ChangeNote  string        `json:"changeNote,omitempty" binding:"omitempty,max=500"`            // Revizyon geçmişinde görünen açıklama
+	Tags        []string      `json:"tags,omitempty" binding:"omitempty,max=20,dive,min=1,max=50"` // Güncellemede nil ise etiketler değişmez
+
+	SourceLanguage string `json:"-"` // Çeviri veya dil varyantı oluşturulurken kaynak içeriğin dili
 }
 
 // ContentStatusInput - Sadece içerik durumunu güncellemek için input.
@@ -89,6 +108,108 @@ type ContentStatusInput struct {
 	Status ContentStatus `json:"status" binding:"required,oneof=draft published closed deleted"`
 }
 
+// ContentTranslateInput - İçeriğin başka bir dile yapay zeka ile çevrilmesi
+// Slug boş bırakılırsa çevrilen başlıktan üretilir.
+type ContentTranslateInput struct {
+	Language string `json:"language" binding:"required,min=2,max=10"`
+	Slug     string `json:"slug" binding:"omitempty,min=3,max=255"`
+}
+
+// ContentVariantInput - Mevcut bir içerikten yeni dil varyantı oluşturma (çeviri yapılmadan kopyalanır)
+// Slug boş bırakılırsa kaynak içeriğin slug'ı kullanılır.
+type ContentVariantInput struct {
+	Language string `json:"language" binding:"required,min=2,max=10"`
+	Slug     string `json:"slug" binding:"omitempty,min=3,max=255"`
+	Title    string `json:"title" binding:"omitempty,min=3,max=255"`
+}
+
+// ContentRestoreInput - Bir revizyonun içeriğe geri yüklenmesi
+type ContentRestoreInput struct {
+	Revision   int    `json:"revision" binding:"required,min=1"`
+	ChangeNote string `json:"changeNote,omitempty" binding:"omitempty,max=500"`
+}
+
+// ====================
+// REVİZYON MODELLERİ
+// ====================
+
+// ContentRevision - İçeriğin bir revizyonu (content_revisions tablosu)
+// Listelemede Snapshot boş döner, sadece karşılaştırma ve geri yüklemede doldurulur.
+type ContentRevision struct {
+	ID           uuid.UUID  `json:"id"`
+	ContentID    uuid.UUID  `json:"contentId"`
+	Revision     int        `json:"revision"`
+	Title        string     `json:"title"`
+	Status       string     `json:"status"`
+	AuthorID     *uuid.UUID `json:"authorId,omitempty"`
+	AuthorName   string     `json:"authorName"`
+	ChangeNote   string     `json:"changeNote"`
+	RestoredFrom *int       `json:"restoredFrom,omitempty"`
+	CreatedAt    time.Time  `json:"createdAt"`
+	Snapshot     *Content   `json:"-"`
+}
+
+// ContentFieldChange - İki revizyon arasında değişen alan
+type ContentFieldChange struct {
+	Field string `json:"field"`
+	From  any    `json:"from"`
+	To    any    `json:"to"`
+}
+
+// ContentRevisionDiff - İki revizyon arasındaki yapısal fark
+type ContentRevisionDiff struct {
+	From   ContentRevision      `json:"from"`
+	To     ContentRevision      `json:"to"`
+	Fields []ContentFieldChange `json:"fields"`
+	Blocks any                  `json:"blocks"` // tiptap.DiffBlocks çıktısı
+}
+
+// ====================
+// İNCELEME MODELLERİ
+// ====================
+
+// ContentTransitionAction - Durum geçişini başlatan işlem
+type ContentTransitionAction string
+
+const (
+	ContentActionSubmit         ContentTransitionAction = "submit"
+	ContentActionApprove        ContentTransitionAction = "approve"
+	ContentActionRequestChanges ContentTransitionAction = "request_changes"
+	ContentActionSchedule       ContentTransitionAction = "schedule"
+	ContentActionStatusChange   ContentTransitionAction = "status_change"
+)
+
+// ContentReviewInput - İncelemeye gönderme, onaylama ve değişiklik isteme işlemlerinde yorum
+type ContentReviewInput struct {
+	Comment string `json:"comment,omitempty" binding:"omitempty,max=2000"`
+}
+
+// ContentTransition - İçeriğin bir durum geçişi (content_status_transitions tablosu)
+type ContentTransition struct {
+	ID         uuid.UUID               `json:"id"`
+	ContentID  uuid.UUID               `json:"contentId"`
+	FromStatus *ContentStatus          `json:"fromStatus,omitempty"`
+	ToStatus   ContentStatus           `json:"toStatus"`
+	Action     ContentTransitionAction `json:"action"`
+	ActorID    *uuid.UUID              `json:"actorId,omitempty"`
+	ActorName  string                  `json:"actorName"`
+	Comment    string                  `json:"comment"`
+	CreatedAt  time.Time               `json:"createdAt"`
+}
+
+// ContentReviewItem - İnceleme kuyruğundaki içerik
+type ContentReviewItem struct {
+	ContentID     uuid.UUID  `json:"contentId"`
+	Title         string     `json:"title"`
+	Slug          string     `json:"slug"`
+	Language      string     `json:"language"`
+	Category      string     `json:"category"`
+	SubmittedBy   *uuid.UUID `json:"submittedBy,omitempty"`
+	SubmitterName string     `json:"submitterName"`
+	Comment       string     `json:"comment"`
+	SubmittedAt   time.Time  `json:"submittedAt"`
+}
+
 // ====================
 // ARAMA PARAMETRELERİ (Listeleme İçin)
 // ====================
@@ -98,9 +219,11 @@ type ContentSearchParams struct {
 	Status     ContentStatus `form:"status"`
 	Language   string        `form:"language"`
 	Identifier string        `form:"identifier"`
-	Category   string        `form:"category"`
+	Category   string        `form:"category"` // Kategori slug'ı, alt kategoriler dahil
+	Tags       []string      `form:"tags"`     // Etiket slug'ları, içerik hepsine sahip olmalı
 	Query      string        `form:"q"`
 	UserID     string        `form:"userId"`
+	Scheduled  bool          `form:"scheduled"` // Sadece zamanlanmış yayın/kapatma bekleyenler
 	Page       int           `form:"page,default=1"`
 	Limit      int           `form:"limit,default=10"`
 	SortBy     string        `form:"sortBy,default=createdAt"`
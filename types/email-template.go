@@ -0,0 +1,67 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EmailTemplateEvent - Adaya e-posta gönderilen olay
+type EmailTemplateEvent string
+
+const (
+	EmailEventApplicationReceived EmailTemplateEvent = "application_received" // Başvuru oluşturuldu
+	EmailEventStatusChanged       EmailTemplateEvent = "status_changed"       // Başvuru durumu değişti
+	EmailEventApplicationDeleted  EmailTemplateEvent = "application_deleted"  // Başvuru silindi (toplu işlem)
+)
+
+// EmailTemplate - Aday e-posta şablonu (email_templates tablosu)
+type EmailTemplate struct {
+	ID        uuid.UUID          `db:"id" json:"id"`
+	Event     EmailTemplateEvent `db:"event" json:"event"`
+	Status    string             `db:"status" json:"status"` // Boşsa olayın tüm durumları için geçerli
+	Language  string             `db:"language" json:"language"`
+	Subject   string             `db:"subject" json:"subject"`
+	TextBody  string             `db:"text_body" json:"textBody"`
+	HTMLBody  string             `db:"html_body" json:"htmlBody"` // Boşsa düz metinden üretilir
+	IsActive  bool               `db:"is_active" json:"isActive"`
+	UpdatedBy *uuid.UUID         `db:"updated_by" json:"updatedBy,omitempty"`
+	CreatedAt time.Time          `db:"created_at" json:"createdAt"`
+	UpdatedAt time.Time          `db:"updated_at" json:"updatedAt"`
+}
+
+// EmailTemplateInput - Şablon oluşturma ve güncelleme
+type EmailTemplateInput struct {
+	Event    EmailTemplateEvent `json:"event" binding:"required,oneof=application_received status_changed application_deleted"`
+	Status   string             `json:"status" binding:"omitempty,max=50"`
+	Language string             `json:"language" binding:"required,min=2,max=10"`
+	Subject  string             `json:"subject" binding:"required,max=255"`
+	TextBody string             `json:"textBody" binding:"required,max=20000"`
+	HTMLBody string             `json:"htmlBody" binding:"omitempty,max=100000"`
+	IsActive *bool              `json:"isActive"` // Boşsa aktif
+}
+
+// EmailTemplateVariables - Şablonlarda kullanılabilecek değişkenler
+type EmailTemplateVariables struct {
+	CandidateName string `json:"candidateName"`
+	JobTitle      string `json:"jobTitle"`
+	Status        string `json:"status"`
+	TrackingLink  string `json:"trackingLink"`
+	Organization  string `json:"organization"`
+	Note          string `json:"note"`
+}
+
+// EmailTemplatePreviewInput - Kaydedilmemiş şablonun örnek verilerle önizlemesi
+type EmailTemplatePreviewInput struct {
+	Subject   string                  `json:"subject" binding:"required,max=255"`
+	TextBody  string                  `json:"textBody" binding:"required,max=20000"`
+	HTMLBody  string                  `json:"htmlBody" binding:"omitempty,max=100000"`
+	Variables *EmailTemplateVariables `json:"variables"` // Boşsa örnek değerler kullanılır
+}
+
+// EmailTemplatePreview - İşlenmiş şablon
+type EmailTemplatePreview struct {
+	Subject string `json:"subject"`
+	Text    string `json:"text"`
+	HTML    string `json:"html"`
+}
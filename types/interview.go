@@ -0,0 +1,100 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// JobInterviewStatus - Mülakat durumu
+type JobInterviewStatus string
+
+const (
+	JobInterviewStatusScheduled           JobInterviewStatus = "scheduled"
+	JobInterviewStatusConfirmed           JobInterviewStatus = "confirmed"
+	JobInterviewStatusRescheduleRequested JobInterviewStatus = "reschedule_requested"
+	JobInterviewStatusCancelled           JobInterviewStatus = "cancelled"
+	JobInterviewStatusCompleted           JobInterviewStatus = "completed"
+)
+
+// ====================
+// VERİTABANI MODELLERİ
+// ====================
+
+// JobInterview - Başvuruya bağlı mülakat (job_interviews tablosu)
+type JobInterview struct {
+	ID                   uuid.UUID          `db:"id" json:"id"`
+	ApplicationID        uuid.UUID          `db:"application_id" json:"applicationId"`
+	StartsAt             time.Time          `db:"starts_at" json:"startsAt"`
+	EndsAt               time.Time          `db:"ends_at" json:"endsAt"`
+	Location             string             `db:"location" json:"location"`
+	VideoURL             string             `db:"video_url" json:"videoUrl"`
+	Notes                string             `db:"notes" json:"notes"`
+	Status               JobInterviewStatus `db:"status" json:"status"`
+	Sequence             int                `db:"sequence" json:"sequence"`
+	CandidateNote        string             `db:"candidate_note" json:"candidateNote"`
+	CandidateRespondedAt *time.Time         `db:"candidate_responded_at" json:"candidateRespondedAt"`
+	CreatedBy            *uuid.UUID         `db:"created_by" json:"createdBy"`
+	CreatedAt            time.Time          `db:"created_at" json:"createdAt"`
+	UpdatedAt            time.Time          `db:"updated_at" json:"updatedAt"`
+}
+
+// ====================
+// VIEW MODELLERİ
+// ====================
+
+// JobInterviewView - Aday ve ilan bilgileriyle birlikte mülakat
+type JobInterviewView struct {
+	JobInterview
+	JobID          uuid.UUID        `json:"jobId"`
+	JobTitle       string           `json:"jobTitle"`
+	CandidateName  string           `json:"candidateName"`
+	CandidateEmail string           `json:"candidateEmail"`
+	Interviewers   []JobInterviewer `json:"interviewers"`
+}
+
+// JobInterviewer - Mülakatçı bilgisi
+type JobInterviewer struct {
+	UserID   uuid.UUID `json:"userId"`
+	Username string    `json:"username"`
+	Email    string    `json:"email"`
+}
+
+// JobInterviewCandidateView - Adayın takip oturumunda gördüğü mülakat (iç notlar ve mülakatçı e-postaları hariç)
+type JobInterviewCandidateView struct {
+	ID            uuid.UUID          `json:"id"`
+	ApplicationID uuid.UUID          `json:"applicationId"`
+	JobTitle      string             `json:"jobTitle"`
+	StartsAt      time.Time          `json:"startsAt"`
+	EndsAt        time.Time          `json:"endsAt"`
+	Location      string             `json:"location"`
+	VideoURL      string             `json:"videoUrl"`
+	Notes         string             `json:"notes"`
+	Status        JobInterviewStatus `json:"status"`
+	CandidateNote string             `json:"candidateNote"`
+	Interviewers  []string           `json:"interviewers"` // Sadece kullanıcı adları
+}
+
+// ====================
+// INPUT MODELLERİ
+// ====================
+
+// JobInterviewInput - Mülakat planlama ve yeniden planlama
+type JobInterviewInput struct {
+	StartsAt     time.Time   `json:"startsAt" binding:"required"`
+	EndsAt       time.Time   `json:"endsAt" binding:"required,gtfield=StartsAt"`
+	Location     string      `json:"location" binding:"omitempty,max=500"`
+	VideoURL     string      `json:"videoUrl" binding:"omitempty,url,max=1000"`
+	Notes        string      `json:"notes" binding:"omitempty,max=2000"`
+	Interviewers []uuid.UUID `json:"interviewers" binding:"omitempty,max=10"`
+}
+
+// JobInterviewStatusInput - Ekip tarafından mülakat durumunu değiştirme (iptal / tamamlandı)
+type JobInterviewStatusInput struct {
+	Status JobInterviewStatus `json:"status" binding:"required,oneof=cancelled completed"`
+}
+
+// JobInterviewRescheduleRequestInput - Adayın yeniden planlama talebi
+type JobInterviewRescheduleRequestInput struct {
+	Note string `json:"note" binding:"required,max=1000"`
+}
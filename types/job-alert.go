@@ -0,0 +1,86 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ====================
+// ENUM TİPLERİ
+// ====================
+
+// JobAlertStatus - İş alarmı abonelik durumu
+type JobAlertStatus string
+
+const (
+	JobAlertStatusPending      JobAlertStatus = "pending"
+	JobAlertStatusActive       JobAlertStatus = "active"
+	JobAlertStatusUnsubscribed JobAlertStatus = "unsubscribed"
+)
+
+// JobAlertFrequency - Bildirim sıklığı
+type JobAlertFrequency string
+
+const (
+	JobAlertFrequencyInstant JobAlertFrequency = "instant" // İlan yayınlandığında
+	JobAlertFrequencyDaily   JobAlertFrequency = "daily"   // Günlük özet
+)
+
+// ====================
+// VERİTABANI MODELLERİ
+// ====================
+
+// JobAlertSubscription - İş alarmı aboneliği (job_alert_subscriptions tablosu)
+type JobAlertSubscription struct {
+	ID               uuid.UUID         `db:"id" json:"id"`
+	Email            string            `db:"email" json:"email"`
+	Categories       []string          `db:"categories" json:"categories"`
+	Location         string            `db:"location" json:"location"`
+	WorkMode         string            `db:"work_mode" json:"workMode"`
+	Frequency        JobAlertFrequency `db:"frequency" json:"frequency"`
+	Status           JobAlertStatus    `db:"status" json:"status"`
+	ConfirmedAt      *time.Time        `db:"confirmed_at" json:"confirmedAt,omitempty"`
+	UnsubscribedAt   *time.Time        `db:"unsubscribed_at" json:"unsubscribedAt,omitempty"`
+	LastNotifiedAt   *time.Time        `db:"last_notified_at" json:"lastNotifiedAt,omitempty"`
+	UnsubscribeToken string            `db:"unsubscribe_token" json:"-"` // Abonelikten çıkma linklerindeki opak token, yanıtlarda dönülmez
+	CreatedAt        time.Time         `db:"created_at" json:"createdAt"`
+	UpdatedAt        time.Time         `db:"updated_at" json:"updatedAt"`
+}
+
+// ====================
+// INPUT MODELLERİ
+// ====================
+
+// JobAlertSubscribeInput - Yeni abonelik isteği
+type JobAlertSubscribeInput struct {
+	Email      string            `json:"email" binding:"required,email"`
+	Categories []string          `json:"categories,omitempty"`
+	Location   string            `json:"location,omitempty"`
+	WorkMode   string            `json:"workMode,omitempty"`
+	Frequency  JobAlertFrequency `json:"frequency,omitempty" binding:"omitempty,oneof=instant daily"`
+}
+
+// JobAlertUpdateInput - Abonelik filtrelerini güncelleme (hesap gerektirmez, imzalı link ile)
+type JobAlertUpdateInput struct {
+	Categories []string          `json:"categories"`
+	Location   string            `json:"location"`
+	WorkMode   string            `json:"workMode"`
+	Frequency  JobAlertFrequency `json:"frequency,omitempty" binding:"omitempty,oneof=instant daily"`
+}
+
+// ====================
+// BİLDİRİM MODELLERİ
+// ====================
+
+// JobAlertJob - Bildirim e-postasında listelenen ilan özeti
+type JobAlertJob struct {
+	ID             uuid.UUID  `json:"id"`
+	Slug           string     `json:"slug"`
+	Title          string     `json:"title"`
+	Location       string     `json:"location"`
+	WorkMode       string     `json:"workMode"`
+	EmploymentType string     `json:"employmentType"`
+	Deadline       *time.Time `json:"deadline,omitempty"`
+	PublishedAt    time.Time  `json:"publishedAt"`
+}
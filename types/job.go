@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/xml"
 	"time"
 
 	"github.com/google/uuid"
@@ -20,6 +21,15 @@ const (
 	JobStatusDeleted   JobStatus = "deleted"
 )
 
+// JobTeamRole - İlanın işe alım ekibindeki rol
+type JobTeamRole string
+
+const (
+	JobTeamRoleOwner       JobTeamRole = "owner"       // İlanı ve ekibi yönetir
+	JobTeamRoleRecruiter   JobTeamRole = "recruiter"   // Başvuruları görür ve durumlarını değiştirir
+	JobTeamRoleInterviewer JobTeamRole = "interviewer" // Başvuruları sadece görüntüler
+)
+
 // ====================
 // VERİTABANI MODELLERİ
 // ====================
@@ -33,6 +43,7 @@ type Job struct {
 	Deadline   *time.Time  `db:"deadline" json:"deadline,omitempty"`
 	CreatedAt  time.Time   `db:"created_at" json:"createdAt"`
 	UpdatedAt  time.Time   `db:"updated_at" json:"updatedAt"`
+	Version    int         `db:"version" json:"version"`
 	Details    *JobDetails `json:"details,omitempty"`
 	Categories []string    `json:"categories,omitempty"`
 }
@@ -51,6 +62,7 @@ type JobDetails struct {
 	JSON            string    `db:"json" json:"json"`
 	FormType        string    `db:"form_type" json:"formType"`
 	Applicants      int       `db:"applicants" json:"applicants"`
+	Language        string    `db:"language" json:"language"`
 }
 
 // JobCategory - İş kategorisi (job_categories tablosu)
@@ -72,8 +84,13 @@ type JobApplication struct {
 	FormType  string    `db:"form_type" json:"formType"`
 	FormJSON  string    `db:"form_json" json:"formJson"`
 	Status    string    `db:"status" json:"status"`
+	Source    string    `db:"source" json:"source"`
+	Tags      []string  `db:"tags" json:"tags"`
+	Language  string    `db:"language" json:"language"`
 	CreatedAt time.Time `db:"created_at" json:"createdAt"`
 	UpdatedAt time.Time `db:"updated_at" json:"updatedAt"`
+
+	AIScreening *ApplicationScreening `db:"ai_screening" json:"aiScreening,omitempty"` // Sadece başvuru detayında doldurulur
 }
 
 // JobsTrackingCode - İş başvuru takip kodu (jobs_tracking_codes tablosu)
@@ -112,9 +129,29 @@ type JobView struct {
 	CreatedAt time.Time  `json:"createdAt"`
 	UpdatedAt time.Time  `json:"updatedAt"`
 
+	// Zamanlanmış yayın alanları
+	PublishAt   *time.Time `json:"publishAt,omitempty"`
+	UnpublishAt *time.Time `json:"unpublishAt,omitempty"`
+	ScheduledBy *uuid.UUID `json:"scheduledBy,omitempty"`
+	ScheduledAt *time.Time `json:"scheduledAt,omitempty"`
+
+	// Eşzamanlı düzenleme kontrolü (ETag)
+	Version int `json:"version"`
+
 	// İlişkili alanlar (job_details tablosundan gelen)
 	Details    JobDetailsView    `json:"details"`
 	Categories []JobCategoryView `json:"categories,omitempty"`
+	HiringTeam []JobTeamMember   `json:"hiringTeam,omitempty"` // Sadece yönetim detayında doldurulur
+}
+
+// JobTeamMember - İşe alım ekibi üyesi görünümü
+type JobTeamMember struct {
+	UserID    uuid.UUID   `json:"userId"`
+	Username  string      `json:"username"`
+	Email     string      `json:"email"`
+	Role      JobTeamRole `json:"role"`
+	AddedBy   *uuid.UUID  `json:"addedBy,omitempty"`
+	CreatedAt time.Time   `json:"createdAt"`
 }
 
 // JobDetailsView - İş ilanı detayları görünümü
@@ -130,6 +167,7 @@ type JobDetailsView struct {
 	JSON            string `json:"json"`
 	FormType        string `json:"formType"`
 	Applicants      int    `json:"applicants"`
+	Language        string `json:"language"`
 }
 
 // JobCategoryView - Kategori görünümü
@@ -153,6 +191,105 @@ type JobApplicationView struct {
 	CreatedAt time.Time `json:"createdAt"`
 }
 
+// ====================
+// JSON-LD MODELLERİ (schema.org)
+// ====================
+
+// JobPostingJSONLD - Google for Jobs için schema.org JobPosting çıktısı
+type JobPostingJSONLD struct {
+	Context                       string                   `json:"@context"`
+	Type                          string                   `json:"@type"`
+	Title                         string                   `json:"title"`
+	Description                   string                   `json:"description"`
+	Identifier                    *JSONLDPropertyValue     `json:"identifier,omitempty"`
+	DatePosted                    string                   `json:"datePosted"`
+	ValidThrough                  string                   `json:"validThrough,omitempty"`
+	EmploymentType                string                   `json:"employmentType,omitempty"`
+	HiringOrganization            JSONLDOrganization       `json:"hiringOrganization"`
+	JobLocation                   *JSONLDPlace             `json:"jobLocation,omitempty"`
+	JobLocationType               string                   `json:"jobLocationType,omitempty"`
+	ApplicantLocationRequirements *JSONLDCountry           `json:"applicantLocationRequirements,omitempty"`
+	Image                         string                   `json:"image,omitempty"`
+	ExperienceRequirements        *JSONLDExperienceSummary `json:"experienceRequirements,omitempty"`
+	Industry                      []string                 `json:"industry,omitempty"`
+}
+
+// JSONLDPropertyValue - İlanın sabit referans kimliği
+type JSONLDPropertyValue struct {
+	Type  string `json:"@type"`
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// JSONLDOrganization - İşe alım yapan kurum
+type JSONLDOrganization struct {
+	Type   string `json:"@type"`
+	Name   string `json:"name"`
+	SameAs string `json:"sameAs,omitempty"`
+	Logo   string `json:"logo,omitempty"`
+}
+
+// JSONLDPlace - İşin yapılacağı fiziksel konum
+type JSONLDPlace struct {
+	Type    string              `json:"@type"`
+	Address JSONLDPostalAddress `json:"address"`
+}
+
+// JSONLDPostalAddress - Konum adresi
+type JSONLDPostalAddress struct {
+	Type            string `json:"@type"`
+	AddressLocality string `json:"addressLocality,omitempty"`
+	AddressCountry  string `json:"addressCountry"`
+}
+
+// JSONLDCountry - Uzaktan çalışmada başvuru yapılabilecek ülke
+type JSONLDCountry struct {
+	Type string `json:"@type"`
+	Name string `json:"name"`
+}
+
+// JSONLDExperienceSummary - Deneyim seviyesi açıklaması
+type JSONLDExperienceSummary struct {
+	Type        string `json:"@type"`
+	Description string `json:"description"`
+}
+
+// ====================
+// XML FEED MODELLERİ (İlan siteleri)
+// ====================
+
+// JobFeedXML - İlan sitelerinin ortak kullandığı <source> kök elemanı
+type JobFeedXML struct {
+	XMLName       xml.Name         `xml:"source"`
+	Publisher     string           `xml:"publisher"`
+	PublisherURL  string           `xml:"publisherurl"`
+	LastBuildDate string           `xml:"lastBuildDate"`
+	Jobs          []JobFeedItemXML `xml:"job"`
+}
+
+// JobFeedItemXML - Feed içerisindeki tek bir ilan
+type JobFeedItemXML struct {
+	Title           XMLCDATA  `xml:"title"`
+	Date            string    `xml:"date"`
+	ReferenceNumber string    `xml:"referencenumber"`
+	URL             XMLCDATA  `xml:"url"`
+	ApplyURL        XMLCDATA  `xml:"applyurl"`
+	Company         XMLCDATA  `xml:"company"`
+	City            *XMLCDATA `xml:"city,omitempty"`
+	Country         string    `xml:"country"`
+	RemoteType      string    `xml:"remotetype,omitempty"`
+	Description     XMLCDATA  `xml:"description"`
+	JobType         string    `xml:"jobtype,omitempty"`
+	Experience      *XMLCDATA `xml:"experience,omitempty"`
+	Category        *XMLCDATA `xml:"category,omitempty"`
+	ExpirationDate  string    `xml:"expirationdate,omitempty"`
+}
+
+// XMLCDATA - Değeri CDATA bloğu içinde yazılan XML alanı
+type XMLCDATA struct {
+	Value string `xml:",cdata"`
+}
+
 // ====================
 // INPUT MODELLERİ
 // ====================
@@ -173,6 +310,16 @@ type JobInput struct {
 	FormType        string     `json:"formType,omitempty"`
 	Categories      []string   `json:"categories,omitempty"`
 	Deadline        *time.Time `json:"deadline,omitempty"`
+	Language        string     `json:"language,omitempty" binding:"omitempty,max=10"` // İlan metninin dili, aramada kök bulma için kullanılır
+
+	// Gönderilmezse ekip değişmez, gönderilirse mevcut ekibin yerine geçer
+	HiringTeam []JobTeamMemberInput `json:"hiringTeam,omitempty" binding:"omitempty,dive"`
+}
+
+// JobTeamMemberInput - İşe alım ekibi üyesi
+type JobTeamMemberInput struct {
+	UserID uuid.UUID   `json:"userId" binding:"required"`
+	Role   JobTeamRole `json:"role" binding:"required,oneof=owner recruiter interviewer"`
 }
 
 // JobStatusInput - İş ilanı durumu güncelleme
@@ -193,6 +340,8 @@ type JobApplicationInput struct {
 	Phone    string `json:"phone" binding:"required"`
 	FormType string `json:"formType" binding:"required"`
 	FormJSON string `json:"formJson" binding:"required"`
+	Source   string `json:"source,omitempty" binding:"omitempty,max=50"`   // Boşsa utm_source veya "direct"
+	Language string `json:"language,omitempty" binding:"omitempty,max=10"` // Aday e-postalarının dili, boşsa varsayılan dil
 }
 
 // JobApplicationStatusInput - Başvuru durumu güncelleme
@@ -219,9 +368,11 @@ type JobTrackingVerifyInput struct {
 type JobSearchParams struct {
 	Status    JobStatus `form:"status"`
 	Category  string    `form:"category"`
-	Query     string    `form:"q"` // Başlık/açıklama içinde arama
+	Query     string    `form:"q"` // Tam metin arama (başlık, açıklama, lokasyon ve ilan metni)
+	Language  string    `form:"language"`
 	Location  string    `form:"location"`
 	WorkMode  string    `form:"workMode"`
+	Scheduled bool      `form:"scheduled"` // Sadece zamanlanmış yayın/kapatma bekleyenler
 	Page      int       `form:"page,default=1"`
 	Limit     int       `form:"limit,default=10"`
 	SortBy    string    `form:"sortBy,default=createdAt"`
@@ -230,14 +381,63 @@ type JobSearchParams struct {
 
 // JobApplicationSearchParams - Başvuru arama parametreleri
 type JobApplicationSearchParams struct {
-	JobID     uuid.UUID `form:"jobId"`
-	Status    string    `form:"status"`
-	FullName  string    `form:"fullName"`
-	Email     string    `form:"email"`
-	StartDate string    `form:"startDate"` // YYYY-MM-DD formatında
-	EndDate   string    `form:"endDate"`   // YYYY-MM-DD formatında
-	Page      int       `form:"page,default=1"`
-	Limit     int       `form:"limit,default=10"`
-	SortBy    string    `form:"sortBy,default=createdAt"`
-	SortOrder string    `form:"sortOrder,default=desc"`
+	JobID     uuid.UUID `form:"jobId" json:"jobId"`
+	ScopeUser uuid.UUID `form:"-" json:"-"` // Boş değilse sadece bu kullanıcının ekibinde olduğu ilanlar (admin için boş)
+	Status    string    `form:"status" json:"status"`
+	Tag       string    `form:"tag" json:"tag"`
+	FullName  string    `form:"fullName" json:"fullName"`
+	Email     string    `form:"email" json:"email"`
+	StartDate string    `form:"startDate" json:"startDate"` // YYYY-MM-DD formatında
+	EndDate   string    `form:"endDate" json:"endDate"`     // YYYY-MM-DD formatında
+	Page      int       `form:"page,default=1" json:"-"`
+	Limit     int       `form:"limit,default=10" json:"-"`
+	SortBy    string    `form:"sortBy,default=createdAt" json:"-"`
+	SortOrder string    `form:"sortOrder,default=desc" json:"-"`
+}
+
+// ====================
+// TOPLU İŞLEMLER
+// ====================
+
+// JobApplicationBulkAction - Başvurulara toplu uygulanabilecek işlem
+type JobApplicationBulkAction string
+
+const (
+	JobApplicationBulkStatus JobApplicationBulkAction = "status" // Durum değiştirme
+	JobApplicationBulkTag    JobApplicationBulkAction = "tag"    // Etiket ekleme
+	JobApplicationBulkUntag  JobApplicationBulkAction = "untag"  // Etiket kaldırma
+	JobApplicationBulkDelete JobApplicationBulkAction = "delete" // Silme (sadece yöneticiler)
+)
+
+// JobApplicationBulkInput - Toplu işlem isteği, ID listesi veya filtreden yalnızca biri gönderilmeli
+type JobApplicationBulkInput struct {
+	IDs           []uuid.UUID                 `json:"ids" binding:"omitempty,max=1000"`
+	Filter        *JobApplicationSearchParams `json:"filter"`
+	Action        JobApplicationBulkAction    `json:"action" binding:"required,oneof=status tag untag delete"`
+	Status        string                      `json:"status" binding:"omitempty,max=50"`
+	Tag           string                      `json:"tag" binding:"omitempty,max=50"`
+	DryRun        bool                        `json:"dryRun"`                                     // true ise sadece etkilenecek başvuru sayısı döner
+	Notify        bool                        `json:"notify"`                                     // Adaylara bilgilendirme e-postası kuyruğa alınsın mı
+	NotifyMessage string                      `json:"notifyMessage" binding:"omitempty,max=2000"` // E-postaya eklenecek opsiyonel not
+}
+
+// JobApplicationRecipient - Başvuru sahibine e-posta göndermek için gereken bilgiler
+type JobApplicationRecipient struct {
+	ID       uuid.UUID
+	Email    string
+	FullName string
+	JobTitle string
+	Language string
+}
+
+// JobApplicationBulkResult - Toplu işlem sonucu
+type JobApplicationBulkResult struct {
+	DryRun       bool        `json:"dryRun"`
+	Matched      int         `json:"matched"`   // Seçime uyan başvuru sayısı
+	OverLimit    bool        `json:"overLimit"` // Seçim BULK_APPLICATION_MAX sınırını aşıyor, işlem uygulanmaz
+	Affected     int         `json:"affected"`  // Gerçekten değişen başvuru sayısı
+	Queued       int         `json:"queued"`    // Kuyruğa alınan e-posta sayısı
+	FilesDeleted int         `json:"filesDeleted,omitempty"`
+	IDs          []uuid.UUID `json:"ids"`
+	DeletedForms []string    `json:"-"` // Silinen başvuruların form verisi (eklerin temizlenmesi için)
 }
@@ -0,0 +1,15 @@
+package types
+
+import (
+	"github.com/google/uuid"
+)
+
+// OutboxMessage - Kuyruktan gönderilecek e-posta (email_outbox tablosu)
+type OutboxMessage struct {
+	ID       uuid.UUID
+	To       string
+	Subject  string
+	Text     string
+	HTML     string
+	Attempts int
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PreviewResource - Önizleme linki oluşturulabilen kayıt türleri
+type PreviewResource string
+
+const (
+	PreviewContent PreviewResource = "content"
+	PreviewJob     PreviewResource = "job"
+)
+
+// PreviewLink - Taslak bir kaydın hesap gerektirmeden görüntülenmesini sağlayan imzalı link
+type PreviewLink struct {
+	ID           uuid.UUID       `db:"id" json:"id"`
+	ResourceType PreviewResource `db:"resource_type" json:"resourceType"`
+	ResourceID   uuid.UUID       `db:"resource_id" json:"resourceId"`
+	CreatedBy    *uuid.UUID      `db:"created_by" json:"createdBy,omitempty"`
+	Note         string          `db:"note" json:"note,omitempty"`
+	ExpiresAt    time.Time       `db:"expires_at" json:"expiresAt"`
+	RevokedAt    *time.Time      `db:"revoked_at" json:"revokedAt,omitempty"`
+	ViewCount    int             `db:"view_count" json:"viewCount"`
+	LastViewedAt *time.Time      `db:"last_viewed_at" json:"lastViewedAt,omitempty"`
+	CreatedAt    time.Time       `db:"created_at" json:"createdAt"`
+
+	// Sadece oluşturma yanıtında döner, veritabanında saklanmaz
+	Token string `json:"token,omitempty"`
+	URL   string `json:"url,omitempty"`
+}
+
+// PreviewLinkInput - Önizleme linki oluşturma isteği
+type PreviewLinkInput struct {
+	ExpiresInHours int    `json:"expiresInHours" binding:"omitempty,min=1,max=720"`
+	Note           string `json:"note" binding:"omitempty,max=255"`
+}
@@ -0,0 +1,111 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DataPrivacyRequestType - Kişisel veri talebi tipi
+type DataPrivacyRequestType string
+
+const (
+	DataPrivacyRequestExport    DataPrivacyRequestType = "export"
+	DataPrivacyRequestDelete    DataPrivacyRequestType = "delete"
+	DataPrivacyRequestRetention DataPrivacyRequestType = "retention"
+)
+
+// DataPrivacyRequestStatus - Kişisel veri talebi durumu
+type DataPrivacyRequestStatus string
+
+const (
+	DataPrivacyRequestPending   DataPrivacyRequestStatus = "pending"
+	DataPrivacyRequestCompleted DataPrivacyRequestStatus = "completed"
+	DataPrivacyRequestFailed    DataPrivacyRequestStatus = "failed"
+)
+
+// ====================
+// VERİTABANI MODELLERİ
+// ====================
+
+// DataPrivacyRequest - Kişisel veri talebi kaydı (data_privacy_requests tablosu)
+type DataPrivacyRequest struct {
+	ID                   uuid.UUID                `db:"id" json:"id"`
+	RequestType          DataPrivacyRequestType   `db:"request_type" json:"requestType"`
+	Status               DataPrivacyRequestStatus `db:"status" json:"status"`
+	EmailHash            *string                  `db:"email_hash" json:"emailHash"`
+	IPAddress            *string                  `db:"ip_address" json:"ipAddress"`
+	ApplicationsAffected int                      `db:"applications_affected" json:"applicationsAffected"`
+	FilesDeleted         int                      `db:"files_deleted" json:"filesDeleted"`
+	Error                string                   `db:"error" json:"error"`
+	RequestedAt          time.Time                `db:"requested_at" json:"requestedAt"`
+	CompletedAt          *time.Time               `db:"completed_at" json:"completedAt"`
+}
+
+// ApplicantRecord - Anonimleştirilecek başvurunun kimliği ve ekleri bulmak için form verisi
+type ApplicantRecord struct {
+	ID       uuid.UUID
+	FormJSON string
+}
+
+// ====================
+// VIEW MODELLERİ
+// ====================
+
+// ApplicantDataExport - Adayın "verilerimi dışa aktar" talebinin çıktısı
+type ApplicantDataExport struct {
+	Email              string                 `json:"email"`
+	ExportedAt         time.Time              `json:"exportedAt"`
+	Applications       []JobApplication       `json:"applications"`
+	StatusHistory      []ApplicantStatusEvent `json:"statusHistory"`
+	Interviews         []ApplicantInterview   `json:"interviews"`
+	AlertSubscriptions []JobAlertSubscription `json:"alertSubscriptions"`
+	Files              []File                 `json:"files"`
+}
+
+// ApplicantStatusEvent - Başvuru durum geçmişi satırı (değiştiren kullanıcı bilgisi hariç)
+type ApplicantStatusEvent struct {
+	ApplicationID uuid.UUID `json:"applicationId"`
+	FromStatus    *string   `json:"fromStatus"`
+	ToStatus      string    `json:"toStatus"`
+	ChangedAt     time.Time `json:"changedAt"`
+}
+
+// ApplicantInterview - Dışa aktarımdaki mülakat kaydı
+type ApplicantInterview struct {
+	ID            uuid.UUID          `json:"id"`
+	ApplicationID uuid.UUID          `json:"applicationId"`
+	StartsAt      time.Time          `json:"startsAt"`
+	EndsAt        time.Time          `json:"endsAt"`
+	Location      string             `json:"location"`
+	VideoURL      string             `json:"videoUrl"`
+	Status        JobInterviewStatus `json:"status"`
+	CandidateNote string             `json:"candidateNote"`
+}
+
+// ApplicantErasureResult - Anonimleştirme işleminin sonucu
+type ApplicantErasureResult struct {
+	ApplicationsAffected int `json:"applicationsAffected"`
+	FilesDeleted         int `json:"filesDeleted"`
+}
+
+// ====================
+// INPUT MODELLERİ
+// ====================
+
+// ApplicantDeleteInput - Adayın silme talebini onaylaması
+type ApplicantDeleteInput struct {
+	Confirm bool `json:"confirm" binding:"required"`
+}
+
+// ====================
+// ARAMA PARAMETRELERİ
+// ====================
+
+// DataPrivacyRequestSearchParams - Yönetici kayıt listesi filtreleri
+type DataPrivacyRequestSearchParams struct {
+	RequestType DataPrivacyRequestType `form:"type"`
+	EmailHash   string                 `form:"-"` // Handler'da e-postadan hesaplanır
+	Page        int                    `form:"page"`
+	Limit       int                    `form:"limit"`
+}
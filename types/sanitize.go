@@ -0,0 +1,19 @@
+package types
+
+// HTMLRemovalReason - Sanitizer'ın bir öğeyi neden kaldırdığı
+type HTMLRemovalReason string
+
+const (
+	HTMLRemovalElement   HTMLRemovalReason = "element_not_allowed"   // İzin listesinde olmayan etiket
+	HTMLRemovalAttribute HTMLRemovalReason = "attribute_not_allowed" // İzin listesinde olmayan öznitelik (on* olayları dahil)
+	HTMLRemovalURL       HTMLRemovalReason = "unsafe_url"            // javascript:, data: gibi izin verilmeyen adres
+	HTMLRemovalStyle     HTMLRemovalReason = "unsafe_style"          // İzin verilmeyen CSS özelliği veya değeri
+)
+
+// HTMLRemoval - Zengin metinden kaldırılan öğe, aynı türdekiler tek kayıtta sayılır
+type HTMLRemoval struct {
+	Element   string            `json:"element"`
+	Attribute string            `json:"attribute,omitempty"`
+	Reason    HTMLRemovalReason `json:"reason"`
+	Count     int               `json:"count"`
+}
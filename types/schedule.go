@@ -0,0 +1,28 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ====================
+// INPUT MODELLERİ
+// ====================
+
+// ScheduleInput - İş ilanı veya içerik için zamanlanmış yayın/kapatma
+// Gönderilen değerler mevcut zamanlamanın yerine geçer, null gönderilen alan zamanlamadan çıkarılır.
+type ScheduleInput struct {
+	PublishAt   *time.Time `json:"publishAt"`
+	UnpublishAt *time.Time `json:"unpublishAt"`
+}
+
+// ====================
+// SONUÇ MODELLERİ
+// ====================
+
+// ScheduleTransitionResult - Zamanlayıcının tek çalışmada uyguladığı durum geçişleri
+type ScheduleTransitionResult struct {
+	Published   []uuid.UUID // Yayınlanan kayıtların ID'leri
+	Unpublished []uuid.UUID // Kapatılan kayıtların ID'leri
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SearchResultType - Arama sonucunun kaynağı
+type SearchResultType string
+
+const (
+	SearchResultContent SearchResultType = "content"
+	SearchResultJob     SearchResultType = "job"
+)
+
+// SearchParams - Tam metin arama parametreleri
+// Language verilirse o dilin yapılandırmasıyla aranır ve sonuçlar o dille sınırlanır.
+type SearchParams struct {
+	Query    string `form:"q"`
+	Language string `form:"language"`
+	Category string `form:"category"` // İçeriklerde kategori slug'ı (alt kategoriler dahil)
+	Page     int    `form:"page,default=1"`
+	Limit    int    `form:"limit,default=10"`
+}
+
+// SearchResult - Sıralanmış ve vurgulanmış arama sonucu
+type SearchResult struct {
+	ID          uuid.UUID        `json:"id"`
+	Type        SearchResultType `json:"type"`
+	Slug        string           `json:"slug"`
+	Language    string           `json:"language"`
+	Title       string           `json:"title"`
+	Category    string           `json:"category,omitempty"` // İçeriklerde kategori, ilanlarda lokasyon
+	ImageURL    *string          `json:"imageUrl,omitempty"`
+	Snippet     string           `json:"snippet"` // Eşleşen kelimeler <mark> ile işaretlenmiş HTML
+	Rank        float64          `json:"rank"`
+	URL         string           `json:"url"`
+	PublishedAt time.Time        `json:"publishedAt"`
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// SitemapEntry - Sitemap'e girecek yayınlanmış bir sayfa
+// Identifier aynı içeriğin dil versiyonlarını hreflang ile bağlamak için kullanılır, ilanlarda boştur.
+type SitemapEntry struct {
+	Identifier string
+	Language   string
+	Slug       string
+	UpdatedAt  time.Time
+}
+
+// ====================
+// SITEMAP XML MODELLERİ
+// ====================
+
+// SitemapURLSetXML - <urlset> kök elemanı
+type SitemapURLSetXML struct {
+	XMLName    xml.Name        `xml:"urlset"`
+	Xmlns      string          `xml:"xmlns,attr"`
+	XmlnsXhtml string          `xml:"xmlns:xhtml,attr"`
+	URLs       []SitemapURLXML `xml:"url"`
+}
+
+// SitemapURLXML - Tek bir sayfa ve dil alternatifleri
+type SitemapURLXML struct {
+	Loc        string                `xml:"loc"`
+	LastMod    string                `xml:"lastmod,omitempty"`
+	Alternates []SitemapAlternateXML `xml:"xhtml:link"`
+}
+
+// SitemapAlternateXML - <xhtml:link rel="alternate" hreflang="..." href="..."/>
+type SitemapAlternateXML struct {
+	Rel      string `xml:"rel,attr"`
+	Hreflang string `xml:"hreflang,attr"`
+	Href     string `xml:"href,attr"`
+}
+
+// SitemapIndexXML - Büyük sitelerde alt sitemap'leri listeleyen <sitemapindex> kök elemanı
+type SitemapIndexXML struct {
+	XMLName  xml.Name        `xml:"sitemapindex"`
+	Xmlns    string          `xml:"xmlns,attr"`
+	Sitemaps []SitemapRefXML `xml:"sitemap"`
+}
+
+// SitemapRefXML - Sitemap index'teki tek bir alt sitemap
+type SitemapRefXML struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
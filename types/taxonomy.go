@@ -0,0 +1,78 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ====================
+// İÇERİK KATEGORİLERİ
+// ====================
+
+// ContentCategory - Hiyerarşik içerik kategorisi (content_categories tablosu)
+type ContentCategory struct {
+	ID           uuid.UUID                    `json:"id"`
+	Slug         string                       `json:"slug"`
+	ParentID     *uuid.UUID                   `json:"parentId,omitempty"`
+	SortOrder    int                          `json:"sortOrder"`
+	Name         string                       `json:"name"` // İstenen dildeki ad, çeviri yoksa ilk çeviri veya slug
+	Translations []ContentCategoryTranslation `json:"translations,omitempty"`
+	Children     []ContentCategory            `json:"children,omitempty"`
+	CreatedAt    time.Time                    `json:"createdAt"`
+	UpdatedAt    time.Time                    `json:"updatedAt"`
+}
+
+// ContentCategoryTranslation - Kategorinin bir dildeki adı
+type ContentCategoryTranslation struct {
+	Language    string `json:"language" binding:"required,min=2,max=10"`
+	Name        string `json:"name" binding:"required,min=1,max=100"`
+	Description string `json:"description,omitempty" binding:"omitempty,max=500"`
+}
+
+// ContentCategoryInput - Kategori oluşturma ve güncelleme
+// Slug boş bırakılırsa ilk çeviriden üretilir.
+type ContentCategoryInput struct {
+	Slug         string                       `json:"slug" binding:"omitempty,min=2,max=100"`
+	ParentID     *uuid.UUID                   `json:"parentId"`
+	SortOrder    int                          `json:"sortOrder"`
+	Translations []ContentCategoryTranslation `json:"translations" binding:"required,min=1,dive"`
+}
+
+// ====================
+// İÇERİK ETİKETLERİ
+// ====================
+
+// ContentTag - İçerik etiketi (content_tags tablosu)
+type ContentTag struct {
+	ID           uuid.UUID `json:"id"`
+	Slug         string    `json:"slug"`
+	Name         string    `json:"name"`
+	ContentCount int       `json:"contentCount,omitempty"`
+}
+
+// ====================
+// FACET SAYILARI
+// ====================
+
+// ContentCategoryFacet - Filtreye uyan içeriklerin kategori bazında sayısı (alt kategoriler dahil)
+type ContentCategoryFacet struct {
+	ID       uuid.UUID  `json:"id"`
+	Slug     string     `json:"slug"`
+	ParentID *uuid.UUID `json:"parentId,omitempty"`
+	Name     string     `json:"name"`
+	Count    int        `json:"count"`
+}
+
+// ContentTagFacet - Filtreye uyan içeriklerin etiket bazında sayısı
+type ContentTagFacet struct {
+	Slug  string `json:"slug"`
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// ContentFacets - Yayınlanmış içerik listesinin facet sayıları
+type ContentFacets struct {
+	Categories []ContentCategoryFacet `json:"categories"`
+	Tags       []ContentTagFacet      `json:"tags"`
+}
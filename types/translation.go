@@ -0,0 +1,64 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ====================
+// ÇEVİRİ GRUPLARI
+// ====================
+
+// ContentTranslationVariant - Çeviri grubundaki tek bir dil versiyonu
+// Outdated: kaynak dildeki içerik bu versiyondan sonra düzenlenmiş.
+type ContentTranslationVariant struct {
+	ID               uuid.UUID     `json:"id"`
+	Language         string        `json:"language"`
+	Slug             string        `json:"slug"`
+	Title            string        `json:"title"`
+	Status           ContentStatus `json:"status"`
+	SourceLanguage   string        `json:"sourceLanguage,omitempty"` // Karşılaştırılan kaynak dil
+	ContentUpdatedAt time.Time     `json:"contentUpdatedAt"`
+	SourceUpdatedAt  *time.Time    `json:"sourceUpdatedAt,omitempty"`
+	Outdated         bool          `json:"outdated"`
+}
+
+// ContentTranslationGroup - Aynı identifier'a sahip içerikler ve yapılandırılmış site dillerine göre eksikleri
+type ContentTranslationGroup struct {
+	Identifier string                      `json:"identifier"`
+	Category   string                      `json:"category"`
+	Variants   []ContentTranslationVariant `json:"variants"`
+	Languages  []string                    `json:"languages"` // Mevcut diller
+	Missing    []string                    `json:"missing"`   // Site dillerinden eksik olanlar
+	Outdated   []string                    `json:"outdated"`  // Güncel olmayan çeviriler
+	Coverage   float64                     `json:"coverage"`  // Site dillerinin mevcut olan oranı (0-1)
+}
+
+// ContentTranslationParams - Çeviri gruplarını listeleme filtreleri
+type ContentTranslationParams struct {
+	Missing  string // Bu dili eksik olan gruplar
+	Outdated bool   // Güncel olmayan çevirisi olan gruplar
+	Category string
+	Query    string
+	Page     int
+	Limit    int
+}
+
+// ContentLanguageCoverage - Bir site dili için çeviri kapsamı
+type ContentLanguageCoverage struct {
+	Language string  `json:"language"`
+	Present  int     `json:"present"`
+	Missing  int     `json:"missing"`
+	Outdated int     `json:"outdated"`
+	Coverage float64 `json:"coverage"`
+}
+
+// ContentTranslationCoverage - Panel için çeviri kapsamı özeti
+type ContentTranslationCoverage struct {
+	Languages      []string                  `json:"languages"`
+	TotalGroups    int                       `json:"totalGroups"`
+	CompleteGroups int                       `json:"completeGroups"` // Tüm site dillerinde versiyonu olan gruplar
+	Coverage       float64                   `json:"coverage"`
+	ByLanguage     []ContentLanguageCoverage `json:"byLanguage"`
+}
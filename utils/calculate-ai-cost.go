@@ -1,18 +1,30 @@
 package utils
 
-import "fmt"
+import (
+	"fmt"
 
-func CalculateAICostWithOutput(inputTokens, outputTokens int) map[string]any {
-	// Pricing: Input $0.05, Output $0.20 (per million tokens) 4.1 nano
-	inputCost := float64(inputTokens) * 0.05 / 1000000.0
-	outputCost := float64(outputTokens) * 0.20 / 1000000.0
+	"github.com/okanay/backend-holding/configs"
+)
+
+// CalculateAICost token sayılarından dolar cinsinden giriş ve çıkış maliyetini hesaplar
+// Fiyat çağıran tarafından verilir (örn. AIRepository.Price); fiyatı bilinmeyen modeller için sıfır fiyat ile maliyet sıfırdır.
+func CalculateAICost(price configs.AIModelPrice, inputTokens, outputTokens int) (float64, float64) {
+	inputCost := float64(inputTokens) * price.Input / 1000000.0
+	outputCost := float64(outputTokens) * price.Output / 1000000.0
+	return inputCost, outputCost
+}
+
+func CalculateAICostWithOutput(model string, price configs.AIModelPrice, inputTokens, outputTokens int) map[string]any {
+	inputCost, outputCost := CalculateAICost(price, inputTokens, outputTokens)
 	totalCost := inputCost + outputCost
 
 	return map[string]any{
+		"model":        model,
 		"inputTokens":  inputTokens,
 		"outputTokens": outputTokens,
 		"inputCost":    fmt.Sprintf("$%.4f", inputCost),
 		"outputCost":   fmt.Sprintf("$%.4f", outputCost),
 		"totalCost":    fmt.Sprintf("$%.4f", totalCost),
+		"totalCostUSD": totalCost,
 	}
 }
@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// CollectURLs form verisindeki tüm URL değerlerini toplar (dosya ekleri form_json içinde URL olarak tutulur)
+func CollectURLs(formJSON string) []string {
+	var data any
+	if err := json.Unmarshal([]byte(formJSON), &data); err != nil {
+		return nil
+	}
+
+	var urls []string
+	var walk func(value any)
+	walk = func(value any) {
+		switch v := value.(type) {
+		case string:
+			if strings.HasPrefix(v, "http://") || strings.HasPrefix(v, "https://") {
+				urls = append(urls, v)
+			}
+		case []any:
+			for _, item := range v {
+				walk(item)
+			}
+		case map[string]any:
+			for _, item := range v {
+				walk(item)
+			}
+		}
+	}
+	walk(data)
+
+	return urls
+}
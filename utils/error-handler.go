@@ -104,6 +104,12 @@ func HandleDatabaseError(c *gin.Context, err error, operation string) bool {
 				ErrorCode:     "tag_exists",
 				Message:       "Bu etiket adı zaten kullanımda.",
 			},
+			{
+				Code:          "23505",
+				ConstraintKey: "uq_email_template",
+				ErrorCode:     "email_template_exists",
+				Message:       "Bu olay, durum ve dil için zaten bir e-posta şablonu var.",
+			},
 		}
 
 		// Check for specific error conditions first
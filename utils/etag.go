@@ -0,0 +1,108 @@
+package utils
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ETag - Kayıt sürümünden ETag değeri üretir
+func ETag(version int) string {
+	return `"` + strconv.Itoa(version) + `"`
+}
+
+// SetETag - Yanıta ETag başlığını ekler
+func SetETag(c *gin.Context, version int) {
+	c.Header("ETag", ETag(version))
+}
+
+// NotModified - If-None-Match başlığı güncel sürümle eşleşiyorsa 304 döner
+func NotModified(c *gin.Context, version int) bool {
+	header := c.GetHeader("If-None-Match")
+	if header == "" {
+		return false
+	}
+
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == ETag(version) {
+			c.Status(http.StatusNotModified)
+			return true
+		}
+	}
+
+	return false
+}
+
+// NotModifiedSince - Sürüm numarası olmayan yanıtlar (feed'ler) için ETag ve Last-Modified başlıklarını ekler
+// İstemcinin kopyası güncelse 304 döner. If-None-Match gönderildiyse If-Modified-Since dikkate alınmaz (RFC 9110).
+func NotModifiedSince(c *gin.Context, etag string, lastModified time.Time) bool {
+	c.Header("ETag", etag)
+	if !lastModified.IsZero() {
+		c.Header("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+	}
+
+	if header := c.GetHeader("If-None-Match"); header != "" {
+		for _, tag := range strings.Split(header, ",") {
+			tag = strings.TrimSpace(tag)
+			if tag == "*" || strings.TrimPrefix(tag, "W/") == strings.TrimPrefix(etag, "W/") {
+				c.Status(http.StatusNotModified)
+				return true
+			}
+		}
+		return false
+	}
+
+	if since, err := http.ParseTime(c.GetHeader("If-Modified-Since")); err == nil && !lastModified.IsZero() {
+		if !lastModified.Truncate(time.Second).After(since) {
+			c.Status(http.StatusNotModified)
+			return true
+		}
+	}
+
+	return false
+}
+
+// RequireIfMatch - Güncelleme isteğindeki If-Match başlığından beklenen sürümü okur
+// "*" herhangi bir sürümü kabul eder ve 0 döner. Başlık yoksa 428, geçersizse 400 yanıtı gönderilir.
+func RequireIfMatch(c *gin.Context) (int, bool) {
+	header := strings.TrimSpace(c.GetHeader("If-Match"))
+	if header == "" {
+		c.JSON(http.StatusPreconditionRequired, ErrorResponse{
+			Success: false,
+			Error:   "precondition_required",
+			Message: "Güncelleme için If-Match başlığında kaydın ETag değeri gönderilmelidir.",
+		})
+		return 0, false
+	}
+
+	if header == "*" {
+		return 0, true
+	}
+
+	// Birden fazla değer gönderilse de düzenleme ekranı tek sürüm bilir, ilki kullanılır
+	tag := strings.TrimSpace(strings.Split(header, ",")[0])
+	tag = strings.Trim(strings.TrimPrefix(tag, "W/"), `"`)
+
+	version, err := strconv.Atoi(tag)
+	if err != nil || version < 1 {
+		BadRequest(c, "Geçersiz If-Match değeri")
+		return 0, false
+	}
+
+	return version, true
+}
+
+// PreconditionFailed - Eski sürüm üzerinden yapılan güncellemeyi güncel sürümle birlikte 412 ile reddeder
+func PreconditionFailed(c *gin.Context, currentVersion int) {
+	SetETag(c, currentVersion)
+	c.JSON(http.StatusPreconditionFailed, gin.H{
+		"success":        false,
+		"error":          "version_conflict",
+		"message":        "Kayıt siz düzenlerken değiştirildi. Güncel sürümü yükleyip değişikliklerinizi tekrar uygulayın.",
+		"currentVersion": currentVersion,
+	})
+}
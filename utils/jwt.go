@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"github.com/okanay/backend-holding/configs"
 	"github.com/okanay/backend-holding/types"
 )
@@ -185,3 +186,119 @@ func ShouldRefreshToken(tokenString string) (bool, error) {
 
 	return remainingDuration < (totalDuration / 4), nil
 }
+
+// GenerateJobAlertToken iş alarmı aboneliği için imzalı link token'ı üretir (subject: onay veya yönetim)
+func GenerateJobAlertToken(subscriptionID uuid.UUID, subject string, duration time.Duration) (string, error) {
+	secretKey := os.Getenv("JWT_ALERTS_SECRET")
+	if secretKey == "" {
+		return "", errors.New("JWT_ALERTS_SECRET environment variable is not set")
+	}
+
+	tokenClaims := jwt.RegisteredClaims{
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(duration)),
+		IssuedAt:  jwt.NewNumericDate(time.Now()),
+		NotBefore: jwt.NewNumericDate(time.Now()),
+		Issuer:    configs.JWT_ISSUER,
+		Subject:   subject,
+		ID:        subscriptionID.String(),
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, tokenClaims)
+
+	signedToken, err := token.SignedString([]byte(secretKey))
+	if err != nil {
+		return "", fmt.Errorf("failed to sign token: %w", err)
+	}
+
+	return signedToken, nil
+}
+
+// VerifyJobAlertToken iş alarmı token'ını doğrular ve abonelik ID'sini döner
+func VerifyJobAlertToken(tokenString string, subject string) (uuid.UUID, error) {
+	secretKey := os.Getenv("JWT_ALERTS_SECRET")
+	if secretKey == "" {
+		return uuid.Nil, errors.New("JWT_ALERTS_SECRET environment variable is not set")
+	}
+
+	claims := &jwt.RegisteredClaims{}
+
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (any, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return []byte(secretKey), nil
+	}, jwt.WithSubject(subject), jwt.WithIssuer(configs.JWT_ISSUER))
+
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to parse or validate token: %w", err)
+	}
+
+	if !token.Valid {
+		return uuid.Nil, errors.New("token parsed but marked as invalid")
+	}
+
+	subscriptionID, err := uuid.Parse(claims.ID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid subscription id in token: %w", err)
+	}
+
+	return subscriptionID, nil
+}
+
+// GeneratePreviewToken taslak önizleme linki için imzalı token üretir (ID: preview_links kaydı)
+func GeneratePreviewToken(linkID uuid.UUID, expiresAt time.Time) (string, error) {
+	secretKey := os.Getenv("JWT_PREVIEW_SECRET")
+	if secretKey == "" {
+		return "", errors.New("JWT_PREVIEW_SECRET environment variable is not set")
+	}
+
+	tokenClaims := jwt.RegisteredClaims{
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+		IssuedAt:  jwt.NewNumericDate(time.Now()),
+		NotBefore: jwt.NewNumericDate(time.Now()),
+		Issuer:    configs.JWT_ISSUER,
+		Subject:   configs.PREVIEW_SUBJECT,
+		ID:        linkID.String(),
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, tokenClaims)
+
+	signedToken, err := token.SignedString([]byte(secretKey))
+	if err != nil {
+		return "", fmt.Errorf("failed to sign token: %w", err)
+	}
+
+	return signedToken, nil
+}
+
+// VerifyPreviewToken önizleme token'ını doğrular ve link ID'sini döner
+func VerifyPreviewToken(tokenString string) (uuid.UUID, error) {
+	secretKey := os.Getenv("JWT_PREVIEW_SECRET")
+	if secretKey == "" {
+		return uuid.Nil, errors.New("JWT_PREVIEW_SECRET environment variable is not set")
+	}
+
+	claims := &jwt.RegisteredClaims{}
+
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (any, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return []byte(secretKey), nil
+	}, jwt.WithSubject(configs.PREVIEW_SUBJECT), jwt.WithIssuer(configs.JWT_ISSUER))
+
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to parse or validate token: %w", err)
+	}
+
+	if !token.Valid {
+		return uuid.Nil, errors.New("token parsed but marked as invalid")
+	}
+
+	linkID, err := uuid.Parse(claims.ID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid preview link id in token: %w", err)
+	}
+
+	return linkID, nil
+}
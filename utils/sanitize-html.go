@@ -0,0 +1,375 @@
+package utils
+
+import (
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/okanay/backend-holding/types"
+	"golang.org/x/net/html"
+)
+
+// allowedElements - Tiptap editörünün ürettiği etiketler ve etikete özel öznitelikler
+// Ortak öznitelikler (globalAttributes) ve data-* öznitelikleri tüm etiketlerde kabul edilir.
+var allowedElements = map[string]map[string]bool{
+	"p": nil, "br": nil, "hr": nil, "div": nil, "span": nil,
+	"h1": nil, "h2": nil, "h3": nil, "h4": nil, "h5": nil, "h6": nil,
+	"strong": nil, "b": nil, "em": nil, "i": nil, "u": nil, "s": nil, "strike": nil, "del": nil, "ins": nil,
+	"mark": nil, "sub": nil, "sup": nil, "small": nil, "code": nil, "kbd": nil, "pre": nil, "blockquote": nil,
+	"ul": nil, "ol": {"start": true, "type": true}, "li": nil,
+	"a":          {"href": true, "target": true, "rel": true},
+	"img":        {"src": true, "alt": true, "width": true, "height": true},
+	"figure":     nil,
+	"figcaption": nil,
+	"table":      nil, "caption": nil, "thead": nil, "tbody": nil, "tfoot": nil, "tr": nil,
+	"colgroup": nil,
+	"col":      {"span": true, "width": true},
+	"th":       {"colspan": true, "rowspan": true, "colwidth": true, "scope": true},
+	"td":       {"colspan": true, "rowspan": true, "colwidth": true},
+	"label":    nil,
+	"input":    {"type": true, "checked": true, "disabled": true}, // Görev listesi kutucukları
+	"iframe":   {"src": true, "width": true, "height": true, "allowfullscreen": true, "frameborder": true},
+}
+
+// globalAttributes - Tüm izinli etiketlerde kabul edilen öznitelikler
+var globalAttributes = map[string]bool{"class": true, "style": true, "title": true, "dir": true, "lang": true}
+
+// dropWithContent - İçerikleriyle birlikte atılan etiketler, diğer izinsiz etiketlerin sadece kendisi kaldırılır
+var dropWithContent = map[string]bool{
+	"script": true, "style": true, "noscript": true, "template": true, "iframe": true, "object": true,
+	"applet": true, "svg": true, "math": true, "textarea": true, "select": true, "title": true,
+	"head": true, "xmp": true, "noembed": true, "noframes": true, "frameset": true, "plaintext": true,
+}
+
+// rawTextElements - İçeriği etiket olarak değil metin olarak okunan etiketler
+// Tarayıcılar bunlarda "/>" işaretini yok sayar; "<script/>" sonrası da kapanış etiketine kadar script içeriğidir.
+var rawTextElements = map[string]bool{
+	"script": true, "style": true, "textarea": true, "title": true, "xmp": true, "iframe": true,
+	"noembed": true, "noframes": true, "noscript": true, "plaintext": true,
+}
+
+// voidElements - Kapanış etiketi olmayan etiketler
+var voidElements = map[string]bool{
+	"br": true, "hr": true, "img": true, "col": true, "input": true, "embed": true, "wbr": true,
+	"meta": true, "link": true, "base": true, "area": true, "source": true, "track": true, "param": true,
+}
+
+// numericAttributes - Sadece sayı (veya Tiptap tablolarındaki gibi virgülle ayrılmış sayılar) alan öznitelikler
+var numericAttributes = map[string]bool{
+	"width": true, "height": true, "colspan": true, "rowspan": true, "colwidth": true, "span": true, "start": true,
+}
+
+// embedHosts - iframe ile gömülmesine izin verilen video sağlayıcıları
+var embedHosts = map[string]bool{
+	"www.youtube.com": true, "youtube.com": true, "www.youtube-nocookie.com": true, "player.vimeo.com": true,
+}
+
+// allowedStyles - style özniteliğinde izin verilen CSS özellikleri (hizalama, renk ve tablo genişlikleri)
+var allowedStyles = map[string]bool{
+	"text-align": true, "color": true, "background-color": true, "font-weight": true, "font-style": true,
+	"text-decoration": true, "font-size": true, "font-family": true, "width": true, "min-width": true,
+}
+
+var (
+	dataAttributePattern = regexp.MustCompile(`^data-[a-z0-9-]+$`)
+	numericValuePattern  = regexp.MustCompile(`^[0-9]+(,[0-9]+)*(%|px)?$`)
+	styleValuePattern    = regexp.MustCompile(`^[a-zA-Z0-9#%.,()\s'"-]+$`)
+	dataImagePattern     = regexp.MustCompile(`^data:image/(png|jpe?g|gif|webp);base64,[a-zA-Z0-9+/=]+$`)
+)
+
+// htmlSanitizer - Temizlenmiş çıktıyı ve kaldırılan öğelerin raporunu biriktirir
+type htmlSanitizer struct {
+	out      strings.Builder
+	open     []string
+	removals []types.HTMLRemoval
+	index    map[string]int
+}
+
+// SanitizeHTML zengin metin HTML'ini izin listesine göre temizler ve kaldırılan öğeleri raporlar.
+// İzinsiz etiketler kaldırılır (script, iframe gibi tehlikeli olanlar içerikleriyle birlikte),
+// on* olayları ve bilinmeyen öznitelikler atılır, güvenli olmayan adresler (javascript:, data: vb.) engellenir.
+// Çıktıda açık kalan etiketler kapatılır, eşi olmayan kapanış etiketleri atılır.
+func SanitizeHTML(input string) (string, []types.HTMLRemoval) {
+	s := &htmlSanitizer{removals: []types.HTMLRemoval{}, index: make(map[string]int)}
+	tokenizer := html.NewTokenizer(strings.NewReader(input))
+
+	skipTag := ""
+	skipDepth := 0
+
+	for {
+		tokenType := tokenizer.Next()
+		// io.EOF veya bozuk HTML - o ana kadar okunan kısımla devam et
+		if tokenType == html.ErrorToken {
+			break
+		}
+		token := tokenizer.Token()
+
+		switch tokenType {
+		case html.TextToken:
+			if skipTag == "" {
+				s.out.WriteString(html.EscapeString(token.Data))
+			}
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			if skipTag != "" {
+				if token.Data == skipTag && tokenType == html.StartTagToken {
+					skipDepth++
+				}
+				continue
+			}
+
+			attributes, keep := s.element(token)
+			if !keep {
+				if dropWithContent[token.Data] && !voidElements[token.Data] && (tokenType == html.StartTagToken || rawTextElements[token.Data]) {
+					skipTag, skipDepth = token.Data, 1
+				}
+				continue
+			}
+
+			s.out.WriteString("<" + token.Data)
+			for _, attribute := range attributes {
+				s.out.WriteString(" " + attribute.Key + `="` + html.EscapeString(attribute.Val) + `"`)
+			}
+			s.out.WriteString(">")
+
+			if !voidElements[token.Data] {
+				if tokenType == html.StartTagToken {
+					s.open = append(s.open, token.Data)
+				} else {
+					s.out.WriteString("</" + token.Data + ">")
+				}
+			}
+
+		case html.EndTagToken:
+			if skipTag != "" {
+				if token.Data == skipTag {
+					if skipDepth--; skipDepth == 0 {
+						skipTag = ""
+					}
+				}
+				continue
+			}
+			s.close(token.Data)
+
+		case html.CommentToken, html.DoctypeToken:
+			// Yorumlar ve doctype çıktıya alınmaz
+		}
+	}
+
+	for i := len(s.open) - 1; i >= 0; i-- {
+		s.out.WriteString("</" + s.open[i] + ">")
+	}
+
+	return s.out.String(), s.removals
+}
+
+// element - Etiketin tutulup tutulmayacağına karar verir ve izinli özniteliklerini döner
+func (s *htmlSanitizer) element(token html.Token) ([]html.Attribute, bool) {
+	name := token.Data
+	allowed, exists := allowedElements[name]
+	if !exists {
+		s.report(name, "", types.HTMLRemovalElement)
+		return nil, false
+	}
+
+	var attributes []html.Attribute
+	styleRemoved := false
+
+	for _, attribute := range token.Attr {
+		key := attribute.Key
+		if attribute.Namespace != "" || (!allowed[key] && !globalAttributes[key] && !dataAttributePattern.MatchString(key)) {
+			s.report(name, key, types.HTMLRemovalAttribute)
+			continue
+		}
+
+		value := attribute.Val
+		switch {
+		case key == "href":
+			safe, ok := sanitizeURL(value, false)
+			if !ok {
+				s.report(name, key, types.HTMLRemovalURL)
+				continue
+			}
+			value = safe
+
+		case key == "src":
+			safe, ok := sanitizeURL(value, name == "img")
+			if name == "iframe" {
+				ok = ok && isEmbedURL(safe)
+			}
+			// Kaynağı güvenli olmayan görsel ve gömülü içerik tamamen kaldırılır
+			if !ok {
+				s.report(name, key, types.HTMLRemovalURL)
+				return nil, false
+			}
+			value = safe
+
+		case key == "style":
+			safe, removed := sanitizeStyle(value)
+			if removed && !styleRemoved {
+				s.report(name, key, types.HTMLRemovalStyle)
+				styleRemoved = true
+			}
+			if safe == "" {
+				continue
+			}
+			value = safe
+
+		case key == "target":
+			if value != "_blank" {
+				s.report(name, key, types.HTMLRemovalAttribute)
+				continue
+			}
+
+		case key == "type" && name == "input":
+			if !strings.EqualFold(value, "checkbox") {
+				s.report(name, "", types.HTMLRemovalElement)
+				return nil, false
+			}
+
+		case numericAttributes[key]:
+			if !numericValuePattern.MatchString(strings.TrimSpace(value)) {
+				s.report(name, key, types.HTMLRemovalAttribute)
+				continue
+			}
+		}
+
+		attributes = append(attributes, html.Attribute{Key: key, Val: value})
+	}
+
+	// Gömülü içerik ve görseller kaynak olmadan tutulmaz
+	if (name == "iframe" || name == "img") && !hasAttribute(attributes, "src") {
+		s.report(name, "src", types.HTMLRemovalURL)
+		return nil, false
+	}
+
+	// Sadece görev listesi kutucukları tutulur, type'sız input metin kutusu olarak görünür
+	if name == "input" && !hasAttribute(attributes, "type") {
+		s.report(name, "", types.HTMLRemovalElement)
+		return nil, false
+	}
+
+	// Yeni sekmede açılan bağlantılar açan sayfaya erişemesin
+	if name == "a" && hasAttribute(attributes, "target") {
+		attributes = withRel(attributes, "noopener", "noreferrer")
+	}
+
+	return attributes, true
+}
+
+// close - Açık etiketi (ve içinde kapatılmamış etiketleri) kapatır, açık değilse kapanış etiketini atar
+func (s *htmlSanitizer) close(name string) {
+	for i := len(s.open) - 1; i >= 0; i-- {
+		if s.open[i] != name {
+			continue
+		}
+		for j := len(s.open) - 1; j >= i; j-- {
+			s.out.WriteString("</" + s.open[j] + ">")
+		}
+		s.open = s.open[:i]
+		return
+	}
+}
+
+// report - Kaldırılan öğeyi rapora ekler, aynı etiket/öznitelik/sebep tek kayıtta sayılır
+func (s *htmlSanitizer) report(element, attribute string, reason types.HTMLRemovalReason) {
+	key := element + "|" + attribute + "|" + string(reason)
+	if i, exists := s.index[key]; exists {
+		s.removals[i].Count++
+		return
+	}
+	s.index[key] = len(s.removals)
+	s.removals = append(s.removals, types.HTMLRemoval{Element: element, Attribute: attribute, Reason: reason, Count: 1})
+}
+
+// sanitizeURL - Adresi tarayıcıların yorumladığı biçime getirip şemasını kontrol eder
+// Göreli adresler, http(s), mailto ve tel kabul edilir; "//" ile başlayanlar https'e çevrilir.
+// allowDataImage sadece görsellerde base64 PNG/JPEG/GIF/WebP verisine izin verir.
+func sanitizeURL(raw string, allowDataImage bool) (string, bool) {
+	// Tarayıcılar baştaki/sondaki kontrol karakterlerini ve aradaki sekme/satır sonlarını yok sayar ("java\tscript:")
+	value := strings.TrimFunc(raw, func(r rune) bool { return r <= ' ' })
+	value = strings.NewReplacer("\t", "", "\n", "", "\r", "").Replace(value)
+
+	if value == "" {
+		return "", false
+	}
+
+	if strings.HasPrefix(value, "//") {
+		value = "https:" + value
+	}
+
+	colon := strings.Index(value, ":")
+	if colon == -1 || strings.ContainsAny(value[:colon], "/?#") {
+		return value, true // Göreli adres
+	}
+
+	switch strings.ToLower(value[:colon]) {
+	case "http", "https", "mailto", "tel":
+		return value, true
+	case "data":
+		return value, allowDataImage && dataImagePattern.MatchString(value)
+	}
+
+	return "", false
+}
+
+// isEmbedURL - iframe kaynağının izinli bir video sağlayıcısına ait https adresi olup olmadığını kontrol eder
+func isEmbedURL(value string) bool {
+	parsed, err := url.Parse(value)
+	return err == nil && parsed.Scheme == "https" && embedHosts[strings.ToLower(parsed.Hostname())]
+}
+
+// sanitizeStyle - İzinli CSS özelliklerini tutar, diğerlerini atar; bir şey atıldıysa removed true döner
+func sanitizeStyle(style string) (string, bool) {
+	var kept []string
+	removed := false
+
+	for _, declaration := range strings.Split(style, ";") {
+		if strings.TrimSpace(declaration) == "" {
+			continue
+		}
+
+		property, value, found := strings.Cut(declaration, ":")
+		property = strings.ToLower(strings.TrimSpace(property))
+		value = strings.TrimSpace(value)
+		lower := strings.ToLower(value)
+
+		if !found || !allowedStyles[property] || !styleValuePattern.MatchString(value) ||
+			strings.Contains(lower, "url(") || strings.Contains(lower, "expression") {
+			removed = true
+			continue
+		}
+		kept = append(kept, property+": "+value)
+	}
+
+	return strings.Join(kept, "; "), removed
+}
+
+// hasAttribute - Öznitelik listesinde verilen anahtarın olup olmadığını kontrol eder
+func hasAttribute(attributes []html.Attribute, key string) bool {
+	for _, attribute := range attributes {
+		if attribute.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// withRel - rel özniteliğine eksik değerleri ekler, öznitelik yoksa oluşturur
+func withRel(attributes []html.Attribute, values ...string) []html.Attribute {
+	for i, attribute := range attributes {
+		if attribute.Key != "rel" {
+			continue
+		}
+		rel := strings.Fields(strings.ToLower(attribute.Val))
+		for _, value := range values {
+			if !slices.Contains(rel, value) {
+				rel = append(rel, value)
+			}
+		}
+		attributes[i].Val = strings.Join(rel, " ")
+		return attributes
+	}
+	return append(attributes, html.Attribute{Key: "rel", Val: strings.Join(values, " ")})
+}
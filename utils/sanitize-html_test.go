@@ -0,0 +1,274 @@
+package utils
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/okanay/backend-holding/types"
+)
+
+func TestSanitizeHTMLXSS(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		want      string
+		forbidden []string
+	}{
+		{
+			name:      "javascript şeması isimli karakter referansıyla gizlenmiş",
+			input:     `<a href="javascript&colon;alert(1)">x</a>`,
+			want:      `<a>x</a>`,
+			forbidden: []string{"javascript", "alert"},
+		},
+		{
+			name:      "javascript şeması sekme karakteriyle bölünmüş",
+			input:     `<a href="jav&#x09;ascript:alert(1)">x</a>`,
+			want:      `<a>x</a>`,
+			forbidden: []string{"ascript", "alert"},
+		},
+		{
+			name:      "büyük/küçük harf karışık ve boşluklu javascript şeması",
+			input:     `<a href="  JaVaScRiPt:alert(1)">x</a>`,
+			want:      `<a>x</a>`,
+			forbidden: []string{"alert"},
+		},
+		{
+			name:      "kendiliğinden kapanan script etiketi",
+			input:     `<p>a<script/>alert(1)</script>b</p>`,
+			want:      `<p>ab</p>`,
+			forbidden: []string{"script", "alert"},
+		},
+		{
+			name:      "script içeriğiyle birlikte atılır",
+			input:     `<p>a<script>alert(1)</script>b</p>`,
+			want:      `<p>ab</p>`,
+			forbidden: []string{"alert"},
+		},
+		{
+			name:      "noscript içinden öznitelik kaçışı",
+			input:     `<noscript><p title="</noscript><img src=x onerror=alert(1)>"></noscript>`,
+			want:      `<img src="x">&#34;&gt;`,
+			forbidden: []string{"onerror", "alert", "noscript"},
+		},
+		{
+			name:      "svg içeren data adresi",
+			input:     `<img src="data:image/svg+xml;base64,PHN2Zz48L3N2Zz4=">`,
+			want:      ``,
+			forbidden: []string{"data:"},
+		},
+		{
+			name:      "bağlantıda data adresi",
+			input:     `<a href="data:text/html;base64,PHNjcmlwdD4=">x</a>`,
+			want:      `<a>x</a>`,
+			forbidden: []string{"data:"},
+		},
+		{
+			name:      "svg içeriğiyle birlikte atılır",
+			input:     `<svg><script>alert(1)</script></svg><p>ok</p>`,
+			want:      `<p>ok</p>`,
+			forbidden: []string{"alert"},
+		},
+		{
+			name:      "izin listesinde olmayan sağlayıcıya iframe",
+			input:     `<iframe src="https://evil.example.com/embed"></iframe><p>sonra</p>`,
+			want:      `<p>sonra</p>`,
+			forbidden: []string{"evil.example.com"},
+		},
+		{
+			name:  "https olmayan video sağlayıcısı",
+			input: `<iframe src="http://www.youtube.com/embed/abc"></iframe>`,
+			want:  ``,
+		},
+		{
+			name:      "olay öznitelikleri",
+			input:     `<p onclick="alert(1)" class="lead">x</p>`,
+			want:      `<p class="lead">x</p>`,
+			forbidden: []string{"onclick"},
+		},
+		{
+			name:      "style içinde url",
+			input:     `<p style="background: url(javascript:alert(1))">x</p>`,
+			want:      `<p>x</p>`,
+			forbidden: []string{"url("},
+		},
+		{
+			name:  "type'sız input",
+			input: `<p><input>metin</p>`,
+			want:  `<p>metin</p>`,
+		},
+		{
+			name:  "metin kutusu input",
+			input: `<input type="text" value="x">`,
+			want:  ``,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _ := SanitizeHTML(tt.input)
+
+			if got != tt.want {
+				t.Errorf("çıktı = %q, beklenen %q", got, tt.want)
+			}
+			for _, value := range tt.forbidden {
+				if strings.Contains(strings.ToLower(got), value) {
+					t.Errorf("çıktı %q içermemeli: %q", value, got)
+				}
+			}
+		})
+	}
+}
+
+func TestSanitizeHTMLTiptap(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "başlık, biçimlendirme ve bağlantı",
+			input: `<h2>Başlık</h2><p><strong>kalın</strong> <em>italik</em> <a href="https://example.com" target="_blank" rel="noopener noreferrer nofollow">link</a></p>`,
+		},
+		{
+			name:  "görev listesi",
+			input: `<ul data-type="taskList"><li data-checked="true"><label><input type="checkbox" checked="checked"><span></span></label><div><p>görev</p></div></li></ul>`,
+		},
+		{
+			name:  "tablo",
+			input: `<table><tbody><tr><th colspan="1" rowspan="1" colwidth="120"><p>A</p></th></tr><tr><td colspan="1" rowspan="1"><p>B</p></td></tr></tbody></table>`,
+		},
+		{
+			name:  "hizalama",
+			input: `<p style="text-align: center">orta</p>`,
+		},
+		{
+			name:  "görsel",
+			input: `<img src="https://cdn.example.com/a.png" alt="a"><img src="data:image/png;base64,iVBORw0KGgo=" alt="b">`,
+		},
+		{
+			name:  "video",
+			input: `<div data-youtube-video=""><iframe src="https://www.youtube.com/embed/abc" width="640" height="480" allowfullscreen="true"></iframe></div>`,
+		},
+		{
+			name:  "kod bloğu ve alıntı",
+			input: `<pre><code class="language-go">fmt.Println(&#34;a &lt; b&#34;)</code></pre><blockquote><p>alıntı</p></blockquote>`,
+		},
+		{
+			name:  "numaralı liste",
+			input: `<ol start="3"><li><p>üç</p></li></ol>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, removed := SanitizeHTML(tt.input)
+			if got != tt.input {
+				t.Errorf("Tiptap çıktısı değişmemeli\n girdi: %s\n çıktı: %s", tt.input, got)
+			}
+			if len(removed) != 0 {
+				t.Errorf("kaldırılan öğe olmamalı: %+v", removed)
+			}
+
+			// Temizlenmiş çıktı tekrar temizlendiğinde aynı kalmalı
+			again, _ := SanitizeHTML(got)
+			if again != got {
+				t.Errorf("ikinci temizleme çıktıyı değiştirdi\n ilk: %s\n ikinci: %s", got, again)
+			}
+		})
+	}
+}
+
+func TestSanitizeHTMLNormalization(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "yeni sekme bağlantısına rel eklenir",
+			input: `<a href="https://example.com" target="_blank">x</a>`,
+			want:  `<a href="https://example.com" target="_blank" rel="noopener noreferrer">x</a>`,
+		},
+		{
+			name:  "protokolsüz adres https olur",
+			input: `<a href="//example.com">x</a>`,
+			want:  `<a href="https://example.com">x</a>`,
+		},
+		{
+			name:  "açık kalan etiketler kapatılır",
+			input: `<p>a<b>b`,
+			want:  `<p>a<b>b</b></p>`,
+		},
+		{
+			name:  "eşi olmayan kapanış etiketi atılır",
+			input: `<p>a</em></p>`,
+			want:  `<p>a</p>`,
+		},
+		{
+			name:  "izinsiz etiketin içeriği korunur",
+			input: `<p><font color="red">kırmızı</font></p>`,
+			want:  `<p>kırmızı</p>`,
+		},
+		{
+			name:  "izinsiz style özellikleri atılır",
+			input: `<p style="text-align: center; position: fixed">x</p>`,
+			want:  `<p style="text-align: center">x</p>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _ := SanitizeHTML(tt.input)
+			if got != tt.want {
+				t.Errorf("çıktı = %q, beklenen %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSanitizeHTMLRemovalReport(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		removed []types.HTMLRemoval
+	}{
+		{
+			name:    "temiz girdi",
+			input:   `<p>merhaba</p>`,
+			removed: []types.HTMLRemoval{},
+		},
+		{
+			name:  "aynı öznitelik tek kayıtta sayılır",
+			input: `<p onclick="x" onmouseover="y">a</p><p onclick="z">b</p>`,
+			removed: []types.HTMLRemoval{
+				{Element: "p", Attribute: "onclick", Reason: types.HTMLRemovalAttribute, Count: 2},
+				{Element: "p", Attribute: "onmouseover", Reason: types.HTMLRemovalAttribute, Count: 1},
+			},
+		},
+		{
+			name:  "etiket, adres ve stil sebepleri",
+			input: `<script>x</script><a href="javascript:x">a</a><p style="position: fixed">b</p>`,
+			removed: []types.HTMLRemoval{
+				{Element: "script", Reason: types.HTMLRemovalElement, Count: 1},
+				{Element: "a", Attribute: "href", Reason: types.HTMLRemovalURL, Count: 1},
+				{Element: "p", Attribute: "style", Reason: types.HTMLRemovalStyle, Count: 1},
+			},
+		},
+		{
+			name:  "kaynağı güvensiz görsel",
+			input: `<img src="javascript:x"><img src="data:image/svg+xml;base64,AA==">`,
+			removed: []types.HTMLRemoval{
+				{Element: "img", Attribute: "src", Reason: types.HTMLRemovalURL, Count: 2},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, removed := SanitizeHTML(tt.input)
+			if !slices.Equal(removed, tt.removed) {
+				t.Errorf("rapor = %+v, beklenen %+v", removed, tt.removed)
+			}
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"database/sql"
 	"fmt"
 	"reflect"
+
+	"github.com/google/uuid"
 )
 
 func ScanStructByDBTags(rows *sql.Rows, dest any) error {
@@ -38,3 +40,19 @@ func ScanStructByDBTags(rows *sql.Rows, dest any) error {
 
 	return rows.Scan(values...)
 }
+
+// ScanUUIDs, tek kolonlu UUID sonuçlarını (örn. RETURNING id) slice olarak okur ve rows'u kapatır
+func ScanUUIDs(rows *sql.Rows) ([]uuid.UUID, error) {
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
@@ -0,0 +1,138 @@
+package utils
+
+import (
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/backend-holding/configs"
+	"github.com/okanay/backend-holding/types"
+)
+
+// Vurgulama işaretleri - ts_headline çıktısı kaçışlandıktan sonra <mark> etiketine çevrilir
+const (
+	searchMarkStart = "{{mark}}"
+	searchMarkEnd   = "{{/mark}}"
+)
+
+// SearchHeadlineOptions - ts_headline için parça ayarları
+const SearchHeadlineOptions = `StartSel="` + searchMarkStart + `", StopSel="` + searchMarkEnd + `", MaxWords=35, MinWords=15, MaxFragments=2, FragmentDelimiter=" … "`
+
+// HighlightSnippet ts_headline çıktısını güvenli HTML'e çevirir
+// Metin kaynak HTML'den geldiği için önce varlıklar çözülür, sonra tamamı kaçışlanır; sadece <mark> etiketleri kalır.
+func HighlightSnippet(headline string) string {
+	escaped := html.EscapeString(html.UnescapeString(strings.TrimSpace(headline)))
+	return strings.NewReplacer(searchMarkStart, "<mark>", searchMarkEnd, "</mark>").Replace(escaped)
+}
+
+// TSQuery - Tam metin aramanın SQL parçaları
+type TSQuery struct {
+	Match  string // WHERE koşulu
+	Query  string // Sıralama ve vurgulamada kullanılan tsquery ifadesi
+	Config string // ts_headline için metin arama yapılandırması
+	Args   []any  // Parçalarda kullanılan parametreler (paramIndex'ten başlayarak)
+}
+
+// BuildTSQuery arama metnini tsquery ifadesine çevirir; son kelime yazılırken de eşleşsin diye önek (:*) olarak aranır.
+// Dil verilirse o dilin yapılandırması kullanılır, dil filtresi çağıran tarafından eklenir.
+// Verilmezse her yayın dili için "dil = X AND vektör @@ sorgu(X)" koşulları OR ile birleştirilir;
+// yapılandırma sabit kaldığı için GIN indeksi her dalda kullanılabilir.
+func BuildTSQuery(vectorColumn, languageColumn, language, query string, languages []string, paramIndex int) TSQuery {
+	var args []any
+	param := func(value any) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", paramIndex+len(args)-1)
+	}
+
+	rest, prefix := splitSearchPrefix(query)
+	var restParam, prefixParam string
+	if rest != "" {
+		restParam = param(rest)
+	}
+	if prefix != "" {
+		prefixParam = param(prefix + ":*")
+	}
+
+	build := func(config string) string {
+		switch {
+		case restParam != "" && prefixParam != "":
+			return fmt.Sprintf("(websearch_to_tsquery(%s, %s) && to_tsquery(%s, %s))", config, restParam, config, prefixParam)
+		case prefixParam != "":
+			return fmt.Sprintf("to_tsquery(%s, %s)", config, prefixParam)
+		default:
+			return fmt.Sprintf("websearch_to_tsquery(%s, %s)", config, restParam)
+		}
+	}
+
+	if language != "" {
+		config := "search_config(" + param(language) + ")"
+		tsQuery := build(config)
+		return TSQuery{Match: vectorColumn + " @@ " + tsQuery, Query: tsQuery, Config: config, Args: args}
+	}
+
+	branches := make([]string, 0, len(languages))
+	cases := make([]string, 0, len(languages))
+	for _, lang := range languages {
+		languageParam := param(lang)
+		tsQuery := build("search_config(" + languageParam + ")")
+		branches = append(branches, fmt.Sprintf("(%s = %s AND %s @@ %s)", languageColumn, languageParam, vectorColumn, tsQuery))
+		cases = append(cases, fmt.Sprintf("WHEN %s THEN %s", languageParam, tsQuery))
+	}
+
+	return TSQuery{
+		Match:  "(" + strings.Join(branches, " OR ") + ")",
+		Query:  "CASE " + languageColumn + " " + strings.Join(cases, " ") + " END",
+		Config: "search_config(" + languageColumn + ")",
+		Args:   args,
+	}
+}
+
+// splitSearchPrefix - Arama metnini son kelimeden ayırır, son kelime önek araması için uygun değilse tamamı döner
+// Tırnaklı ifadeler, hariç tutulan (-kelime) ve harf/rakam dışı karakter içeren kelimeler önek olarak aranmaz.
+func splitSearchPrefix(query string) (string, string) {
+	fields := strings.Fields(query)
+	if len(fields) == 0 {
+		return query, ""
+	}
+
+	last := fields[len(fields)-1]
+	if strings.EqualFold(last, "or") || strings.Count(query, `"`)%2 != 0 {
+		return query, ""
+	}
+	for _, r := range last {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return query, ""
+		}
+	}
+
+	return strings.Join(fields[:len(fields)-1], " "), last
+}
+
+// ParseSearchParams arama isteğinin parametrelerini okur ve sınırlar, arama metni çok kısaysa 400 döner
+func ParseSearchParams(c *gin.Context) (types.SearchParams, bool) {
+	params := types.SearchParams{
+		Query:    strings.TrimSpace(c.Query("q")),
+		Language: strings.ToLower(c.Query("language")),
+		Category: c.Query("category"),
+		Page:     1,
+		Limit:    10,
+	}
+
+	if page, err := strconv.Atoi(c.Query("page")); err == nil && page > 0 {
+		params.Page = page
+	}
+	if limit, err := strconv.Atoi(c.Query("limit")); err == nil && limit > 0 {
+		params.Limit = min(limit, configs.SEARCH_MAX_LIMIT)
+	}
+
+	if utf8.RuneCountInString(params.Query) < configs.SEARCH_MIN_QUERY_LENGTH {
+		BadRequest(c, fmt.Sprintf("Arama metni en az %d karakter olmalı", configs.SEARCH_MIN_QUERY_LENGTH))
+		return params, false
+	}
+
+	return params, true
+}
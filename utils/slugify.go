@@ -0,0 +1,34 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+)
+
+// slugReplacer - Türkçe ve yaygın Latin karakterlerin ASCII karşılıkları
+var slugReplacer = strings.NewReplacer(
+	"ç", "c", "ğ", "g", "ı", "i", "İ", "i", "ö", "o", "ş", "s", "ü", "u",
+	"Ç", "c", "Ğ", "g", "Ö", "o", "Ş", "s", "Ü", "u",
+	"â", "a", "î", "i", "û", "u", "é", "e", "è", "e", "ä", "a", "ß", "ss",
+)
+
+// Slugify metni URL'de kullanılabilecek küçük harfli, tireli biçime çevirir
+func Slugify(text string) string {
+	text = strings.ToLower(slugReplacer.Replace(text))
+
+	var b strings.Builder
+	dash := false
+	for _, r := range text {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			b.WriteRune(r)
+			dash = false
+			continue
+		}
+		if !dash && b.Len() > 0 {
+			b.WriteByte('-')
+			dash = true
+		}
+	}
+
+	return strings.TrimRight(b.String(), "-")
+}
@@ -0,0 +1,85 @@
+package utils
+
+import (
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// blockTags - Metne dönüştürülürken satır sonu eklenecek blok etiketler
+var blockTags = map[string]bool{
+	"p": true, "div": true, "br": true, "li": true, "ul": true, "ol": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"blockquote": true, "pre": true, "tr": true, "table": true, "hr": true,
+}
+
+// StripHTML, Tiptap HTML çıktısını düz metne çevirir.
+// Blok etiketler satır sonuna dönüşür, script/style içerikleri atılır.
+func StripHTML(input string) string {
+	tokenizer := html.NewTokenizer(strings.NewReader(input))
+
+	var builder strings.Builder
+	skipDepth := 0
+
+	for {
+		tokenType := tokenizer.Next()
+		// io.EOF veya bozuk HTML - o ana kadar okunan metinle devam et
+		if tokenType == html.ErrorToken {
+			break
+		}
+
+		switch tokenType {
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, _ := tokenizer.TagName()
+			tag := string(name)
+			if tag == "script" || tag == "style" {
+				if tokenType == html.StartTagToken {
+					skipDepth++
+				}
+				continue
+			}
+			if blockTags[tag] {
+				builder.WriteString("\n")
+			}
+		case html.EndTagToken:
+			name, _ := tokenizer.TagName()
+			tag := string(name)
+			if (tag == "script" || tag == "style") && skipDepth > 0 {
+				skipDepth--
+				continue
+			}
+			if blockTags[tag] {
+				builder.WriteString("\n")
+			}
+		case html.TextToken:
+			if skipDepth > 0 {
+				continue
+			}
+			builder.Write(tokenizer.Text())
+		}
+	}
+
+	return NormalizeWhitespace(builder.String())
+}
+
+// NormalizeWhitespace satır içi boşlukları tekilleştirir ve ardışık boş satırları birleştirir
+func NormalizeWhitespace(text string) string {
+	lines := strings.Split(text, "\n")
+	result := make([]string, 0, len(lines))
+	previousEmpty := true
+
+	for _, line := range lines {
+		line = strings.Join(strings.Fields(line), " ")
+		if line == "" {
+			if !previousEmpty {
+				result = append(result, "")
+			}
+			previousEmpty = true
+			continue
+		}
+		result = append(result, line)
+		previousEmpty = false
+	}
+
+	return strings.TrimSpace(strings.Join(result, "\n"))
+}
@@ -0,0 +1,21 @@
+package utils
+
+import "github.com/gin-gonic/gin"
+
+// TrackingEmail - Takip oturumundaki (AuthTrackingMiddleware) e-posta adresini döner
+// Değer yoksa veya geçersizse 401 yanıtı yazılır ve ok false döner.
+func TrackingEmail(c *gin.Context) (string, bool) {
+	emailAny, exists := c.Get("tracking_email")
+	if !exists {
+		Unauthorized(c, "Oturum bilgisi bulunamadı")
+		return "", false
+	}
+
+	email, ok := emailAny.(string)
+	if !ok || email == "" {
+		Unauthorized(c, "Geçersiz oturum bilgisi")
+		return "", false
+	}
+
+	return email, true
+}
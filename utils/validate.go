@@ -3,9 +3,11 @@ package utils
 import (
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
+	"github.com/okanay/backend-holding/types"
 )
 
 // ValidateRequest, request verilerinin doğruluğunu kontrol eder ve hataları işler
@@ -99,3 +101,22 @@ func ValidateFileType(contentType string) bool {
 
 	return validTypes[contentType]
 }
+
+// ValidateSchedule, zamanlanmış yayın/kapatma zamanlarını doğrular, geçerliyse boş mesaj döner
+func ValidateSchedule(input types.ScheduleInput) string {
+	now := time.Now()
+
+	if input.PublishAt != nil && !input.PublishAt.After(now) {
+		return "Yayın zamanı gelecekte olmalıdır"
+	}
+
+	if input.UnpublishAt != nil && !input.UnpublishAt.After(now) {
+		return "Kapatma zamanı gelecekte olmalıdır"
+	}
+
+	if input.PublishAt != nil && input.UnpublishAt != nil && !input.UnpublishAt.After(*input.PublishAt) {
+		return "Kapatma zamanı yayın zamanından sonra olmalıdır"
+	}
+
+	return ""
+}